@@ -0,0 +1,73 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.94.1-71478489-20240820-161623
+ */
+
+package atrackerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AtrackerV2API : The operations supported by the AtrackerV2 service. This interface is satisfied by
+// *AtrackerV2 and may be used to substitute a fake or a decorator for the service client.
+type AtrackerV2API interface {
+	// CreateTargetWithContext : Create a target
+	CreateTargetWithContext(ctx context.Context, createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ListTargetsWithContext : List targets
+	ListTargetsWithContext(ctx context.Context, listTargetsOptions *ListTargetsOptions) (result *TargetList, response *core.DetailedResponse, err error)
+
+	// GetTargetWithContext : Get details of a target
+	GetTargetWithContext(ctx context.Context, getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ReplaceTargetWithContext : Update a target
+	ReplaceTargetWithContext(ctx context.Context, replaceTargetOptions *ReplaceTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// DeleteTargetWithContext : Delete a target
+	DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *DeleteTargetOptions) (result *WarningReport, response *core.DetailedResponse, err error)
+
+	// ValidateTargetWithContext : Validate a target
+	ValidateTargetWithContext(ctx context.Context, validateTargetOptions *ValidateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// CreateRouteWithContext : Create a route
+	CreateRouteWithContext(ctx context.Context, createRouteOptions *CreateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// ListRoutesWithContext : List routes
+	ListRoutesWithContext(ctx context.Context, listRoutesOptions *ListRoutesOptions) (result *RouteList, response *core.DetailedResponse, err error)
+
+	// GetRouteWithContext : Get details of a route
+	GetRouteWithContext(ctx context.Context, getRouteOptions *GetRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// ReplaceRouteWithContext : Update a route
+	ReplaceRouteWithContext(ctx context.Context, replaceRouteOptions *ReplaceRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// DeleteRouteWithContext : Delete a route
+	DeleteRouteWithContext(ctx context.Context, deleteRouteOptions *DeleteRouteOptions) (response *core.DetailedResponse, err error)
+
+	// GetSettingsWithContext : Get settings
+	GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+
+	// PutSettingsWithContext : Modify settings
+	PutSettingsWithContext(ctx context.Context, putSettingsOptions *PutSettingsOptions) (result *Settings, response *core.DetailedResponse, err error)
+}
+
+// Verify that AtrackerV2 implements the AtrackerV2API interface.
+var _ AtrackerV2API = (*AtrackerV2)(nil)
@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -2494,6 +2495,19 @@ func (caseManagement *CaseManagementV1) NewGetCasesPager(options *GetCasesOption
 	return
 }
 
+// NewGetCasesPagerFromCheckpoint returns a new GetCasesPager instance that resumes
+// from the position recorded by GetCasesPager.Checkpoint().
+func (caseManagement *CaseManagementV1) NewGetCasesPagerFromCheckpoint(checkpoint []byte) (pager *GetCasesPager, err error) {
+	pager = &GetCasesPager{
+		client: caseManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetCasesOptions, int64]("GetCasesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetCasesPager) HasNext() bool {
 	return pager.hasNext
@@ -2552,3 +2566,47 @@ func (pager *GetCasesPager) GetNext() (page []Case, err error) {
 func (pager *GetCasesPager) GetAll() (allItems []Case, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetCasesPager) All(ctx context.Context) iter.Seq2[Case, error] {
+	return common.All[Case](ctx, pager)
+}
+
+// AllWithPrefetch returns an iterator over all results, like All(). Once the first page of
+// results reveals the total count, the remaining pages are retrieved by up to "workers"
+// concurrent requests while items are still yielded in order.
+// The pager must not have been used before, otherwise an error is yielded. The position of
+// the pager advances each time all of the items of a page have been yielded, so a pager whose
+// iteration stopped early can be resumed with All() or from its Checkpoint().
+func (pager *GetCasesPager) AllWithPrefetch(ctx context.Context, workers int) iter.Seq2[Case, error] {
+	return func(yield func(Case, error) bool) {
+		if !pager.hasNext || pager.pageContext.next != nil {
+			yield(Case{}, fmt.Errorf("the pager has already been used"))
+			return
+		}
+		fetch := func(ctx context.Context, offset int64) ([]Case, *int64, error) {
+			var options GetCasesOptions = *pager.options
+			if offset > 0 {
+				options.Offset = core.Int64Ptr(offset)
+			}
+			result, _, err := pager.client.GetCasesWithContext(ctx, &options)
+			if err != nil {
+				return nil, nil, err
+			}
+			return result.Cases, result.TotalCount, nil
+		}
+		progress := func(next *int64) {
+			pager.pageContext.next = next
+			pager.hasNext = (next != nil)
+		}
+		common.AllWithPrefetchProgress[Case](ctx, workers, fetch, progress)(yield)
+	}
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetCasesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetCasesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetCasesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
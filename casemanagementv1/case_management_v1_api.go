@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2020, 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.60.0-13f6e1ba-20221019-164457
+ */
+
+package casemanagementv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CaseManagementV1API : The operations supported by the CaseManagementV1 service. This interface is satisfied by
+// *CaseManagementV1 and may be used to substitute a fake or a decorator for the service client.
+type CaseManagementV1API interface {
+	// GetCasesWithContext : Get cases in account
+	GetCasesWithContext(ctx context.Context, getCasesOptions *GetCasesOptions) (result *CaseList, response *core.DetailedResponse, err error)
+
+	// CreateCaseWithContext : Create a case
+	CreateCaseWithContext(ctx context.Context, createCaseOptions *CreateCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+
+	// GetCaseWithContext : Get a case in account
+	GetCaseWithContext(ctx context.Context, getCaseOptions *GetCaseOptions) (result *Case, response *core.DetailedResponse, err error)
+
+	// UpdateCaseStatusWithContext : Update case status
+	UpdateCaseStatusWithContext(ctx context.Context, updateCaseStatusOptions *UpdateCaseStatusOptions) (result *Case, response *core.DetailedResponse, err error)
+
+	// AddCommentWithContext : Add comment to case
+	AddCommentWithContext(ctx context.Context, addCommentOptions *AddCommentOptions) (result *Comment, response *core.DetailedResponse, err error)
+
+	// AddWatchlistWithContext : Add users to watchlist of case
+	AddWatchlistWithContext(ctx context.Context, addWatchlistOptions *AddWatchlistOptions) (result *WatchlistAddResponse, response *core.DetailedResponse, err error)
+
+	// RemoveWatchlistWithContext : Remove users from watchlist of case
+	RemoveWatchlistWithContext(ctx context.Context, removeWatchlistOptions *RemoveWatchlistOptions) (result *Watchlist, response *core.DetailedResponse, err error)
+
+	// AddResourceWithContext : Add a resource to case
+	AddResourceWithContext(ctx context.Context, addResourceOptions *AddResourceOptions) (result *Resource, response *core.DetailedResponse, err error)
+
+	// UploadFileWithContext : Add attachments to a support case
+	UploadFileWithContext(ctx context.Context, uploadFileOptions *UploadFileOptions) (result *Attachment, response *core.DetailedResponse, err error)
+
+	// DownloadFileWithContext : Download an attachment
+	DownloadFileWithContext(ctx context.Context, downloadFileOptions *DownloadFileOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// DeleteFileWithContext : Remove attachment from case
+	DeleteFileWithContext(ctx context.Context, deleteFileOptions *DeleteFileOptions) (result *AttachmentList, response *core.DetailedResponse, err error)
+}
+
+// Verify that CaseManagementV1 implements the CaseManagementV1API interface.
+var _ CaseManagementV1API = (*CaseManagementV1)(nil)
@@ -495,6 +495,47 @@ var _ = Describe(`CaseManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetCasesPager.Checkpoint successfully`, func() {
+				caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(caseManagementService).ToNot(BeNil())
+
+				getCasesOptionsModel := &casemanagementv1.GetCasesOptions{
+					Limit: core.Int64Ptr(int64(10)),
+					Search: core.StringPtr("testString"),
+					Sort: core.StringPtr("number"),
+					Status: []string{"new"},
+					Fields: []string{"number"},
+				}
+
+				pager, err := caseManagementService.NewGetCasesPager(getCasesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []casemanagementv1.Case
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = caseManagementService.NewGetCasesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetCasesPager.GetAll successfully`, func() {
 				caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
 					URL:           testServer.URL,
@@ -520,6 +561,129 @@ var _ = Describe(`CaseManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetCasesPager.All successfully`, func() {
+				caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(caseManagementService).ToNot(BeNil())
+
+				getCasesOptionsModel := &casemanagementv1.GetCasesOptions{
+					Limit: core.Int64Ptr(int64(10)),
+					Search: core.StringPtr("testString"),
+					Sort: core.StringPtr("number"),
+					Status: []string{"new"},
+					Fields: []string{"number"},
+				}
+
+				pager, err := caseManagementService.NewGetCasesPager(getCasesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []casemanagementv1.Case
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
+		Context(`Using mock server endpoint - paginated response with total count`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getCasesPath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.URL.Query()["limit"]).To(Equal([]string{"1"}))
+
+					// Set mock response based on the requested offset
+					offset := req.URL.Query().Get("offset")
+					if offset == "" {
+						offset = "0"
+					}
+					res.Header().Set("Content-type", "application/json")
+					if offset != "0" && offset != "1" && offset != "2" {
+						res.WriteHeader(400)
+						return
+					}
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"limit":1,"offset":%s,"total_count":3,"cases":[{"number":"%s"}]}`, offset, offset)
+				}))
+			})
+			It(`Use GetCasesPager.AllWithPrefetch successfully`, func() {
+				caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(caseManagementService).ToNot(BeNil())
+
+				getCasesOptionsModel := &casemanagementv1.GetCasesOptions{
+					Limit:  core.Int64Ptr(int64(1)),
+					Search: core.StringPtr("testString"),
+				}
+
+				pager, err := caseManagementService.NewGetCasesPager(getCasesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []string
+				for item, err := range pager.AllWithPrefetch(context.Background(), 2) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, *item.Number)
+				}
+				Expect(allResults).To(Equal([]string{"0", "1", "2"}))
+				Expect(pager.HasNext()).To(BeFalse())
+			})
+			It(`Use GetCasesPager.AllWithPrefetch and stop early`, func() {
+				caseManagementService, serviceErr := casemanagementv1.NewCaseManagementV1(&casemanagementv1.CaseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				getCasesOptionsModel := &casemanagementv1.GetCasesOptions{
+					Limit:  core.Int64Ptr(int64(1)),
+					Search: core.StringPtr("testString"),
+				}
+
+				pager, err := caseManagementService.NewGetCasesPager(getCasesOptionsModel)
+				Expect(err).To(BeNil())
+				var results []string
+				for item, err := range pager.AllWithPrefetch(context.Background(), 2) {
+					Expect(err).To(BeNil())
+					results = append(results, *item.Number)
+					if len(results) == 2 {
+						break
+					}
+				}
+				Expect(results).To(Equal([]string{"0", "1"}))
+				Expect(pager.HasNext()).To(BeTrue())
+
+				// The pager cannot be iterated with AllWithPrefetch again.
+				var errs []error
+				for _, err := range pager.AllWithPrefetch(context.Background(), 2) {
+					errs = append(errs, err)
+				}
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0]).ToNot(BeNil())
+
+				// The page whose items were not all consumed is retrieved again after resuming.
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				resumed, err := caseManagementService.NewGetCasesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				page, err := resumed.GetNext()
+				Expect(err).To(BeNil())
+				Expect(page).To(HaveLen(1))
+				Expect(*page[0].Number).To(Equal("1"))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
 		})
 	})
 	Describe(`CreateCase(createCaseOptions *CreateCaseOptions) - Operation response error`, func() {
@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -25733,6 +25734,19 @@ func (catalogManagement *CatalogManagementV1) NewCatalogAccountAuditsPager(optio
 	return
 }
 
+// NewCatalogAccountAuditsPagerFromCheckpoint returns a new CatalogAccountAuditsPager instance that resumes
+// from the position recorded by CatalogAccountAuditsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewCatalogAccountAuditsPagerFromCheckpoint(checkpoint []byte) (pager *CatalogAccountAuditsPager, err error) {
+	pager = &CatalogAccountAuditsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListCatalogAccountAuditsOptions, string]("CatalogAccountAuditsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *CatalogAccountAuditsPager) HasNext() bool {
 	return pager.hasNext
@@ -25792,6 +25806,19 @@ func (pager *CatalogAccountAuditsPager) GetAll() (allItems []AuditLogDigest, err
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *CatalogAccountAuditsPager) All(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.All[AuditLogDigest](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewCatalogAccountAuditsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *CatalogAccountAuditsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("CatalogAccountAuditsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // GetShareApprovalListPager can be used to simplify the use of the "GetShareApprovalList" method.
 type GetShareApprovalListPager struct {
 	hasNext     bool
@@ -25818,6 +25845,19 @@ func (catalogManagement *CatalogManagementV1) NewGetShareApprovalListPager(optio
 	return
 }
 
+// NewGetShareApprovalListPagerFromCheckpoint returns a new GetShareApprovalListPager instance that resumes
+// from the position recorded by GetShareApprovalListPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewGetShareApprovalListPagerFromCheckpoint(checkpoint []byte) (pager *GetShareApprovalListPager, err error) {
+	pager = &GetShareApprovalListPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetShareApprovalListOptions, string]("GetShareApprovalListPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetShareApprovalListPager) HasNext() bool {
 	return pager.hasNext
@@ -25877,6 +25917,19 @@ func (pager *GetShareApprovalListPager) GetAll() (allItems []ShareApprovalAccess
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetShareApprovalListPager) All(ctx context.Context) iter.Seq2[ShareApprovalAccess, error] {
+	return common.All[ShareApprovalAccess](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetShareApprovalListPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetShareApprovalListPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetShareApprovalListPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // GetShareApprovalListAsSourcePager can be used to simplify the use of the "GetShareApprovalListAsSource" method.
 type GetShareApprovalListAsSourcePager struct {
 	hasNext     bool
@@ -25903,6 +25956,19 @@ func (catalogManagement *CatalogManagementV1) NewGetShareApprovalListAsSourcePag
 	return
 }
 
+// NewGetShareApprovalListAsSourcePagerFromCheckpoint returns a new GetShareApprovalListAsSourcePager instance that resumes
+// from the position recorded by GetShareApprovalListAsSourcePager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewGetShareApprovalListAsSourcePagerFromCheckpoint(checkpoint []byte) (pager *GetShareApprovalListAsSourcePager, err error) {
+	pager = &GetShareApprovalListAsSourcePager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetShareApprovalListAsSourceOptions, string]("GetShareApprovalListAsSourcePager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetShareApprovalListAsSourcePager) HasNext() bool {
 	return pager.hasNext
@@ -25962,6 +26028,19 @@ func (pager *GetShareApprovalListAsSourcePager) GetAll() (allItems []ShareApprov
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetShareApprovalListAsSourcePager) All(ctx context.Context) iter.Seq2[ShareApprovalAccess, error] {
+	return common.All[ShareApprovalAccess](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetShareApprovalListAsSourcePagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetShareApprovalListAsSourcePager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetShareApprovalListAsSourcePager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // CatalogAuditsPager can be used to simplify the use of the "ListCatalogAudits" method.
 type CatalogAuditsPager struct {
 	hasNext     bool
@@ -25988,6 +26067,19 @@ func (catalogManagement *CatalogManagementV1) NewCatalogAuditsPager(options *Lis
 	return
 }
 
+// NewCatalogAuditsPagerFromCheckpoint returns a new CatalogAuditsPager instance that resumes
+// from the position recorded by CatalogAuditsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewCatalogAuditsPagerFromCheckpoint(checkpoint []byte) (pager *CatalogAuditsPager, err error) {
+	pager = &CatalogAuditsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListCatalogAuditsOptions, string]("CatalogAuditsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *CatalogAuditsPager) HasNext() bool {
 	return pager.hasNext
@@ -26047,6 +26139,19 @@ func (pager *CatalogAuditsPager) GetAll() (allItems []AuditLogDigest, err error)
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *CatalogAuditsPager) All(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.All[AuditLogDigest](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewCatalogAuditsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *CatalogAuditsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("CatalogAuditsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // EnterpriseAuditsPager can be used to simplify the use of the "ListEnterpriseAudits" method.
 type EnterpriseAuditsPager struct {
 	hasNext     bool
@@ -26073,6 +26178,19 @@ func (catalogManagement *CatalogManagementV1) NewEnterpriseAuditsPager(options *
 	return
 }
 
+// NewEnterpriseAuditsPagerFromCheckpoint returns a new EnterpriseAuditsPager instance that resumes
+// from the position recorded by EnterpriseAuditsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewEnterpriseAuditsPagerFromCheckpoint(checkpoint []byte) (pager *EnterpriseAuditsPager, err error) {
+	pager = &EnterpriseAuditsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListEnterpriseAuditsOptions, string]("EnterpriseAuditsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *EnterpriseAuditsPager) HasNext() bool {
 	return pager.hasNext
@@ -26132,6 +26250,19 @@ func (pager *EnterpriseAuditsPager) GetAll() (allItems []AuditLogDigest, err err
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *EnterpriseAuditsPager) All(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.All[AuditLogDigest](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewEnterpriseAuditsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *EnterpriseAuditsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("EnterpriseAuditsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // GetConsumptionOfferingsPager can be used to simplify the use of the "GetConsumptionOfferings" method.
 type GetConsumptionOfferingsPager struct {
 	hasNext     bool
@@ -26158,6 +26289,19 @@ func (catalogManagement *CatalogManagementV1) NewGetConsumptionOfferingsPager(op
 	return
 }
 
+// NewGetConsumptionOfferingsPagerFromCheckpoint returns a new GetConsumptionOfferingsPager instance that resumes
+// from the position recorded by GetConsumptionOfferingsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewGetConsumptionOfferingsPagerFromCheckpoint(checkpoint []byte) (pager *GetConsumptionOfferingsPager, err error) {
+	pager = &GetConsumptionOfferingsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetConsumptionOfferingsOptions, int64]("GetConsumptionOfferingsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetConsumptionOfferingsPager) HasNext() bool {
 	return pager.hasNext
@@ -26224,6 +26368,19 @@ func (pager *GetConsumptionOfferingsPager) GetAll() (allItems []Offering, err er
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetConsumptionOfferingsPager) All(ctx context.Context) iter.Seq2[Offering, error] {
+	return common.All[Offering](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetConsumptionOfferingsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetConsumptionOfferingsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetConsumptionOfferingsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // OfferingsPager can be used to simplify the use of the "ListOfferings" method.
 type OfferingsPager struct {
 	hasNext     bool
@@ -26250,6 +26407,19 @@ func (catalogManagement *CatalogManagementV1) NewOfferingsPager(options *ListOff
 	return
 }
 
+// NewOfferingsPagerFromCheckpoint returns a new OfferingsPager instance that resumes
+// from the position recorded by OfferingsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewOfferingsPagerFromCheckpoint(checkpoint []byte) (pager *OfferingsPager, err error) {
+	pager = &OfferingsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListOfferingsOptions, int64]("OfferingsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *OfferingsPager) HasNext() bool {
 	return pager.hasNext
@@ -26316,6 +26486,19 @@ func (pager *OfferingsPager) GetAll() (allItems []Offering, err error) {
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *OfferingsPager) All(ctx context.Context) iter.Seq2[Offering, error] {
+	return common.All[Offering](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewOfferingsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *OfferingsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("OfferingsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // OfferingAuditsPager can be used to simplify the use of the "ListOfferingAudits" method.
 type OfferingAuditsPager struct {
 	hasNext     bool
@@ -26342,6 +26525,19 @@ func (catalogManagement *CatalogManagementV1) NewOfferingAuditsPager(options *Li
 	return
 }
 
+// NewOfferingAuditsPagerFromCheckpoint returns a new OfferingAuditsPager instance that resumes
+// from the position recorded by OfferingAuditsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewOfferingAuditsPagerFromCheckpoint(checkpoint []byte) (pager *OfferingAuditsPager, err error) {
+	pager = &OfferingAuditsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListOfferingAuditsOptions, string]("OfferingAuditsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *OfferingAuditsPager) HasNext() bool {
 	return pager.hasNext
@@ -26401,6 +26597,19 @@ func (pager *OfferingAuditsPager) GetAll() (allItems []AuditLogDigest, err error
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *OfferingAuditsPager) All(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.All[AuditLogDigest](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewOfferingAuditsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *OfferingAuditsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("OfferingAuditsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // GetOfferingAccessListPager can be used to simplify the use of the "GetOfferingAccessList" method.
 type GetOfferingAccessListPager struct {
 	hasNext     bool
@@ -26427,6 +26636,19 @@ func (catalogManagement *CatalogManagementV1) NewGetOfferingAccessListPager(opti
 	return
 }
 
+// NewGetOfferingAccessListPagerFromCheckpoint returns a new GetOfferingAccessListPager instance that resumes
+// from the position recorded by GetOfferingAccessListPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewGetOfferingAccessListPagerFromCheckpoint(checkpoint []byte) (pager *GetOfferingAccessListPager, err error) {
+	pager = &GetOfferingAccessListPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetOfferingAccessListOptions, string]("GetOfferingAccessListPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetOfferingAccessListPager) HasNext() bool {
 	return pager.hasNext
@@ -26486,6 +26708,19 @@ func (pager *GetOfferingAccessListPager) GetAll() (allItems []Access, err error)
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetOfferingAccessListPager) All(ctx context.Context) iter.Seq2[Access, error] {
+	return common.All[Access](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetOfferingAccessListPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetOfferingAccessListPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetOfferingAccessListPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // GetVersionsPager can be used to simplify the use of the "GetVersions" method.
 type GetVersionsPager struct {
 	hasNext     bool
@@ -26512,6 +26747,19 @@ func (catalogManagement *CatalogManagementV1) NewGetVersionsPager(options *GetVe
 	return
 }
 
+// NewGetVersionsPagerFromCheckpoint returns a new GetVersionsPager instance that resumes
+// from the position recorded by GetVersionsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewGetVersionsPagerFromCheckpoint(checkpoint []byte) (pager *GetVersionsPager, err error) {
+	pager = &GetVersionsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetVersionsOptions, string]("GetVersionsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetVersionsPager) HasNext() bool {
 	return pager.hasNext
@@ -26571,6 +26819,19 @@ func (pager *GetVersionsPager) GetAll() (allItems []Version, err error) {
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetVersionsPager) All(ctx context.Context) iter.Seq2[Version, error] {
+	return common.All[Version](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetVersionsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetVersionsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetVersionsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // GetNamespacesPager can be used to simplify the use of the "GetNamespaces" method.
 type GetNamespacesPager struct {
 	hasNext     bool
@@ -26597,6 +26858,19 @@ func (catalogManagement *CatalogManagementV1) NewGetNamespacesPager(options *Get
 	return
 }
 
+// NewGetNamespacesPagerFromCheckpoint returns a new GetNamespacesPager instance that resumes
+// from the position recorded by GetNamespacesPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewGetNamespacesPagerFromCheckpoint(checkpoint []byte) (pager *GetNamespacesPager, err error) {
+	pager = &GetNamespacesPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetNamespacesOptions, int64]("GetNamespacesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetNamespacesPager) HasNext() bool {
 	return pager.hasNext
@@ -26663,6 +26937,19 @@ func (pager *GetNamespacesPager) GetAll() (allItems []string, err error) {
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetNamespacesPager) All(ctx context.Context) iter.Seq2[string, error] {
+	return common.All[string](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetNamespacesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetNamespacesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetNamespacesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // SearchObjectsPager can be used to simplify the use of the "SearchObjects" method.
 type SearchObjectsPager struct {
 	hasNext     bool
@@ -26689,6 +26976,19 @@ func (catalogManagement *CatalogManagementV1) NewSearchObjectsPager(options *Sea
 	return
 }
 
+// NewSearchObjectsPagerFromCheckpoint returns a new SearchObjectsPager instance that resumes
+// from the position recorded by SearchObjectsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewSearchObjectsPagerFromCheckpoint(checkpoint []byte) (pager *SearchObjectsPager, err error) {
+	pager = &SearchObjectsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[SearchObjectsOptions, int64]("SearchObjectsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *SearchObjectsPager) HasNext() bool {
 	return pager.hasNext
@@ -26755,6 +27055,19 @@ func (pager *SearchObjectsPager) GetAll() (allItems []CatalogObject, err error)
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *SearchObjectsPager) All(ctx context.Context) iter.Seq2[CatalogObject, error] {
+	return common.All[CatalogObject](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewSearchObjectsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *SearchObjectsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("SearchObjectsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // ObjectsPager can be used to simplify the use of the "ListObjects" method.
 type ObjectsPager struct {
 	hasNext     bool
@@ -26781,6 +27094,19 @@ func (catalogManagement *CatalogManagementV1) NewObjectsPager(options *ListObjec
 	return
 }
 
+// NewObjectsPagerFromCheckpoint returns a new ObjectsPager instance that resumes
+// from the position recorded by ObjectsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewObjectsPagerFromCheckpoint(checkpoint []byte) (pager *ObjectsPager, err error) {
+	pager = &ObjectsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListObjectsOptions, int64]("ObjectsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *ObjectsPager) HasNext() bool {
 	return pager.hasNext
@@ -26847,6 +27173,19 @@ func (pager *ObjectsPager) GetAll() (allItems []CatalogObject, err error) {
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ObjectsPager) All(ctx context.Context) iter.Seq2[CatalogObject, error] {
+	return common.All[CatalogObject](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewObjectsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ObjectsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ObjectsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // ObjectAuditsPager can be used to simplify the use of the "ListObjectAudits" method.
 type ObjectAuditsPager struct {
 	hasNext     bool
@@ -26873,6 +27212,19 @@ func (catalogManagement *CatalogManagementV1) NewObjectAuditsPager(options *List
 	return
 }
 
+// NewObjectAuditsPagerFromCheckpoint returns a new ObjectAuditsPager instance that resumes
+// from the position recorded by ObjectAuditsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewObjectAuditsPagerFromCheckpoint(checkpoint []byte) (pager *ObjectAuditsPager, err error) {
+	pager = &ObjectAuditsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListObjectAuditsOptions, string]("ObjectAuditsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *ObjectAuditsPager) HasNext() bool {
 	return pager.hasNext
@@ -26932,6 +27284,19 @@ func (pager *ObjectAuditsPager) GetAll() (allItems []AuditLogDigest, err error)
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ObjectAuditsPager) All(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.All[AuditLogDigest](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewObjectAuditsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ObjectAuditsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ObjectAuditsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // GetObjectAccessListPager can be used to simplify the use of the "GetObjectAccessList" method.
 type GetObjectAccessListPager struct {
 	hasNext     bool
@@ -26958,6 +27323,19 @@ func (catalogManagement *CatalogManagementV1) NewGetObjectAccessListPager(option
 	return
 }
 
+// NewGetObjectAccessListPagerFromCheckpoint returns a new GetObjectAccessListPager instance that resumes
+// from the position recorded by GetObjectAccessListPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewGetObjectAccessListPagerFromCheckpoint(checkpoint []byte) (pager *GetObjectAccessListPager, err error) {
+	pager = &GetObjectAccessListPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetObjectAccessListOptions, string]("GetObjectAccessListPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetObjectAccessListPager) HasNext() bool {
 	return pager.hasNext
@@ -27017,6 +27395,19 @@ func (pager *GetObjectAccessListPager) GetAll() (allItems []Access, err error) {
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetObjectAccessListPager) All(ctx context.Context) iter.Seq2[Access, error] {
+	return common.All[Access](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetObjectAccessListPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetObjectAccessListPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetObjectAccessListPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // GetObjectAccessListDeprecatedPager can be used to simplify the use of the "GetObjectAccessListDeprecated" method.
 type GetObjectAccessListDeprecatedPager struct {
 	hasNext     bool
@@ -27043,6 +27434,19 @@ func (catalogManagement *CatalogManagementV1) NewGetObjectAccessListDeprecatedPa
 	return
 }
 
+// NewGetObjectAccessListDeprecatedPagerFromCheckpoint returns a new GetObjectAccessListDeprecatedPager instance that resumes
+// from the position recorded by GetObjectAccessListDeprecatedPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewGetObjectAccessListDeprecatedPagerFromCheckpoint(checkpoint []byte) (pager *GetObjectAccessListDeprecatedPager, err error) {
+	pager = &GetObjectAccessListDeprecatedPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetObjectAccessListDeprecatedOptions, int64]("GetObjectAccessListDeprecatedPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetObjectAccessListDeprecatedPager) HasNext() bool {
 	return pager.hasNext
@@ -27109,6 +27513,19 @@ func (pager *GetObjectAccessListDeprecatedPager) GetAll() (allItems []Access, er
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetObjectAccessListDeprecatedPager) All(ctx context.Context) iter.Seq2[Access, error] {
+	return common.All[Access](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetObjectAccessListDeprecatedPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetObjectAccessListDeprecatedPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetObjectAccessListDeprecatedPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // OfferingInstanceAuditsPager can be used to simplify the use of the "ListOfferingInstanceAudits" method.
 type OfferingInstanceAuditsPager struct {
 	hasNext     bool
@@ -27135,6 +27552,19 @@ func (catalogManagement *CatalogManagementV1) NewOfferingInstanceAuditsPager(opt
 	return
 }
 
+// NewOfferingInstanceAuditsPagerFromCheckpoint returns a new OfferingInstanceAuditsPager instance that resumes
+// from the position recorded by OfferingInstanceAuditsPager.Checkpoint().
+func (catalogManagement *CatalogManagementV1) NewOfferingInstanceAuditsPagerFromCheckpoint(checkpoint []byte) (pager *OfferingInstanceAuditsPager, err error) {
+	pager = &OfferingInstanceAuditsPager{
+		client: catalogManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListOfferingInstanceAuditsOptions, string]("OfferingInstanceAuditsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *OfferingInstanceAuditsPager) HasNext() bool {
 	return pager.hasNext
@@ -27193,3 +27623,16 @@ func (pager *OfferingInstanceAuditsPager) GetAll() (allItems []AuditLogDigest, e
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *OfferingInstanceAuditsPager) All(ctx context.Context) iter.Seq2[AuditLogDigest, error] {
+	return common.All[AuditLogDigest](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewOfferingInstanceAuditsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *OfferingInstanceAuditsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("OfferingInstanceAuditsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
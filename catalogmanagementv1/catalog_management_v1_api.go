@@ -0,0 +1,362 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.94.1-71478489-20240820-161623
+ */
+
+package catalogmanagementv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CatalogManagementV1API : The operations supported by the CatalogManagementV1 service. This interface is satisfied by
+// *CatalogManagementV1 and may be used to substitute a fake or a decorator for the service client.
+type CatalogManagementV1API interface {
+	// GetCatalogAccountWithContext : Get catalog account settings
+	GetCatalogAccountWithContext(ctx context.Context, getCatalogAccountOptions *GetCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+
+	// UpdateCatalogAccountWithContext : Update account settings
+	UpdateCatalogAccountWithContext(ctx context.Context, updateCatalogAccountOptions *UpdateCatalogAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+
+	// ListCatalogAccountAuditsWithContext : Get catalog account audit logs
+	ListCatalogAccountAuditsWithContext(ctx context.Context, listCatalogAccountAuditsOptions *ListCatalogAccountAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetCatalogAccountAuditWithContext : Get a catalog account audit log entry
+	GetCatalogAccountAuditWithContext(ctx context.Context, getCatalogAccountAuditOptions *GetCatalogAccountAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetCatalogAccountFiltersWithContext : Get catalog account filters
+	GetCatalogAccountFiltersWithContext(ctx context.Context, getCatalogAccountFiltersOptions *GetCatalogAccountFiltersOptions) (result *AccumulatedFilters, response *core.DetailedResponse, err error)
+
+	// GetShareApprovalListWithContext : Get share approval access list
+	GetShareApprovalListWithContext(ctx context.Context, getShareApprovalListOptions *GetShareApprovalListOptions) (result *ShareApprovalListAccessResult, response *core.DetailedResponse, err error)
+
+	// DeleteShareApprovalListWithContext : Delete share approval access
+	DeleteShareApprovalListWithContext(ctx context.Context, deleteShareApprovalListOptions *DeleteShareApprovalListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// AddShareApprovalListWithContext : Add accesses to share approval access list
+	AddShareApprovalListWithContext(ctx context.Context, addShareApprovalListOptions *AddShareApprovalListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// GetShareApprovalListAsSourceWithContext : Get share approval access list for requesting accounts
+	GetShareApprovalListAsSourceWithContext(ctx context.Context, getShareApprovalListAsSourceOptions *GetShareApprovalListAsSourceOptions) (result *ShareApprovalListAccessResult, response *core.DetailedResponse, err error)
+
+	// UpdateShareApprovalListAsSourceWithContext : Update approval states for share approval access list for requesting accounts
+	UpdateShareApprovalListAsSourceWithContext(ctx context.Context, updateShareApprovalListAsSourceOptions *UpdateShareApprovalListAsSourceOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// ListCatalogsWithContext : Get list of catalogs
+	ListCatalogsWithContext(ctx context.Context, listCatalogsOptions *ListCatalogsOptions) (result *CatalogSearchResult, response *core.DetailedResponse, err error)
+
+	// CreateCatalogWithContext : Create a catalog
+	CreateCatalogWithContext(ctx context.Context, createCatalogOptions *CreateCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// GetCatalogWithContext : Get catalog
+	GetCatalogWithContext(ctx context.Context, getCatalogOptions *GetCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// ReplaceCatalogWithContext : Update catalog
+	ReplaceCatalogWithContext(ctx context.Context, replaceCatalogOptions *ReplaceCatalogOptions) (result *Catalog, response *core.DetailedResponse, err error)
+
+	// DeleteCatalogWithContext : Delete catalog
+	DeleteCatalogWithContext(ctx context.Context, deleteCatalogOptions *DeleteCatalogOptions) (response *core.DetailedResponse, err error)
+
+	// ListCatalogAuditsWithContext : Get catalog audit logs
+	ListCatalogAuditsWithContext(ctx context.Context, listCatalogAuditsOptions *ListCatalogAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetCatalogAuditWithContext : Get a catalog audit log entry
+	GetCatalogAuditWithContext(ctx context.Context, getCatalogAuditOptions *GetCatalogAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// ListEnterpriseAuditsWithContext : Get enterprise audit logs
+	ListEnterpriseAuditsWithContext(ctx context.Context, listEnterpriseAuditsOptions *ListEnterpriseAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetEnterpriseAuditWithContext : Get an enterprise audit log entry
+	GetEnterpriseAuditWithContext(ctx context.Context, getEnterpriseAuditOptions *GetEnterpriseAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetConsumptionOfferingsWithContext : Get consumption offerings
+	GetConsumptionOfferingsWithContext(ctx context.Context, getConsumptionOfferingsOptions *GetConsumptionOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+
+	// ListOfferingsWithContext : Get list of offerings
+	ListOfferingsWithContext(ctx context.Context, listOfferingsOptions *ListOfferingsOptions) (result *OfferingSearchResult, response *core.DetailedResponse, err error)
+
+	// CreateOfferingWithContext : Create offering
+	CreateOfferingWithContext(ctx context.Context, createOfferingOptions *CreateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ImportOfferingVersionWithContext : Import offering version
+	ImportOfferingVersionWithContext(ctx context.Context, importOfferingVersionOptions *ImportOfferingVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ImportOfferingWithContext : Import offering
+	ImportOfferingWithContext(ctx context.Context, importOfferingOptions *ImportOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ReloadOfferingWithContext : Reload offering
+	ReloadOfferingWithContext(ctx context.Context, reloadOfferingOptions *ReloadOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// GetOfferingWithContext : Get offering
+	GetOfferingWithContext(ctx context.Context, getOfferingOptions *GetOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// ReplaceOfferingWithContext : Update offering
+	ReplaceOfferingWithContext(ctx context.Context, replaceOfferingOptions *ReplaceOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// UpdateOfferingWithContext : Update offering
+	UpdateOfferingWithContext(ctx context.Context, updateOfferingOptions *UpdateOfferingOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// DeleteOfferingWithContext : Delete offering
+	DeleteOfferingWithContext(ctx context.Context, deleteOfferingOptions *DeleteOfferingOptions) (response *core.DetailedResponse, err error)
+
+	// GetOfferingStatsWithContext : Get offering statistics
+	GetOfferingStatsWithContext(ctx context.Context, getOfferingStatsOptions *GetOfferingStatsOptions) (result *MetricStats, response *core.DetailedResponse, err error)
+
+	// ListOfferingAuditsWithContext : Get offering audit logs
+	ListOfferingAuditsWithContext(ctx context.Context, listOfferingAuditsOptions *ListOfferingAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetOfferingAuditWithContext : Get an offering audit log entry
+	GetOfferingAuditWithContext(ctx context.Context, getOfferingAuditOptions *GetOfferingAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// SetOfferingPublishWithContext : Set offering publish approval settings
+	SetOfferingPublishWithContext(ctx context.Context, setOfferingPublishOptions *SetOfferingPublishOptions) (result *ApprovalResult, response *core.DetailedResponse, err error)
+
+	// DeprecateOfferingWithContext : Allows offering to be deprecated
+	DeprecateOfferingWithContext(ctx context.Context, deprecateOfferingOptions *DeprecateOfferingOptions) (response *core.DetailedResponse, err error)
+
+	// ShareOfferingWithContext : Allows offering to be shared
+	ShareOfferingWithContext(ctx context.Context, shareOfferingOptions *ShareOfferingOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+
+	// GetOfferingAccessWithContext : Check for account ID in offering access list
+	GetOfferingAccessWithContext(ctx context.Context, getOfferingAccessOptions *GetOfferingAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+
+	// GetOfferingAccessListWithContext : Get offering access list
+	GetOfferingAccessListWithContext(ctx context.Context, getOfferingAccessListOptions *GetOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// DeleteOfferingAccessListWithContext : Delete accesses from offering access list
+	DeleteOfferingAccessListWithContext(ctx context.Context, deleteOfferingAccessListOptions *DeleteOfferingAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// AddOfferingAccessListWithContext : Add accesses to offering access list
+	AddOfferingAccessListWithContext(ctx context.Context, addOfferingAccessListOptions *AddOfferingAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// GetOfferingUpdatesWithContext : Get version updates
+	GetOfferingUpdatesWithContext(ctx context.Context, getOfferingUpdatesOptions *GetOfferingUpdatesOptions) (result []VersionUpdateDescriptor, response *core.DetailedResponse, err error)
+
+	// GetOfferingChangeNoticesWithContext : Get version change notices
+	GetOfferingChangeNoticesWithContext(ctx context.Context, getOfferingChangeNoticesOptions *GetOfferingChangeNoticesOptions) (result *ChangeNoticesResponse, response *core.DetailedResponse, err error)
+
+	// GetOfferingSourceWithContext : Get offering source
+	GetOfferingSourceWithContext(ctx context.Context, getOfferingSourceOptions *GetOfferingSourceOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetOfferingSourceArchiveWithContext : Get offering source
+	GetOfferingSourceArchiveWithContext(ctx context.Context, getOfferingSourceArchiveOptions *GetOfferingSourceArchiveOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetOfferingSourceURLWithContext : Get offering source URL
+	GetOfferingSourceURLWithContext(ctx context.Context, getOfferingSourceURLOptions *GetOfferingSourceURLOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// GetVersionsWithContext : Get versions
+	GetVersionsWithContext(ctx context.Context, getVersionsOptions *GetVersionsOptions) (result *VersionsResult, response *core.DetailedResponse, err error)
+
+	// GetOfferingAboutWithContext : Get version about information
+	GetOfferingAboutWithContext(ctx context.Context, getOfferingAboutOptions *GetOfferingAboutOptions) (result *string, response *core.DetailedResponse, err error)
+
+	// GetIamPermissionsWithContext : Get the required IAM permissions for this version with the specified user context
+	GetIamPermissionsWithContext(ctx context.Context, getIamPermissionsOptions *GetIamPermissionsOptions) (result []CheckedIamPermission, response *core.DetailedResponse, err error)
+
+	// GetOfferingLicenseWithContext : Get version license content
+	GetOfferingLicenseWithContext(ctx context.Context, getOfferingLicenseOptions *GetOfferingLicenseOptions) (result *string, response *core.DetailedResponse, err error)
+
+	// GetOfferingContainerImagesWithContext : Get version's container images
+	GetOfferingContainerImagesWithContext(ctx context.Context, getOfferingContainerImagesOptions *GetOfferingContainerImagesOptions) (result *ImageManifest, response *core.DetailedResponse, err error)
+
+	// ArchiveVersionWithContext : Archive version immediately
+	ArchiveVersionWithContext(ctx context.Context, archiveVersionOptions *ArchiveVersionOptions) (response *core.DetailedResponse, err error)
+
+	// SetDeprecateVersionWithContext : Sets version to be deprecated in a certain time period
+	SetDeprecateVersionWithContext(ctx context.Context, setDeprecateVersionOptions *SetDeprecateVersionOptions) (response *core.DetailedResponse, err error)
+
+	// ConsumableVersionWithContext : Make version consumable for sharing
+	ConsumableVersionWithContext(ctx context.Context, consumableVersionOptions *ConsumableVersionOptions) (response *core.DetailedResponse, err error)
+
+	// PrereleaseVersionWithContext : Make version prerelease
+	PrereleaseVersionWithContext(ctx context.Context, prereleaseVersionOptions *PrereleaseVersionOptions) (response *core.DetailedResponse, err error)
+
+	// SuspendVersionWithContext : Suspend a version
+	SuspendVersionWithContext(ctx context.Context, suspendVersionOptions *SuspendVersionOptions) (response *core.DetailedResponse, err error)
+
+	// CommitVersionWithContext : Commit version
+	CommitVersionWithContext(ctx context.Context, commitVersionOptions *CommitVersionOptions) (response *core.DetailedResponse, err error)
+
+	// CopyVersionWithContext : Copy version to new target kind
+	CopyVersionWithContext(ctx context.Context, copyVersionOptions *CopyVersionOptions) (response *core.DetailedResponse, err error)
+
+	// GetOfferingWorkingCopyWithContext : Create working copy of version
+	GetOfferingWorkingCopyWithContext(ctx context.Context, getOfferingWorkingCopyOptions *GetOfferingWorkingCopyOptions) (result *Version, response *core.DetailedResponse, err error)
+
+	// CopyFromPreviousVersionWithContext : Copy values from a previous version
+	CopyFromPreviousVersionWithContext(ctx context.Context, copyFromPreviousVersionOptions *CopyFromPreviousVersionOptions) (response *core.DetailedResponse, err error)
+
+	// ValidateInputsWithContext : Validates deployment input variables
+	ValidateInputsWithContext(ctx context.Context, validateInputsOptions *ValidateInputsOptions) (result *VersionInputValidationResponse, response *core.DetailedResponse, err error)
+
+	// GetVersionWithContext : Get offering/kind/version 'branch'
+	GetVersionWithContext(ctx context.Context, getVersionOptions *GetVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// UpdateVersionWithContext : Update a version
+	UpdateVersionWithContext(ctx context.Context, updateVersionOptions *UpdateVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// PatchUpdateVersionWithContext : Update a version
+	PatchUpdateVersionWithContext(ctx context.Context, patchUpdateVersionOptions *PatchUpdateVersionOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// DeleteVersionWithContext : Delete version
+	DeleteVersionWithContext(ctx context.Context, deleteVersionOptions *DeleteVersionOptions) (response *core.DetailedResponse, err error)
+
+	// GetVersionDependenciesWithContext : Get offering/kind/version 'dependencies'
+	GetVersionDependenciesWithContext(ctx context.Context, getVersionDependenciesOptions *GetVersionDependenciesOptions) (result *VersionDependant, response *core.DetailedResponse, err error)
+
+	// DeprecateVersionWithContext : Deprecate version immediately - use /archive instead
+	DeprecateVersionWithContext(ctx context.Context, deprecateVersionOptions *DeprecateVersionOptions) (response *core.DetailedResponse, err error)
+
+	// GetClusterWithContext : Get kubernetes cluster
+	GetClusterWithContext(ctx context.Context, getClusterOptions *GetClusterOptions) (result *ClusterInfo, response *core.DetailedResponse, err error)
+
+	// GetNamespacesWithContext : Get cluster namespaces
+	GetNamespacesWithContext(ctx context.Context, getNamespacesOptions *GetNamespacesOptions) (result *NamespaceSearchResult, response *core.DetailedResponse, err error)
+
+	// DeployOperatorsWithContext : Deploy operators
+	DeployOperatorsWithContext(ctx context.Context, deployOperatorsOptions *DeployOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// ListOperatorsWithContext : List operators
+	ListOperatorsWithContext(ctx context.Context, listOperatorsOptions *ListOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// ReplaceOperatorsWithContext : Update operators
+	ReplaceOperatorsWithContext(ctx context.Context, replaceOperatorsOptions *ReplaceOperatorsOptions) (result []OperatorDeployResult, response *core.DetailedResponse, err error)
+
+	// DeleteOperatorsWithContext : Delete operators
+	DeleteOperatorsWithContext(ctx context.Context, deleteOperatorsOptions *DeleteOperatorsOptions) (response *core.DetailedResponse, err error)
+
+	// InstallVersionWithContext : Install version
+	InstallVersionWithContext(ctx context.Context, installVersionOptions *InstallVersionOptions) (response *core.DetailedResponse, err error)
+
+	// PreinstallVersionWithContext : Pre-install version
+	PreinstallVersionWithContext(ctx context.Context, preinstallVersionOptions *PreinstallVersionOptions) (response *core.DetailedResponse, err error)
+
+	// GetPreinstallWithContext : Get version pre-install status
+	GetPreinstallWithContext(ctx context.Context, getPreinstallOptions *GetPreinstallOptions) (result *InstallStatus, response *core.DetailedResponse, err error)
+
+	// ValidateInstallWithContext : Validate offering
+	ValidateInstallWithContext(ctx context.Context, validateInstallOptions *ValidateInstallOptions) (response *core.DetailedResponse, err error)
+
+	// GetValidationStatusWithContext : Get offering install status
+	GetValidationStatusWithContext(ctx context.Context, getValidationStatusOptions *GetValidationStatusOptions) (result *Validation, response *core.DetailedResponse, err error)
+
+	// SearchObjectsWithContext : List objects across catalogs
+	SearchObjectsWithContext(ctx context.Context, searchObjectsOptions *SearchObjectsOptions) (result *ObjectSearchResult, response *core.DetailedResponse, err error)
+
+	// ListObjectsWithContext : List objects within a catalog
+	ListObjectsWithContext(ctx context.Context, listObjectsOptions *ListObjectsOptions) (result *ObjectListResult, response *core.DetailedResponse, err error)
+
+	// CreateObjectWithContext : Create catalog object
+	CreateObjectWithContext(ctx context.Context, createObjectOptions *CreateObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// GetObjectWithContext : Get catalog object
+	GetObjectWithContext(ctx context.Context, getObjectOptions *GetObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// ReplaceObjectWithContext : Update catalog object
+	ReplaceObjectWithContext(ctx context.Context, replaceObjectOptions *ReplaceObjectOptions) (result *CatalogObject, response *core.DetailedResponse, err error)
+
+	// DeleteObjectWithContext : Delete catalog object
+	DeleteObjectWithContext(ctx context.Context, deleteObjectOptions *DeleteObjectOptions) (response *core.DetailedResponse, err error)
+
+	// ListObjectAuditsWithContext : Get object audit logs
+	ListObjectAuditsWithContext(ctx context.Context, listObjectAuditsOptions *ListObjectAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetObjectAuditWithContext : Get an object audit log entry
+	GetObjectAuditWithContext(ctx context.Context, getObjectAuditOptions *GetObjectAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// ConsumableShareObjectWithContext : Make object consumable for sharing
+	ConsumableShareObjectWithContext(ctx context.Context, consumableShareObjectOptions *ConsumableShareObjectOptions) (response *core.DetailedResponse, err error)
+
+	// ShareObjectWithContext : Allows object to be shared
+	ShareObjectWithContext(ctx context.Context, shareObjectOptions *ShareObjectOptions) (result *ShareSetting, response *core.DetailedResponse, err error)
+
+	// GetObjectAccessListWithContext : Get object access list
+	GetObjectAccessListWithContext(ctx context.Context, getObjectAccessListOptions *GetObjectAccessListOptions) (result *AccessListResult, response *core.DetailedResponse, err error)
+
+	// GetObjectAccessWithContext : Check for account ID in object access list
+	GetObjectAccessWithContext(ctx context.Context, getObjectAccessOptions *GetObjectAccessOptions) (result *Access, response *core.DetailedResponse, err error)
+
+	// CreateObjectAccessWithContext : Add account ID to object access list
+	CreateObjectAccessWithContext(ctx context.Context, createObjectAccessOptions *CreateObjectAccessOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteObjectAccessWithContext : Remove account ID from object access list
+	DeleteObjectAccessWithContext(ctx context.Context, deleteObjectAccessOptions *DeleteObjectAccessOptions) (response *core.DetailedResponse, err error)
+
+	// GetObjectAccessListDeprecatedWithContext : Get object access list
+	GetObjectAccessListDeprecatedWithContext(ctx context.Context, getObjectAccessListDeprecatedOptions *GetObjectAccessListDeprecatedOptions) (result *ObjectAccessListResult, response *core.DetailedResponse, err error)
+
+	// DeleteObjectAccessListWithContext : Delete accesses from object access list
+	DeleteObjectAccessListWithContext(ctx context.Context, deleteObjectAccessListOptions *DeleteObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// AddObjectAccessListWithContext : Add accesses to object access list
+	AddObjectAccessListWithContext(ctx context.Context, addObjectAccessListOptions *AddObjectAccessListOptions) (result *AccessListBulkResponse, response *core.DetailedResponse, err error)
+
+	// CreateOfferingInstanceWithContext : Create an offering resource instance
+	CreateOfferingInstanceWithContext(ctx context.Context, createOfferingInstanceOptions *CreateOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// GetOfferingInstanceWithContext : Get Offering Instance
+	GetOfferingInstanceWithContext(ctx context.Context, getOfferingInstanceOptions *GetOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// PutOfferingInstanceWithContext : Update Offering Instance
+	PutOfferingInstanceWithContext(ctx context.Context, putOfferingInstanceOptions *PutOfferingInstanceOptions) (result *OfferingInstance, response *core.DetailedResponse, err error)
+
+	// DeleteOfferingInstanceWithContext : Delete a version instance
+	DeleteOfferingInstanceWithContext(ctx context.Context, deleteOfferingInstanceOptions *DeleteOfferingInstanceOptions) (response *core.DetailedResponse, err error)
+
+	// ListOfferingInstanceAuditsWithContext : Get offering instance audit logs
+	ListOfferingInstanceAuditsWithContext(ctx context.Context, listOfferingInstanceAuditsOptions *ListOfferingInstanceAuditsOptions) (result *AuditLogs, response *core.DetailedResponse, err error)
+
+	// GetOfferingInstanceAuditWithContext : Get an offering instance audit log entry
+	GetOfferingInstanceAuditWithContext(ctx context.Context, getOfferingInstanceAuditOptions *GetOfferingInstanceAuditOptions) (result *AuditLog, response *core.DetailedResponse, err error)
+
+	// GetPlanWithContext : Get offering/plan 'branch'
+	GetPlanWithContext(ctx context.Context, getPlanOptions *GetPlanOptions) (result *Offering, response *core.DetailedResponse, err error)
+
+	// DeletePlanWithContext : Delete plan
+	DeletePlanWithContext(ctx context.Context, deletePlanOptions *DeletePlanOptions) (response *core.DetailedResponse, err error)
+
+	// ConsumablePlanWithContext : Make plan consumable for sharing
+	ConsumablePlanWithContext(ctx context.Context, consumablePlanOptions *ConsumablePlanOptions) (response *core.DetailedResponse, err error)
+
+	// SetDeprecatePlanWithContext : Sets plan to be deprecated in a certain time period
+	SetDeprecatePlanWithContext(ctx context.Context, setDeprecatePlanOptions *SetDeprecatePlanOptions) (response *core.DetailedResponse, err error)
+
+	// PreviewRegionsWithContext : Returns available locations based on supplied filter
+	PreviewRegionsWithContext(ctx context.Context, previewRegionsOptions *PreviewRegionsOptions) (result *RegionsSearchResult, response *core.DetailedResponse, err error)
+
+	// ListRegionsWithContext : Returns available locations based on filter set on the account and supplied filter
+	ListRegionsWithContext(ctx context.Context, listRegionsOptions *ListRegionsOptions) (result *RegionsSearchResult, response *core.DetailedResponse, err error)
+
+	// AddPlanWithContext : Add a plan to an offering
+	AddPlanWithContext(ctx context.Context, catalogID string, offeringID string, plan *Plan, headers map[string]string) (result *Plan, response *core.DetailedResponse, err error)
+
+	// SetValidatePlanWithContext : Set a plan as validated
+	SetValidatePlanWithContext(ctx context.Context, planID string, headers map[string]string) (response *core.DetailedResponse, err error)
+
+	// SetAllowPublishPlanWithContext : Set a plan as publish approved
+	SetAllowPublishPlanWithContext(ctx context.Context, planID string, headers map[string]string) (response *core.DetailedResponse, err error)
+
+	// SetAllowPublishOfferingWithContext : Set allow publish offering
+	SetAllowPublishOfferingWithContext(ctx context.Context, catalogID string, offeringID string, approvalType string, setting bool, headers map[string]string) (response *core.DetailedResponse, err error)
+}
+
+// Verify that CatalogManagementV1 implements the CatalogManagementV1API interface.
+var _ CatalogManagementV1API = (*CatalogManagementV1)(nil)
@@ -1025,6 +1025,44 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use CatalogAccountAuditsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listCatalogAccountAuditsOptionsModel := &catalogmanagementv1.ListCatalogAccountAuditsOptions{
+					Limit:       core.Int64Ptr(int64(10)),
+					Lookupnames: core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewCatalogAccountAuditsPager(listCatalogAccountAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewCatalogAccountAuditsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use CatalogAccountAuditsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -1047,6 +1085,30 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use CatalogAccountAuditsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listCatalogAccountAuditsOptionsModel := &catalogmanagementv1.ListCatalogAccountAuditsOptions{
+					Limit:       core.Int64Ptr(int64(10)),
+					Lookupnames: core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewCatalogAccountAuditsPager(listCatalogAccountAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetCatalogAccountAudit(getCatalogAccountAuditOptions *GetCatalogAccountAuditOptions) - Operation response error`, func() {
@@ -1772,6 +1834,44 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetShareApprovalListPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getShareApprovalListOptionsModel := &catalogmanagementv1.GetShareApprovalListOptions{
+					ObjectType: core.StringPtr("offering"),
+					Limit:      core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetShareApprovalListPager(getShareApprovalListOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.ShareApprovalAccess
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewGetShareApprovalListPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetShareApprovalListPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -1794,6 +1894,30 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetShareApprovalListPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getShareApprovalListOptionsModel := &catalogmanagementv1.GetShareApprovalListOptions{
+					ObjectType: core.StringPtr("offering"),
+					Limit:      core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetShareApprovalListPager(getShareApprovalListOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.ShareApprovalAccess
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`DeleteShareApprovalList(deleteShareApprovalListOptions *DeleteShareApprovalListOptions) - Operation response error`, func() {
@@ -2604,6 +2728,46 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetShareApprovalListAsSourcePager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getShareApprovalListAsSourceOptionsModel := &catalogmanagementv1.GetShareApprovalListAsSourceOptions{
+					ObjectType:              core.StringPtr("offering"),
+					ApprovalStateIdentifier: core.StringPtr("approved"),
+					Limit:                   core.Int64Ptr(int64(10)),
+					EnterpriseID:            core.StringPtr("testString"),
+				}
+
+				pager, err := catalogManagementService.NewGetShareApprovalListAsSourcePager(getShareApprovalListAsSourceOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.ShareApprovalAccess
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewGetShareApprovalListAsSourcePagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetShareApprovalListAsSourcePager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -2628,6 +2792,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetShareApprovalListAsSourcePager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getShareApprovalListAsSourceOptionsModel := &catalogmanagementv1.GetShareApprovalListAsSourceOptions{
+					ObjectType:              core.StringPtr("offering"),
+					ApprovalStateIdentifier: core.StringPtr("approved"),
+					Limit:                   core.Int64Ptr(int64(10)),
+					EnterpriseID:            core.StringPtr("testString"),
+				}
+
+				pager, err := catalogManagementService.NewGetShareApprovalListAsSourcePager(getShareApprovalListAsSourceOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.ShareApprovalAccess
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`UpdateShareApprovalListAsSource(updateShareApprovalListAsSourceOptions *UpdateShareApprovalListAsSourceOptions) - Operation response error`, func() {
@@ -4742,6 +4932,45 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use CatalogAuditsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listCatalogAuditsOptionsModel := &catalogmanagementv1.ListCatalogAuditsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Lookupnames:       core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewCatalogAuditsPager(listCatalogAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewCatalogAuditsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use CatalogAuditsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -4765,6 +4994,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use CatalogAuditsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listCatalogAuditsOptionsModel := &catalogmanagementv1.ListCatalogAuditsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Lookupnames:       core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewCatalogAuditsPager(listCatalogAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetCatalogAudit(getCatalogAuditOptions *GetCatalogAuditOptions) - Operation response error`, func() {
@@ -5296,6 +5550,45 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use EnterpriseAuditsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listEnterpriseAuditsOptionsModel := &catalogmanagementv1.ListEnterpriseAuditsOptions{
+					EnterpriseIdentifier: core.StringPtr("testString"),
+					Limit:                core.Int64Ptr(int64(10)),
+					Lookupnames:          core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewEnterpriseAuditsPager(listEnterpriseAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewEnterpriseAuditsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use EnterpriseAuditsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -5319,6 +5612,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use EnterpriseAuditsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listEnterpriseAuditsOptionsModel := &catalogmanagementv1.ListEnterpriseAuditsOptions{
+					EnterpriseIdentifier: core.StringPtr("testString"),
+					Limit:                core.Int64Ptr(int64(10)),
+					Lookupnames:          core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewEnterpriseAuditsPager(listEnterpriseAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetEnterpriseAudit(getEnterpriseAuditOptions *GetEnterpriseAuditOptions) - Operation response error`, func() {
@@ -5878,6 +6196,47 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetConsumptionOfferingsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getConsumptionOfferingsOptionsModel := &catalogmanagementv1.GetConsumptionOfferingsOptions{
+					Digest:        core.BoolPtr(true),
+					Catalog:       core.StringPtr("testString"),
+					Select:        core.StringPtr("all"),
+					IncludeHidden: core.BoolPtr(true),
+					Limit:         core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetConsumptionOfferingsPager(getConsumptionOfferingsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Offering
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewGetConsumptionOfferingsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetConsumptionOfferingsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -5903,6 +6262,33 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetConsumptionOfferingsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getConsumptionOfferingsOptionsModel := &catalogmanagementv1.GetConsumptionOfferingsOptions{
+					Digest:        core.BoolPtr(true),
+					Catalog:       core.StringPtr("testString"),
+					Select:        core.StringPtr("all"),
+					IncludeHidden: core.BoolPtr(true),
+					Limit:         core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetConsumptionOfferingsPager(getConsumptionOfferingsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Offering
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListOfferings(listOfferingsOptions *ListOfferingsOptions) - Operation response error`, func() {
@@ -6250,6 +6636,48 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use OfferingsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listOfferingsOptionsModel := &catalogmanagementv1.ListOfferingsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					Digest:            core.BoolPtr(true),
+					Limit:             core.Int64Ptr(int64(10)),
+					Name:              core.StringPtr("testString"),
+					Sort:              core.StringPtr("testString"),
+					IncludeHidden:     core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewOfferingsPager(listOfferingsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Offering
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewOfferingsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use OfferingsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -6276,6 +6704,34 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use OfferingsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listOfferingsOptionsModel := &catalogmanagementv1.ListOfferingsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					Digest:            core.BoolPtr(true),
+					Limit:             core.Int64Ptr(int64(10)),
+					Name:              core.StringPtr("testString"),
+					Sort:              core.StringPtr("testString"),
+					IncludeHidden:     core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewOfferingsPager(listOfferingsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Offering
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateOffering(createOfferingOptions *CreateOfferingOptions) - Operation response error`, func() {
@@ -14921,6 +15377,46 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use OfferingAuditsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listOfferingAuditsOptionsModel := &catalogmanagementv1.ListOfferingAuditsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					OfferingID:        core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Lookupnames:       core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewOfferingAuditsPager(listOfferingAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewOfferingAuditsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use OfferingAuditsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -14945,6 +15441,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use OfferingAuditsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listOfferingAuditsOptionsModel := &catalogmanagementv1.ListOfferingAuditsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					OfferingID:        core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Lookupnames:       core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewOfferingAuditsPager(listOfferingAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetOfferingAudit(getOfferingAuditOptions *GetOfferingAuditOptions) - Operation response error`, func() {
@@ -16281,20 +16803,47 @@ var _ = Describe(`CatalogManagementV1`, func() {
 					Expect(req.URL.EscapedPath()).To(Equal(getOfferingAccessListPath))
 					Expect(req.Method).To(Equal("GET"))
 
-					// Set mock response
-					res.Header().Set("Content-type", "application/json")
-					res.WriteHeader(200)
-					requestNumber++
-					if requestNumber == 1 {
-						fmt.Fprintf(res, "%s", `{"next":{"start":"1"},"total_count":2,"limit":1,"resources":[{"id":"ID","account":"Account","account_type":11,"catalog_id":"CatalogID","target_id":"TargetID","target_account":"TargetAccount","target_kind":"TargetKind","created":"2019-01-01T12:00:00.000Z","approval_state":"ApprovalState"}]}`)
-					} else if requestNumber == 2 {
-						fmt.Fprintf(res, "%s", `{"total_count":2,"limit":1,"resources":[{"id":"ID","account":"Account","account_type":11,"catalog_id":"CatalogID","target_id":"TargetID","target_account":"TargetAccount","target_kind":"TargetKind","created":"2019-01-01T12:00:00.000Z","approval_state":"ApprovalState"}]}`)
-					} else {
-						res.WriteHeader(400)
-					}
-				}))
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":{"start":"1"},"total_count":2,"limit":1,"resources":[{"id":"ID","account":"Account","account_type":11,"catalog_id":"CatalogID","target_id":"TargetID","target_account":"TargetAccount","target_kind":"TargetKind","created":"2019-01-01T12:00:00.000Z","approval_state":"ApprovalState"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"total_count":2,"limit":1,"resources":[{"id":"ID","account":"Account","account_type":11,"catalog_id":"CatalogID","target_id":"TargetID","target_account":"TargetAccount","target_kind":"TargetKind","created":"2019-01-01T12:00:00.000Z","approval_state":"ApprovalState"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use GetOfferingAccessListPager.GetNext successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getOfferingAccessListOptionsModel := &catalogmanagementv1.GetOfferingAccessListOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					OfferingID:        core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetOfferingAccessListPager(getOfferingAccessListOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Access
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
 			})
-			It(`Use GetOfferingAccessListPager.GetNext successfully`, func() {
+			It(`Use GetOfferingAccessListPager.Checkpoint successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
 					Authenticator: &core.NoAuthAuthenticator{},
@@ -16313,6 +16862,18 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(pager).ToNot(BeNil())
 
 				var allResults []catalogmanagementv1.Access
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewGetOfferingAccessListPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
 				for pager.HasNext() {
 					nextPage, err := pager.GetNext()
 					Expect(err).To(BeNil())
@@ -16344,6 +16905,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetOfferingAccessListPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getOfferingAccessListOptionsModel := &catalogmanagementv1.GetOfferingAccessListOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					OfferingID:        core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetOfferingAccessListPager(getOfferingAccessListOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Access
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`DeleteOfferingAccessList(deleteOfferingAccessListOptions *DeleteOfferingAccessListOptions) - Operation response error`, func() {
@@ -18420,6 +19006,48 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetVersionsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getVersionsOptionsModel := &catalogmanagementv1.GetVersionsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					OfferingID:        core.StringPtr("testString"),
+					KindID:            core.StringPtr("testString"),
+					Digest:            core.BoolPtr(true),
+					Catalog:           core.BoolPtr(true),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetVersionsPager(getVersionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Version
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewGetVersionsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetVersionsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -18446,6 +19074,34 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetVersionsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getVersionsOptionsModel := &catalogmanagementv1.GetVersionsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					OfferingID:        core.StringPtr("testString"),
+					KindID:            core.StringPtr("testString"),
+					Digest:            core.BoolPtr(true),
+					Catalog:           core.BoolPtr(true),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetVersionsPager(getVersionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Version
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetOfferingAbout(getOfferingAboutOptions *GetOfferingAboutOptions)`, func() {
@@ -24735,6 +25391,46 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetNamespacesPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getNamespacesOptionsModel := &catalogmanagementv1.GetNamespacesOptions{
+					ClusterID:         core.StringPtr("testString"),
+					Region:            core.StringPtr("testString"),
+					XAuthRefreshToken: core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetNamespacesPager(getNamespacesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []string
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewGetNamespacesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetNamespacesPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -24759,6 +25455,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetNamespacesPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getNamespacesOptionsModel := &catalogmanagementv1.GetNamespacesOptions{
+					ClusterID:         core.StringPtr("testString"),
+					Region:            core.StringPtr("testString"),
+					XAuthRefreshToken: core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetNamespacesPager(getNamespacesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []string
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`DeployOperators(deployOperatorsOptions *DeployOperatorsOptions) - Operation response error`, func() {
@@ -27024,6 +27746,47 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use SearchObjectsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				searchObjectsOptionsModel := &catalogmanagementv1.SearchObjectsOptions{
+					Query:    core.StringPtr("testString"),
+					Kind:     core.StringPtr("vpe"),
+					Limit:    core.Int64Ptr(int64(10)),
+					Collapse: core.BoolPtr(true),
+					Digest:   core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewSearchObjectsPager(searchObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.CatalogObject
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewSearchObjectsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use SearchObjectsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -27049,6 +27812,33 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use SearchObjectsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				searchObjectsOptionsModel := &catalogmanagementv1.SearchObjectsOptions{
+					Query:    core.StringPtr("testString"),
+					Kind:     core.StringPtr("vpe"),
+					Limit:    core.Int64Ptr(int64(10)),
+					Collapse: core.BoolPtr(true),
+					Digest:   core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewSearchObjectsPager(searchObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.CatalogObject
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListObjects(listObjectsOptions *ListObjectsOptions) - Operation response error`, func() {
@@ -27350,7 +28140,35 @@ var _ = Describe(`CatalogManagementV1`, func() {
 					}
 				}))
 			})
-			It(`Use ObjectsPager.GetNext successfully`, func() {
+			It(`Use ObjectsPager.GetNext successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listObjectsOptionsModel := &catalogmanagementv1.ListObjectsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Name:              core.StringPtr("testString"),
+					Sort:              core.StringPtr("testString"),
+				}
+
+				pager, err := catalogManagementService.NewObjectsPager(listObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.CatalogObject
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ObjectsPager.Checkpoint successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
 					Authenticator: &core.NoAuthAuthenticator{},
@@ -27370,6 +28188,18 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(pager).ToNot(BeNil())
 
 				var allResults []catalogmanagementv1.CatalogObject
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewObjectsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
 				for pager.HasNext() {
 					nextPage, err := pager.GetNext()
 					Expect(err).To(BeNil())
@@ -27402,6 +28232,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ObjectsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listObjectsOptionsModel := &catalogmanagementv1.ListObjectsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Name:              core.StringPtr("testString"),
+					Sort:              core.StringPtr("testString"),
+				}
+
+				pager, err := catalogManagementService.NewObjectsPager(listObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.CatalogObject
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateObject(createObjectOptions *CreateObjectOptions) - Operation response error`, func() {
@@ -28904,6 +29760,46 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ObjectAuditsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listObjectAuditsOptionsModel := &catalogmanagementv1.ListObjectAuditsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					ObjectIdentifier:  core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Lookupnames:       core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewObjectAuditsPager(listObjectAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewObjectAuditsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use ObjectAuditsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -28928,6 +29824,32 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ObjectAuditsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listObjectAuditsOptionsModel := &catalogmanagementv1.ListObjectAuditsOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					ObjectIdentifier:  core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+					Lookupnames:       core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewObjectAuditsPager(listObjectAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetObjectAudit(getObjectAuditOptions *GetObjectAuditOptions) - Operation response error`, func() {
@@ -29795,6 +30717,45 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetObjectAccessListPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getObjectAccessListOptionsModel := &catalogmanagementv1.GetObjectAccessListOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					ObjectIdentifier:  core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetObjectAccessListPager(getObjectAccessListOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Access
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewGetObjectAccessListPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetObjectAccessListPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -29818,6 +30779,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetObjectAccessListPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getObjectAccessListOptionsModel := &catalogmanagementv1.GetObjectAccessListOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					ObjectIdentifier:  core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetObjectAccessListPager(getObjectAccessListOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Access
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetObjectAccess(getObjectAccessOptions *GetObjectAccessOptions) - Operation response error`, func() {
@@ -30501,6 +31487,45 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetObjectAccessListDeprecatedPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getObjectAccessListDeprecatedOptionsModel := &catalogmanagementv1.GetObjectAccessListDeprecatedOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					ObjectIdentifier:  core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetObjectAccessListDeprecatedPager(getObjectAccessListDeprecatedOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Access
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewGetObjectAccessListDeprecatedPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetObjectAccessListDeprecatedPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -30524,6 +31549,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetObjectAccessListDeprecatedPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				getObjectAccessListDeprecatedOptionsModel := &catalogmanagementv1.GetObjectAccessListDeprecatedOptions{
+					CatalogIdentifier: core.StringPtr("testString"),
+					ObjectIdentifier:  core.StringPtr("testString"),
+					Limit:             core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := catalogManagementService.NewGetObjectAccessListDeprecatedPager(getObjectAccessListDeprecatedOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.Access
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`DeleteObjectAccessList(deleteObjectAccessListOptions *DeleteObjectAccessListOptions) - Operation response error`, func() {
@@ -32527,6 +33577,45 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use OfferingInstanceAuditsPager.Checkpoint successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listOfferingInstanceAuditsOptionsModel := &catalogmanagementv1.ListOfferingInstanceAuditsOptions{
+					InstanceIdentifier: core.StringPtr("testString"),
+					Limit:              core.Int64Ptr(int64(10)),
+					Lookupnames:        core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewOfferingInstanceAuditsPager(listOfferingInstanceAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = catalogManagementService.NewOfferingInstanceAuditsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use OfferingInstanceAuditsPager.GetAll successfully`, func() {
 				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
 					URL:           testServer.URL,
@@ -32550,6 +33639,31 @@ var _ = Describe(`CatalogManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use OfferingInstanceAuditsPager.All successfully`, func() {
+				catalogManagementService, serviceErr := catalogmanagementv1.NewCatalogManagementV1(&catalogmanagementv1.CatalogManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(catalogManagementService).ToNot(BeNil())
+
+				listOfferingInstanceAuditsOptionsModel := &catalogmanagementv1.ListOfferingInstanceAuditsOptions{
+					InstanceIdentifier: core.StringPtr("testString"),
+					Limit:              core.Int64Ptr(int64(10)),
+					Lookupnames:        core.BoolPtr(true),
+				}
+
+				pager, err := catalogManagementService.NewOfferingInstanceAuditsPager(listOfferingInstanceAuditsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []catalogmanagementv1.AuditLogDigest
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetOfferingInstanceAudit(getOfferingInstanceAuditOptions *GetOfferingInstanceAuditOptions) - Operation response error`, func() {
@@ -0,0 +1,77 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// pagerCheckpoint is the serialized form of a pager's position within a list operation.
+type pagerCheckpoint[O any, N any] struct {
+	// The name of the pager type that produced the checkpoint.
+	Pager string `json:"pager"`
+
+	// The options used to invoke the list operation.
+	Options *O `json:"options"`
+
+	// Whether or not there were more results to be retrieved.
+	HasNext bool `json:"has_next"`
+
+	// The value (e.g. "start", "offset" or "next_docid") used to request the next page of results.
+	Next *N `json:"next,omitempty"`
+}
+
+// MarshalPagerCheckpoint returns the serialized state of a pager, where "pager" is the name of
+// the pager type, "options" are the options of the list operation, and "next" is the value used
+// to request the next page of results. It is invoked by the Checkpoint() method of each pager.
+func MarshalPagerCheckpoint[O any, N any](pager string, options *O, hasNext bool, next *N) ([]byte, error) {
+	checkpoint := &pagerCheckpoint[O, N]{
+		Pager:   pager,
+		Options: options,
+		HasNext: hasNext,
+		Next:    next,
+	}
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "checkpoint-marshal-error", GetComponentInfo())
+		return nil, err
+	}
+	return data, nil
+}
+
+// UnmarshalPagerCheckpoint restores the state serialized by MarshalPagerCheckpoint(). It returns
+// an error if "data" is malformed or was produced by a pager type other than "pager".
+func UnmarshalPagerCheckpoint[O any, N any](pager string, data []byte) (options *O, hasNext bool, next *N, err error) {
+	checkpoint := &pagerCheckpoint[O, N]{}
+	err = json.Unmarshal(data, checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "checkpoint-unmarshal-error", GetComponentInfo())
+		return
+	}
+	if checkpoint.Pager != pager {
+		errMsg := fmt.Sprintf("the checkpoint was created by a '%s', not a '%s'", checkpoint.Pager, pager)
+		err = core.SDKErrorf(nil, errMsg, "checkpoint-pager-mismatch", GetComponentInfo())
+		return
+	}
+	if checkpoint.Options == nil {
+		checkpoint.Options = new(O)
+	}
+	return checkpoint.Options, checkpoint.HasNext, checkpoint.Next, nil
+}
@@ -0,0 +1,70 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+type testListOptions struct {
+	Name    *string `json:"name,omitempty"`
+	Limit   *int64  `json:"limit,omitempty"`
+	Offset  *int64  `json:"offset,omitempty"`
+	Headers map[string]string
+}
+
+func TestPagerCheckpointRoundTrip(t *testing.T) {
+	options := &testListOptions{
+		Name:    core.StringPtr("name"),
+		Limit:   core.Int64Ptr(10),
+		Headers: map[string]string{"x-custom-header": "x-custom-value"},
+	}
+
+	data, err := MarshalPagerCheckpoint("TestPager", options, true, core.Int64Ptr(20))
+	assert.Nil(t, err)
+
+	restored, hasNext, next, err := UnmarshalPagerCheckpoint[testListOptions, int64]("TestPager", data)
+	assert.Nil(t, err)
+	assert.Equal(t, options, restored)
+	assert.True(t, hasNext)
+	assert.Equal(t, core.Int64Ptr(20), next)
+}
+
+func TestPagerCheckpointWithoutNext(t *testing.T) {
+	data, err := MarshalPagerCheckpoint[testListOptions, string]("TestPager", nil, false, nil)
+	assert.Nil(t, err)
+
+	restored, hasNext, next, err := UnmarshalPagerCheckpoint[testListOptions, string]("TestPager", data)
+	assert.Nil(t, err)
+	assert.Equal(t, &testListOptions{}, restored)
+	assert.False(t, hasNext)
+	assert.Nil(t, next)
+}
+
+func TestPagerCheckpointErrors(t *testing.T) {
+	_, _, _, err := UnmarshalPagerCheckpoint[testListOptions, string]("TestPager", []byte("{bad json"))
+	assert.NotNil(t, err)
+
+	data, err := MarshalPagerCheckpoint("OtherPager", &testListOptions{}, true, core.StringPtr("abc"))
+	assert.Nil(t, err)
+	_, _, _, err = UnmarshalPagerCheckpoint[testListOptions, string]("TestPager", data)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "OtherPager")
+}
@@ -19,6 +19,7 @@ package common
 import (
 	"fmt"
 	"runtime"
+	"sync/atomic"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 )
@@ -26,8 +27,22 @@ import (
 const (
 	sdkName             = "platform-services-go-sdk"
 	headerNameUserAgent = "User-Agent"
+
+	// HeaderNameSdkOperation is the name of the internal header that identifies the service
+	// operation which produced an outgoing request. It is only added once EnableSdkOperationHeader
+	// has been invoked, and is removed by the telemetry transport before the request is sent.
+	HeaderNameSdkOperation = "X-IBMCloud-SDK-Operation"
 )
 
+var sdkOperationHeaderEnabled atomic.Bool
+
+// EnableSdkOperationHeader makes GetSdkHeaders add the HeaderNameSdkOperation header to the
+// outgoing requests. It is invoked by telemetry.Attach; the header is then also sent by the
+// service clients that are not instrumented, since they do not remove it.
+func EnableSdkOperationHeader() {
+	sdkOperationHeaderEnabled.Store(true)
+}
+
 // GetSdkHeaders - returns the set of SDK-specific headers to be included in an outgoing request.
 //
 // This function is invoked by generated service methods (i.e. methods which implement the REST API operations
@@ -52,6 +67,10 @@ const (
 func GetSdkHeaders(serviceName string, serviceVersion string, operationId string) map[string]string {
 	sdkHeaders := make(map[string]string)
 	sdkHeaders[headerNameUserAgent] = GetUserAgentInfo()
+	if sdkOperationHeaderEnabled.Load() {
+		sdkHeaders[HeaderNameSdkOperation] = fmt.Sprintf("service_name=%s;service_version=%s;operation_id=%s",
+			serviceName, serviceVersion, operationId)
+	}
 	return sdkHeaders
 }
 
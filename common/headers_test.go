@@ -40,4 +40,12 @@ func TestGetSdkHeaders(t *testing.T) {
 	_, foundIt = headers[headerNameUserAgent]
 	assert.True(t, foundIt)
 	t.Logf("user agent: %s\n", headers[headerNameUserAgent])
+
+	_, foundIt = headers[HeaderNameSdkOperation]
+	assert.False(t, foundIt)
+
+	EnableSdkOperationHeader()
+	defer sdkOperationHeaderEnabled.Store(false)
+	headers = GetSdkHeaders("myService", "v123", "myOperation")
+	assert.Equal(t, "service_name=myService;service_version=v123;operation_id=myOperation", headers[HeaderNameSdkOperation])
 }
@@ -0,0 +1,67 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"iter"
+)
+
+// Pager is the behavior shared by all of the pager types defined in the service packages
+// (e.g. resourcecontrollerv2.ResourceInstancesPager), where T is the type of the items
+// contained in each page of results.
+type Pager[T any] interface {
+	// HasNext returns true if there are potentially more results to be retrieved.
+	HasNext() bool
+
+	// GetNextWithContext returns the next page of results using the specified Context.
+	GetNextWithContext(ctx context.Context) ([]T, error)
+}
+
+// All returns an iterator that yields the items of "pager" one at a time, retrieving
+// each page of results only when the items of the previous page have been consumed.
+//
+// Iteration stops early if "ctx" is cancelled or if the caller breaks out of the loop.
+// If an error occurs (including cancellation of "ctx"), it is yielded exactly once,
+// along with the zero value of T, and the iteration then ends.
+func All[T any](ctx context.Context, pager Pager[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for pager.HasNext() {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
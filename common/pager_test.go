@@ -0,0 +1,104 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testPager struct {
+	pages [][]int
+	err   error
+	calls int
+}
+
+func (pager *testPager) HasNext() bool {
+	return pager.calls < len(pager.pages) || (pager.err != nil && pager.calls == len(pager.pages))
+}
+
+func (pager *testPager) GetNextWithContext(ctx context.Context) ([]int, error) {
+	pager.calls++
+	if pager.calls > len(pager.pages) {
+		return nil, pager.err
+	}
+	return pager.pages[pager.calls-1], nil
+}
+
+func TestAllYieldsEveryItem(t *testing.T) {
+	pager := &testPager{pages: [][]int{{1, 2}, {3}, {4, 5}}}
+
+	var items []int
+	for item, err := range All[int](context.Background(), pager) {
+		assert.Nil(t, err)
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	assert.Equal(t, 3, pager.calls)
+}
+
+func TestAllFetchesPagesLazily(t *testing.T) {
+	pager := &testPager{pages: [][]int{{1, 2}, {3}, {4, 5}}}
+
+	for item, err := range All[int](context.Background(), pager) {
+		assert.Nil(t, err)
+		if item == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 1, pager.calls)
+}
+
+func TestAllYieldsErrorOnce(t *testing.T) {
+	pageErr := errors.New("page error")
+	pager := &testPager{pages: [][]int{{1}}, err: pageErr}
+
+	var items []int
+	var errs []error
+	for item, err := range All[int](context.Background(), pager) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{1}, items)
+	assert.Equal(t, []error{pageErr}, errs)
+}
+
+func TestAllStopsOnCancellation(t *testing.T) {
+	pager := &testPager{pages: [][]int{{1, 2}, {3}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var items []int
+	var errs []error
+	for item, err := range All[int](ctx, pager) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+		cancel()
+	}
+	assert.Equal(t, []int{1}, items)
+	assert.Equal(t, []error{context.Canceled}, errs)
+	assert.Equal(t, 1, pager.calls)
+}
@@ -0,0 +1,156 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"iter"
+)
+
+// OffsetPageFunc retrieves the page of results that begins at "offset" from an
+// offset-based list operation. It returns the items contained in the page along with
+// the total number of items in the collection, or nil if the service did not report it.
+type OffsetPageFunc[T any] func(ctx context.Context, offset int64) (items []T, totalCount *int64, err error)
+
+// PrefetchProgressFunc is invoked by AllWithPrefetchProgress each time all of the items of a
+// page have been yielded, with the offset of the next page, or nil if no results remain.
+type PrefetchProgressFunc func(next *int64)
+
+type offsetPageResult[T any] struct {
+	items []T
+	err   error
+}
+
+// AllWithPrefetch returns an iterator that yields every item reachable through "fetch".
+//
+// The first page is retrieved on its own. If it reports the total count, the offsets of the
+// remaining pages are computed from the size of the first page and those pages are retrieved
+// by up to "workers" concurrent requests. At most "workers" pages are in flight or waiting
+// to be consumed at any time, and items are always yielded in order.
+//
+// If the total count is not reported or "workers" is less than 2, the remaining pages are
+// retrieved one at a time until an empty page is returned.
+//
+// Iteration stops early if "ctx" is cancelled or if the caller breaks out of the loop, in
+// which case outstanding requests are cancelled. If an error occurs, it is yielded exactly
+// once, along with the zero value of T, and the iteration then ends.
+func AllWithPrefetch[T any](ctx context.Context, workers int, fetch OffsetPageFunc[T]) iter.Seq2[T, error] {
+	return AllWithPrefetchProgress(ctx, workers, fetch, nil)
+}
+
+// AllWithPrefetchProgress is like AllWithPrefetch, but also reports to "progress" (if not nil)
+// the offset of the next page once the items of each page have been yielded, so that the
+// position of the iteration can be recorded as the items are consumed.
+func AllWithPrefetchProgress[T any](ctx context.Context, workers int, fetch OffsetPageFunc[T], progress PrefetchProgressFunc) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if progress == nil {
+			progress = func(*int64) {}
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// yieldPage yields the items of a page and then reports "next" (if it is less than the
+		// total count, when known) as the offset of the next page.
+		var totalCount *int64
+		yieldPage := func(items []T, next int64) bool {
+			for _, item := range items {
+				if err := ctx.Err(); err != nil {
+					yield(zero, err)
+					return false
+				}
+				if !yield(item, nil) {
+					return false
+				}
+			}
+			if totalCount != nil && next >= *totalCount {
+				progress(nil)
+			} else {
+				progress(&next)
+			}
+			return true
+		}
+
+		items, totalCount, err := fetch(ctx, 0)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		pageSize := int64(len(items))
+		if pageSize == 0 {
+			progress(nil)
+			return
+		}
+		if !yieldPage(items, pageSize) {
+			return
+		}
+
+		if totalCount == nil || workers < 2 {
+			offset := pageSize
+			for totalCount == nil || offset < *totalCount {
+				items, _, err = fetch(ctx, offset)
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+				if len(items) == 0 {
+					progress(nil)
+					return
+				}
+				offset += int64(len(items))
+				if !yieldPage(items, offset) {
+					return
+				}
+			}
+			return
+		}
+
+		var offsets []int64
+		for offset := pageSize; offset < *totalCount; offset += pageSize {
+			offsets = append(offsets, offset)
+		}
+
+		results := make([]chan offsetPageResult[T], len(offsets))
+		launch := func(i int) {
+			results[i] = make(chan offsetPageResult[T], 1)
+			go func() {
+				items, _, err := fetch(ctx, offsets[i])
+				results[i] <- offsetPageResult[T]{items: items, err: err}
+			}()
+		}
+
+		next := 0
+		for ; next < len(offsets) && next < workers; next++ {
+			launch(next)
+		}
+		for i := range offsets {
+			result := <-results[i]
+			if next < len(offsets) {
+				launch(next)
+				next++
+			}
+			if result.err != nil {
+				yield(zero, result.err)
+				return
+			}
+			if !yieldPage(result.items, offsets[i]+pageSize) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// newOffsetFetcher returns an OffsetPageFunc over the integers [0, total) that records
+// the highest number of concurrent calls it observed.
+func newOffsetFetcher(total int64, pageSize int64, reportTotal bool, maxInFlight *int32) OffsetPageFunc[int64] {
+	var inFlight int32
+	var mutex sync.Mutex
+	return func(ctx context.Context, offset int64) ([]int64, *int64, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		mutex.Lock()
+		if current > *maxInFlight {
+			*maxInFlight = current
+		}
+		mutex.Unlock()
+
+		// Make later pages complete first to verify that ordering is preserved.
+		time.Sleep(time.Duration(total-offset) * time.Millisecond)
+
+		var items []int64
+		for i := offset; i < offset+pageSize && i < total; i++ {
+			items = append(items, i)
+		}
+		if !reportTotal {
+			return items, nil, nil
+		}
+		return items, &total, nil
+	}
+}
+
+func collect(seq iter.Seq2[int64, error]) (items []int64, errs []error) {
+	for item, err := range seq {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	return
+}
+
+func expectedRange(total int64) (items []int64) {
+	for i := int64(0); i < total; i++ {
+		items = append(items, i)
+	}
+	return
+}
+
+func TestAllWithPrefetchPreservesOrder(t *testing.T) {
+	var maxInFlight int32
+	fetch := newOffsetFetcher(47, 5, true, &maxInFlight)
+
+	items, errs := collect(AllWithPrefetch(context.Background(), 4, fetch))
+	assert.Empty(t, errs)
+	assert.Equal(t, expectedRange(47), items)
+	assert.True(t, maxInFlight > 1)
+	assert.True(t, maxInFlight <= 4)
+}
+
+func TestAllWithPrefetchWithoutTotalCount(t *testing.T) {
+	var maxInFlight int32
+	fetch := newOffsetFetcher(12, 5, false, &maxInFlight)
+
+	items, errs := collect(AllWithPrefetch(context.Background(), 4, fetch))
+	assert.Empty(t, errs)
+	assert.Equal(t, expectedRange(12), items)
+	assert.Equal(t, int32(1), maxInFlight)
+}
+
+func TestAllWithPrefetchSingleWorker(t *testing.T) {
+	var maxInFlight int32
+	fetch := newOffsetFetcher(12, 5, true, &maxInFlight)
+
+	items, errs := collect(AllWithPrefetch(context.Background(), 1, fetch))
+	assert.Empty(t, errs)
+	assert.Equal(t, expectedRange(12), items)
+	assert.Equal(t, int32(1), maxInFlight)
+}
+
+func TestAllWithPrefetchYieldsErrorOnce(t *testing.T) {
+	pageErr := errors.New("page error")
+	var maxInFlight int32
+	inner := newOffsetFetcher(20, 5, true, &maxInFlight)
+	fetch := func(ctx context.Context, offset int64) ([]int64, *int64, error) {
+		if offset == 10 {
+			return nil, nil, pageErr
+		}
+		return inner(ctx, offset)
+	}
+
+	items, errs := collect(AllWithPrefetch(context.Background(), 3, fetch))
+	assert.Equal(t, expectedRange(10), items)
+	assert.Equal(t, []error{pageErr}, errs)
+}
+
+func TestAllWithPrefetchEarlyBreak(t *testing.T) {
+	var maxInFlight int32
+	fetch := newOffsetFetcher(100, 5, true, &maxInFlight)
+
+	var items []int64
+	for item, err := range AllWithPrefetch(context.Background(), 3, fetch) {
+		assert.Nil(t, err)
+		items = append(items, item)
+		if item == 7 {
+			break
+		}
+	}
+	assert.Equal(t, expectedRange(8), items)
+}
+
+func TestAllWithPrefetchProgress(t *testing.T) {
+	for _, reportTotal := range []bool{true, false} {
+		var maxInFlight int32
+		fetch := newOffsetFetcher(12, 5, reportTotal, &maxInFlight)
+
+		var positions []*int64
+		progress := func(next *int64) {
+			positions = append(positions, next)
+		}
+		items, errs := collect(AllWithPrefetchProgress(context.Background(), 3, fetch, progress))
+		assert.Empty(t, errs)
+		assert.Equal(t, expectedRange(12), items)
+		if reportTotal {
+			assert.Equal(t, []*int64{core.Int64Ptr(5), core.Int64Ptr(10), nil}, positions)
+		} else {
+			assert.Equal(t, []*int64{core.Int64Ptr(5), core.Int64Ptr(10), core.Int64Ptr(12), nil}, positions)
+		}
+
+		// The position is only reported once all of the items of a page have been yielded.
+		positions = nil
+		for item := range AllWithPrefetchProgress(context.Background(), 3, fetch, progress) {
+			if item == 7 {
+				break
+			}
+		}
+		assert.Equal(t, []*int64{core.Int64Ptr(5)}, positions)
+	}
+}
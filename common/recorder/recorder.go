@@ -0,0 +1,407 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package recorder : Records the HTTP interactions of a service client to a cassette file and
+// replays them offline, so that tests can be run without live credentials.
+package recorder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// Mode : The way in which a Recorder handles the requests sent through it.
+type Mode string
+
+const (
+	// ModeRecord sends each request to the service and records the interaction in the cassette.
+	ModeRecord Mode = "record"
+
+	// ModeReplay answers each request from the cassette without contacting the service.
+	ModeReplay Mode = "replay"
+
+	// ModeAuto replays the cassette if it exists, and records a new one otherwise.
+	ModeAuto Mode = "auto"
+
+	// ModePassthrough sends each request to the service without recording it.
+	ModePassthrough Mode = "passthrough"
+)
+
+// ModeEnvironmentVariable is the name of the environment variable from which the mode is
+// obtained when Options.Mode is not set.
+const ModeEnvironmentVariable = "IBM_RECORDER_MODE"
+
+// Cassette : The interactions recorded in a cassette file.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction : A request and the response that was received for it.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest : The recorded form of an HTTP request.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    Body        `json:"body,omitempty"`
+}
+
+// RecordedResponse : The recorded form of an HTTP response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body : The content of a request or response body. Bodies that are not valid UTF-8 are stored in
+// the cassette as base64-encoded strings.
+type Body []byte
+
+// MarshalJSON stores the body as a string, or as a base64-encoded object for binary content.
+func (body Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(body) {
+		return json.Marshal(string(body))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(body)})
+}
+
+// UnmarshalJSON restores a body stored by MarshalJSON.
+func (body *Body) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*body = Body(text)
+		return nil
+	}
+	var encoded map[string]string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded["base64"])
+	if err != nil {
+		return err
+	}
+	*body = decoded
+	return nil
+}
+
+// Options : The options used to create a Recorder.
+type Options struct {
+	// The mode of the recorder. If not set, the mode is obtained from the IBM_RECORDER_MODE
+	// environment variable, and defaults to ModeReplay.
+	Mode Mode
+
+	// The transport used to send requests to the service. If not set, the transport of the
+	// client to which the recorder is attached (or http.DefaultTransport) is used.
+	Transport http.RoundTripper
+
+	// Whether or not the request body must also match when a request is replayed. By default,
+	// requests are matched by their method and URL.
+	MatchBody bool
+
+	// Additional headers and JSON fields to be redacted, beyond DefaultRedactedHeaders and
+	// DefaultRedactedFields.
+	RedactHeaders []string
+	RedactFields  []string
+
+	// A function that is invoked to perform any further redaction of each interaction before it
+	// is stored in the cassette.
+	Redact func(*Interaction)
+}
+
+// Recorder : An http.RoundTripper that records and replays the interactions with a service.
+type Recorder struct {
+	path      string
+	mode      Mode
+	options   Options
+	transport http.RoundTripper
+	redactor  *redactor
+
+	mutex    sync.Mutex
+	cassette *Cassette
+	replayed []bool
+}
+
+// New returns a Recorder for the cassette file at "path". In replay mode, the cassette is loaded
+// immediately and an error is returned if it cannot be read.
+func New(path string, options *Options) (recorder *Recorder, err error) {
+	if options == nil {
+		options = &Options{}
+	}
+	mode := options.Mode
+	if mode == "" {
+		mode = Mode(os.Getenv(ModeEnvironmentVariable))
+	}
+	if mode == "" {
+		mode = ModeReplay
+	}
+	if mode == ModeAuto {
+		mode = ModeReplay
+		if _, statErr := os.Stat(path); errors.Is(statErr, fs.ErrNotExist) {
+			mode = ModeRecord
+		}
+	}
+
+	recorder = &Recorder{
+		path:      path,
+		mode:      mode,
+		options:   *options,
+		transport: options.Transport,
+		redactor:  newRedactor(options.RedactHeaders, options.RedactFields),
+		cassette:  &Cassette{},
+	}
+
+	switch mode {
+	case ModeReplay:
+		err = recorder.load()
+		if err != nil {
+			return nil, err
+		}
+	case ModeRecord, ModePassthrough:
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported recorder mode '%s'", mode), "invalid-mode", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// Mode returns the mode in which the recorder is operating. A recorder created with ModeAuto
+// reports either ModeRecord or ModeReplay.
+func (recorder *Recorder) Mode() Mode {
+	return recorder.mode
+}
+
+// Attach installs the recorder in the HTTP client used by "service", which is typically the
+// "Service" field of a service client. In replay mode, the service's authenticator is replaced
+// with a NoAuthAuthenticator so that no token requests are made.
+func (recorder *Recorder) Attach(service *core.BaseService) {
+	client := service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+	if recorder.transport == nil {
+		recorder.transport = client.Transport
+	}
+
+	recordingClient := *client
+	recordingClient.Transport = recorder
+	service.SetHTTPClient(&recordingClient)
+
+	if recorder.mode == ModeReplay {
+		service.Options.Authenticator = &core.NoAuthAuthenticator{}
+	}
+}
+
+// Stop completes the use of the recorder. In record mode, the recorded interactions are written
+// to the cassette file.
+func (recorder *Recorder) Stop() error {
+	if recorder.mode != ModeRecord {
+		return nil
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	data, err := json.MarshalIndent(recorder.cassette, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "cassette-marshal-error", common.GetComponentInfo())
+	}
+	err = os.MkdirAll(filepath.Dir(recorder.path), 0750)
+	if err == nil {
+		err = os.WriteFile(recorder.path, append(data, '\n'), 0600)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "cassette-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// Interactions returns the interactions that have been recorded or loaded from the cassette.
+func (recorder *Recorder) Interactions() []*Interaction {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return append([]*Interaction(nil), recorder.cassette.Interactions...)
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch recorder.mode {
+	case ModeReplay:
+		return recorder.replay(req)
+	case ModeRecord:
+		return recorder.record(req)
+	default:
+		return recorder.getTransport().RoundTrip(req)
+	}
+}
+
+func (recorder *Recorder) getTransport() http.RoundTripper {
+	if recorder.transport == nil {
+		return http.DefaultTransport
+	}
+	return recorder.transport
+}
+
+// record sends "req" to the service and stores a redacted copy of the interaction.
+func (recorder *Recorder) record(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := recorder.getTransport().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := &Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: req.Header.Clone(),
+			Body:    requestBody,
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Headers:    res.Header.Clone(),
+			Body:       responseBody,
+		},
+	}
+	recorder.redact(interaction)
+
+	recorder.mutex.Lock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, interaction)
+	recorder.mutex.Unlock()
+	return res, nil
+}
+
+// replay answers "req" with the first matching interaction that has not yet been replayed.
+func (recorder *Recorder) replay(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The request is redacted in the same way as the recorded requests before they are compared.
+	key := &Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: req.Header.Clone(),
+			Body:    requestBody,
+		},
+	}
+	recorder.redact(key)
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	for i, interaction := range recorder.cassette.Interactions {
+		if recorder.replayed[i] || !recorder.matches(key, interaction) {
+			continue
+		}
+		recorder.replayed[i] = true
+
+		res := &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Headers.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}
+		if res.Header == nil {
+			res.Header = http.Header{}
+		}
+		return res, nil
+	}
+
+	errMsg := fmt.Sprintf("no recorded interaction in '%s' matches %s %s", recorder.path, key.Request.Method, key.Request.URL)
+	return nil, core.SDKErrorf(nil, errMsg, "interaction-not-found", common.GetComponentInfo())
+}
+
+func (recorder *Recorder) matches(key *Interaction, interaction *Interaction) bool {
+	if key.Request.Method != interaction.Request.Method || key.Request.URL != interaction.Request.URL {
+		return false
+	}
+	return !recorder.options.MatchBody || bytes.Equal(key.Request.Body, interaction.Request.Body)
+}
+
+func (recorder *Recorder) redact(interaction *Interaction) {
+	recorder.redactor.redactInteraction(interaction)
+	if recorder.options.Redact != nil {
+		recorder.options.Redact(interaction)
+	}
+}
+
+func (recorder *Recorder) load() error {
+	data, err := os.ReadFile(recorder.path)
+	if err != nil {
+		return core.SDKErrorf(err, "", "cassette-read-error", common.GetComponentInfo())
+	}
+	err = json.Unmarshal(data, recorder.cassette)
+	if err != nil {
+		return core.SDKErrorf(err, "", "cassette-unmarshal-error", common.GetComponentInfo())
+	}
+	recorder.replayed = make([]bool, len(recorder.cassette.Interactions))
+	return nil
+}
+
+// readRequestBody returns the body of "req", leaving the request with an unread copy of it.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// isJSON returns true if "headers" describe a JSON body.
+func isJSON(headers http.Header) bool {
+	return strings.Contains(strings.ToLower(headers.Get("Content-Type")), "json")
+}
@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recorder
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplayResourceKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures", "resource_keys.json")
+
+	fake := mockservers.NewResourceControllerV2Server()
+	key := fake.AddResourceKey(resourcecontrollerv2.ResourceKey{
+		Name:      core.StringPtr("test-key"),
+		SourceCRN: core.StringPtr("crn:v1:bluemix:public:cloud-object-storage:global:a/testAccountID:instance-1::"),
+	})
+
+	// Record the interaction against the fake, authenticating with a bearer token.
+	service, err := fake.NewClient()
+	require.Nil(t, err)
+	service.Service.Options.Authenticator, _ = core.NewBearerTokenAuthenticator("secret-token")
+
+	recorder, err := New(path, &Options{Mode: ModeRecord})
+	require.Nil(t, err)
+	recorder.Attach(service.Service)
+
+	result, _, err := service.GetResourceKey(service.NewGetResourceKeyOptions(*key.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, "apikey-"+*key.GUID, *result.Credentials.Apikey)
+	require.Nil(t, recorder.Stop())
+	fake.Close()
+
+	// The cassette contains no secrets.
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), "apikey-"+*key.GUID)
+	assert.Contains(t, string(data), "Bearer REDACTED")
+
+	// Replay the interaction after the fake has been stopped.
+	service, err = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           fake.URL,
+		Authenticator: &core.IamAuthenticator{ApiKey: "not-used"},
+	})
+	require.Nil(t, err)
+
+	recorder, err = New(path, nil)
+	require.Nil(t, err)
+	assert.Equal(t, ModeReplay, recorder.Mode())
+	recorder.Attach(service.Service)
+
+	result, response, err := service.GetResourceKey(service.NewGetResourceKeyOptions(*key.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, *key.CRN, *result.CRN)
+	assert.Equal(t, Redacted, *result.Credentials.Apikey)
+
+	// Each interaction is replayed only once.
+	_, _, err = service.GetResourceKey(service.NewGetResourceKeyOptions(*key.GUID))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "no recorded interaction")
+}
+
+func TestModeFromEnvironment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	t.Setenv(ModeEnvironmentVariable, string(ModeAuto))
+	recorder, err := New(path, nil)
+	require.Nil(t, err)
+	assert.Equal(t, ModeRecord, recorder.Mode())
+	require.Nil(t, recorder.Stop())
+
+	recorder, err = New(path, nil)
+	require.Nil(t, err)
+	assert.Equal(t, ModeReplay, recorder.Mode())
+
+	t.Setenv(ModeEnvironmentVariable, "bogus")
+	_, err = New(path, nil)
+	assert.NotNil(t, err)
+
+	t.Setenv(ModeEnvironmentVariable, "")
+	_, err = New(filepath.Join(t.TempDir(), "missing.json"), nil)
+	assert.NotNil(t, err)
+}
+
+func TestRedactFormAndQueryFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("Set-Cookie", "session=abc")
+		_, _ = io.WriteString(res, `{"access_token":"token-value","expires_in":3600,"nested":{"password":"pw"}}`)
+	}))
+	defer server.Close()
+
+	recorder, err := New(filepath.Join(t.TempDir(), "cassette.json"), &Options{
+		Mode:         ModeRecord,
+		RedactFields: []string{"session_id"},
+		Redact: func(interaction *Interaction) {
+			interaction.Response.Headers.Del("Date")
+		},
+	})
+	require.Nil(t, err)
+	client := &http.Client{Transport: recorder}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/identity/token?session_id=s1&page=2",
+		strings.NewReader("grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey&apikey=my-api-key"))
+	require.Nil(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header["x-auth-refresh-token"] = []string{"refresh-token"}
+	res, err := client.Do(req)
+	require.Nil(t, err)
+	body, _ := io.ReadAll(res.Body)
+	res.Body.Close()
+	assert.Contains(t, string(body), "token-value")
+
+	interactions := recorder.Interactions()
+	require.Len(t, interactions, 1)
+	interaction := interactions[0]
+	assert.Equal(t, server.URL+"/identity/token?page=2&session_id=REDACTED", interaction.Request.URL)
+	assert.Equal(t, "apikey=REDACTED&grant_type=urn%3Aibm%3Aparams%3Aoauth%3Agrant-type%3Aapikey", string(interaction.Request.Body))
+	assert.JSONEq(t, `{"access_token":"REDACTED","expires_in":3600,"nested":{"password":"REDACTED"}}`, string(interaction.Response.Body))
+	assert.Equal(t, []string{Redacted}, interaction.Request.Headers["x-auth-refresh-token"])
+	assert.Equal(t, []string{Redacted}, interaction.Response.Headers.Values("Set-Cookie"))
+	assert.Empty(t, interaction.Response.Headers.Get("Date"))
+}
+
+func TestBinaryBodies(t *testing.T) {
+	body := Body{0xff, 0xfe, 0x00, 0x01}
+	data, err := body.MarshalJSON()
+	require.Nil(t, err)
+	assert.Equal(t, `{"base64":"//4AAQ=="}`, string(data))
+
+	var decoded Body
+	require.Nil(t, decoded.UnmarshalJSON(data))
+	assert.Equal(t, body, decoded)
+
+	require.Nil(t, decoded.UnmarshalJSON([]byte(`"text"`)))
+	assert.Equal(t, "text", string(decoded))
+}
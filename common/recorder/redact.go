@@ -0,0 +1,202 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Redacted is the value that replaces secrets in a cassette.
+const Redacted = "REDACTED"
+
+// DefaultRedactedHeaders are the request and response headers whose values are always redacted.
+var DefaultRedactedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"Apikey",
+	"X-Api-Key",
+	"X-Auth-Token",
+	"X-Auth-Refresh-Token",
+	"X-Auth-User-Token",
+}
+
+// DefaultRedactedFields are the JSON fields, form fields and query parameters whose values are
+// always redacted. Every value nested within a "credentials" field (e.g. the credentials of a
+// resource key or resource binding) is redacted.
+var DefaultRedactedFields = []string{
+	"apikey",
+	"api_key",
+	"access_token",
+	"refresh_token",
+	"password",
+	"credentials",
+}
+
+type redactor struct {
+	headers []string
+	fields  map[string]bool
+}
+
+func newRedactor(headers []string, fields []string) *redactor {
+	r := &redactor{
+		headers: append(append([]string(nil), DefaultRedactedHeaders...), headers...),
+		fields:  map[string]bool{},
+	}
+	for _, field := range append(append([]string(nil), DefaultRedactedFields...), fields...) {
+		r.fields[strings.ToLower(field)] = true
+	}
+	return r
+}
+
+func (r *redactor) redactInteraction(interaction *Interaction) {
+	interaction.Request.URL = r.redactURL(interaction.Request.URL)
+	r.redactHeaders(interaction.Request.Headers)
+	r.redactHeaders(interaction.Response.Headers)
+	interaction.Request.Body = r.redactBody(interaction.Request.Headers, interaction.Request.Body)
+	interaction.Response.Body = r.redactBody(interaction.Response.Headers, interaction.Response.Body)
+}
+
+// redactHeaders replaces the value of each sensitive header. The scheme of an "Authorization"
+// header (e.g. "Bearer") is preserved.
+func (r *redactor) redactHeaders(headers http.Header) {
+	// Header names are compared case-insensitively, since the request builder does not
+	// canonicalize the names of the headers that it adds.
+	for key, values := range headers {
+		if !r.isRedactedHeader(key) {
+			continue
+		}
+		redacted := make([]string, len(values))
+		for i, value := range values {
+			redacted[i] = Redacted
+			if scheme, _, found := strings.Cut(value, " "); found && strings.EqualFold(key, "Authorization") {
+				redacted[i] = scheme + " " + Redacted
+			}
+		}
+		headers[key] = redacted
+	}
+}
+
+func (r *redactor) isRedactedHeader(key string) bool {
+	for _, name := range r.headers {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *redactor) redactURL(rawURL string) string {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || parsedURL.RawQuery == "" {
+		return rawURL
+	}
+	query := parsedURL.Query()
+	if r.redactValues(query) {
+		parsedURL.RawQuery = query.Encode()
+	}
+	return parsedURL.String()
+}
+
+// redactBody redacts the sensitive fields of a JSON or form-encoded body. Other bodies, and
+// bodies that contain nothing to redact, are returned unchanged.
+func (r *redactor) redactBody(headers http.Header, body Body) Body {
+	if len(body) == 0 {
+		return body
+	}
+
+	if strings.HasPrefix(headers.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err == nil && r.redactValues(form) {
+			return Body(form.Encode())
+		}
+		return body
+	}
+
+	trimmed := bytes.TrimSpace(body)
+	if !isJSON(headers) && (len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[')) {
+		return body
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var document interface{}
+	if decoder.Decode(&document) != nil {
+		return body
+	}
+	document, changed := r.redactJSON(document, false)
+	if !changed {
+		return body
+	}
+	redacted, err := json.Marshal(document)
+	if err != nil {
+		return body
+	}
+	return redacted
+}
+
+// redactJSON redacts the sensitive fields within "value". If "all" is true, every scalar
+// value is redacted.
+func (r *redactor) redactJSON(value interface{}, all bool) (interface{}, bool) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		changed := false
+		for name, field := range value {
+			sensitive := all || r.fields[strings.ToLower(name)]
+			redacted, fieldChanged := r.redactJSON(field, sensitive)
+			if fieldChanged {
+				value[name] = redacted
+				changed = true
+			}
+		}
+		return value, changed
+	case []interface{}:
+		changed := false
+		for i, element := range value {
+			redacted, elementChanged := r.redactJSON(element, all)
+			if elementChanged {
+				value[i] = redacted
+				changed = true
+			}
+		}
+		return value, changed
+	case nil:
+		return value, false
+	default:
+		if !all || value == Redacted {
+			return value, false
+		}
+		return Redacted, true
+	}
+}
+
+func (r *redactor) redactValues(values url.Values) bool {
+	changed := false
+	for name, fieldValues := range values {
+		if !r.fields[strings.ToLower(name)] {
+			continue
+		}
+		for i := range fieldValues {
+			fieldValues[i] = Redacted
+		}
+		changed = true
+	}
+	return changed
+}
@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultLatencyBounds are the upper bounds of the latency buckets used when none are specified.
+var DefaultLatencyBounds = []time.Duration{
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// LatencyHistogram : An in-memory implementation of Metrics that maintains a latency histogram
+// for each operation.
+type LatencyHistogram struct {
+	bounds []time.Duration
+
+	mutex  sync.Mutex
+	series map[string]*HistogramData
+}
+
+// HistogramData : The measurements recorded for a single operation.
+type HistogramData struct {
+	// The upper bounds of the latency buckets.
+	Bounds []time.Duration
+
+	// The number of invocations in each bucket. The last bucket counts the invocations whose
+	// latency exceeded every bound.
+	Counts []uint64
+
+	// The total number and latency of the invocations.
+	Count uint64
+	Sum   time.Duration
+
+	// The total number of retries of the invocations.
+	Retries uint64
+
+	// The number of invocations that failed with a transport error or a status code >= 400.
+	Errors uint64
+
+	// The number of invocations whose last response had each status code.
+	StatusCodes map[int]uint64
+}
+
+// NewLatencyHistogram returns a LatencyHistogram whose buckets have the specified upper bounds,
+// or DefaultLatencyBounds if none are specified.
+func NewLatencyHistogram(bounds ...time.Duration) *LatencyHistogram {
+	if len(bounds) == 0 {
+		bounds = DefaultLatencyBounds
+	}
+	bounds = append([]time.Duration(nil), bounds...)
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i] < bounds[j] })
+	return &LatencyHistogram{
+		bounds: bounds,
+		series: map[string]*HistogramData{},
+	}
+}
+
+// Record implements the Metrics interface.
+func (histogram *LatencyHistogram) Record(operation Operation, outcome Outcome) {
+	histogram.mutex.Lock()
+	defer histogram.mutex.Unlock()
+
+	name := operation.Name("")
+	data := histogram.series[name]
+	if data == nil {
+		data = &HistogramData{
+			Bounds:      histogram.bounds,
+			Counts:      make([]uint64, len(histogram.bounds)+1),
+			StatusCodes: map[int]uint64{},
+		}
+		histogram.series[name] = data
+	}
+
+	bucket := sort.Search(len(histogram.bounds), func(i int) bool {
+		return outcome.Latency <= histogram.bounds[i]
+	})
+	data.Counts[bucket]++
+	data.Count++
+	data.Sum += outcome.Latency
+	data.Retries += uint64(outcome.Retries)
+	if outcome.Err != nil || outcome.StatusCode >= 400 {
+		data.Errors++
+	}
+	if outcome.StatusCode != 0 {
+		data.StatusCodes[outcome.StatusCode]++
+	}
+}
+
+// Snapshot returns a copy of the measurements recorded so far, keyed by operation name
+// (see Operation.Name).
+func (histogram *LatencyHistogram) Snapshot() map[string]HistogramData {
+	histogram.mutex.Lock()
+	defer histogram.mutex.Unlock()
+
+	snapshot := make(map[string]HistogramData, len(histogram.series))
+	for name, data := range histogram.series {
+		copied := *data
+		copied.Counts = append([]uint64(nil), data.Counts...)
+		copied.StatusCodes = make(map[int]uint64, len(data.StatusCodes))
+		for statusCode, count := range data.StatusCodes {
+			copied.StatusCodes[statusCode] = count
+		}
+		snapshot[name] = copied
+	}
+	return snapshot
+}
@@ -0,0 +1,278 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package telemetry : Opt-in tracing and metrics for the operations invoked with a service client.
+//
+// Each invocation of a service method is traced with a single span named "service.operationId",
+// which covers the automatic retries of its HTTP request, if any.
+//
+// The Tracer and Span interfaces are intentionally small so that they can be implemented with
+// a thin adapter over OpenTelemetry or any other tracing library.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// The attributes set on each span.
+const (
+	AttributeServiceName    = "ibm.service.name"
+	AttributeServiceVersion = "ibm.service.version"
+	AttributeOperationID    = "ibm.operation_id"
+	AttributeCorrelationID  = "ibm.correlation_id"
+	AttributeMethod         = "http.request.method"
+	AttributeURL            = "url.full"
+	AttributeResendCount    = "http.request.resend_count"
+	AttributeStatusCode     = "http.response.status_code"
+)
+
+const (
+	headerNameTraceParent   = "traceparent"
+	headerNameCorrelationID = "X-Correlation-Id"
+)
+
+// Tracer : Starts a span for each operation invoked with a service client.
+type Tracer interface {
+	// Start returns a new span named "name" and a context that contains it.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span : A single traced operation.
+type Span interface {
+	// SetAttribute sets an attribute of the span. The value is a string or an int.
+	SetAttribute(key string, value interface{})
+
+	// SetError marks the span as failed.
+	SetError(err error)
+
+	// TraceParent returns the W3C "traceparent" header value that identifies the span, or an
+	// empty string if the trace context should not be propagated.
+	TraceParent() string
+
+	// End completes the span.
+	End()
+}
+
+// Metrics : Receives a measurement of each operation invoked with a service client.
+type Metrics interface {
+	Record(operation Operation, outcome Outcome)
+}
+
+// Operation : Identifies the service operation that produced an HTTP request.
+type Operation struct {
+	// The service name (e.g. "global_tagging").
+	ServiceName string
+
+	// The service version (e.g. "V1").
+	ServiceVersion string
+
+	// The operation ID (e.g. "ListTags").
+	OperationID string
+}
+
+// Name returns the name of the operation in the form "service.operationId", which is used as
+// the span name. Requests that were not sent by a service method are named after their HTTP
+// method.
+func (operation Operation) Name(method string) string {
+	if operation.ServiceName == "" || operation.OperationID == "" {
+		return "HTTP " + method
+	}
+	return operation.ServiceName + "." + operation.OperationID
+}
+
+// parseOperation returns the operation identified by the value of the
+// common.HeaderNameSdkOperation header.
+func parseOperation(value string) (operation Operation) {
+	for _, field := range strings.Split(value, ";") {
+		name, value, _ := strings.Cut(field, "=")
+		switch strings.TrimSpace(name) {
+		case "service_name":
+			operation.ServiceName = value
+		case "service_version":
+			operation.ServiceVersion = value
+		case "operation_id":
+			operation.OperationID = value
+		}
+	}
+	return
+}
+
+// Outcome : The outcome of an operation, i.e. of its HTTP request and of the retries of the
+// request.
+type Outcome struct {
+	// The number of times that the request was retried.
+	Retries int
+
+	// The status code of the last response, or 0 if no response was received.
+	StatusCode int
+
+	// The time taken to receive the headers of the last response, including the retries.
+	Latency time.Duration
+
+	// The "X-Correlation-Id" of the request or response, if any.
+	CorrelationID string
+
+	// The error returned by the transport, if any.
+	Err error
+}
+
+// Options : The options used to instrument a service client. Either field may be nil.
+type Options struct {
+	Tracer  Tracer
+	Metrics Metrics
+}
+
+// Attach instruments the HTTP client of "service", which is typically the "Service" field of a
+// service client. Attach() must be invoked after the HTTP client is configured, in particular
+// after EnableRetries(), so that the retries of a request are traced as part of its operation.
+func Attach(service *core.BaseService, options *Options) {
+	if options == nil {
+		options = &Options{}
+	}
+	common.EnableSdkOperationHeader()
+
+	client := service.Client
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	}
+
+	// When retries are enabled, the retryable client reports the attempt number of each request.
+	if retryable, ok := client.Transport.(*retryablehttp.RoundTripper); ok {
+		previousHook := retryable.Client.RequestLogHook
+		retryable.Client.RequestLogHook = func(logger retryablehttp.Logger, req *http.Request, attempt int) {
+			if previousHook != nil {
+				previousHook(logger, req, attempt)
+			}
+			if retries, ok := req.Context().Value(retriesKey{}).(*int); ok {
+				*retries = attempt
+			}
+		}
+	}
+
+	// The instrumented client wraps the retryable client, if any, so that its transport is
+	// invoked once per operation.
+	instrumentedClient := *client
+	instrumentedClient.Transport = &transport{
+		next:    client.Transport,
+		options: *options,
+	}
+	service.Client = &instrumentedClient
+}
+
+// retriesKey is the context key of the number of retries of a request.
+type retriesKey struct{}
+
+// removeHeader removes the header named "name" and returns its first value. Unlike
+// http.Header.Get(), the name is matched case-insensitively, since the request builder does not
+// canonicalize the names of the headers that it adds.
+func removeHeader(header http.Header, name string) (value string) {
+	for key, values := range header {
+		if strings.EqualFold(key, name) {
+			if value == "" && len(values) > 0 {
+				value = values[0]
+			}
+			delete(header, key)
+		}
+	}
+	return
+}
+
+// headerValue returns the first value of the header named "name", matched case-insensitively.
+func headerValue(header http.Header, name string) string {
+	if value := header.Get(name); value != "" {
+		return value
+	}
+	for key, values := range header {
+		if strings.EqualFold(key, name) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// transport is an http.RoundTripper that traces and measures each operation.
+type transport struct {
+	next    http.RoundTripper
+	options Options
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// The request is cloned so that the headers can be modified.
+	req = req.Clone(req.Context())
+	operation := parseOperation(removeHeader(req.Header, common.HeaderNameSdkOperation))
+	outcome := Outcome{
+		CorrelationID: headerValue(req.Header, headerNameCorrelationID),
+	}
+
+	var span Span
+	ctx := req.Context()
+	if t.options.Tracer != nil {
+		ctx, span = t.options.Tracer.Start(ctx, operation.Name(req.Method))
+		if traceParent := span.TraceParent(); traceParent != "" {
+			req.Header.Set(headerNameTraceParent, traceParent)
+		}
+		span.SetAttribute(AttributeServiceName, operation.ServiceName)
+		span.SetAttribute(AttributeServiceVersion, operation.ServiceVersion)
+		span.SetAttribute(AttributeOperationID, operation.OperationID)
+		span.SetAttribute(AttributeMethod, req.Method)
+		span.SetAttribute(AttributeURL, req.URL.Redacted())
+	}
+	req = req.WithContext(context.WithValue(ctx, retriesKey{}, &outcome.Retries))
+
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	start := time.Now()
+	res, err := next.RoundTrip(req)
+	outcome.Latency = time.Since(start)
+	outcome.Err = err
+	if res != nil {
+		outcome.StatusCode = res.StatusCode
+		if outcome.CorrelationID == "" {
+			outcome.CorrelationID = res.Header.Get(headerNameCorrelationID)
+		}
+	}
+
+	if span != nil {
+		span.SetAttribute(AttributeResendCount, outcome.Retries)
+		if outcome.CorrelationID != "" {
+			span.SetAttribute(AttributeCorrelationID, outcome.CorrelationID)
+		}
+		if outcome.StatusCode != 0 {
+			span.SetAttribute(AttributeStatusCode, outcome.StatusCode)
+		}
+		if err != nil {
+			span.SetError(err)
+		} else if outcome.StatusCode >= 400 {
+			span.SetError(fmt.Errorf("%d %s", outcome.StatusCode, http.StatusText(outcome.StatusCode)))
+		}
+		span.End()
+	}
+	if t.options.Metrics != nil {
+		t.options.Metrics.Record(operation, outcome)
+	}
+	return res, err
+}
@@ -0,0 +1,173 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (span *testSpan) SetAttribute(key string, value interface{}) {
+	span.attributes[key] = value
+}
+
+func (span *testSpan) SetError(err error) {
+	span.err = err
+}
+
+func (span *testSpan) TraceParent() string {
+	return fmt.Sprintf("00-4bf92f3577b34da6a3ce929d0e0e4736-%016x-01", len(span.name))
+}
+
+func (span *testSpan) End() {
+	span.ended = true
+}
+
+type testTracer struct {
+	mutex sync.Mutex
+	spans []*testSpan
+}
+
+func (tracer *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+	span := &testSpan{name: name, attributes: map[string]interface{}{}}
+	tracer.spans = append(tracer.spans, span)
+	return ctx, span
+}
+
+func TestTraceOperationWithRetry(t *testing.T) {
+	fake := mockservers.NewGlobalTaggingV1Server()
+	defer fake.Close()
+	fake.AddFault(mockservers.Fault{
+		Method:     http.MethodGet,
+		Path:       "/v3/tags",
+		StatusCode: 503,
+	})
+
+	service, err := fake.NewClient()
+	require.Nil(t, err)
+	service.EnableRetries(2, 10*time.Millisecond)
+
+	tracer := &testTracer{}
+	histogram := NewLatencyHistogram()
+	Attach(service.Service, &Options{Tracer: tracer, Metrics: histogram})
+
+	listTagsOptions := service.NewListTagsOptions()
+	listTagsOptions.SetXCorrelationID("correlation-1")
+	_, response, err := service.ListTags(listTagsOptions)
+	require.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+
+	// A single span covers the operation and its retry.
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	assert.Equal(t, "global_tagging.ListTags", span.name)
+	assert.True(t, span.ended)
+	assert.Equal(t, 1, span.attributes[AttributeResendCount])
+	assert.Equal(t, "correlation-1", span.attributes[AttributeCorrelationID])
+	assert.Equal(t, "V1", span.attributes[AttributeServiceVersion])
+	assert.Equal(t, 200, span.attributes[AttributeStatusCode])
+	assert.Nil(t, span.err)
+
+	// The trace context is propagated to each attempt and the operation header is not sent.
+	requests := fake.Requests()
+	require.Len(t, requests, 2)
+	for _, request := range requests {
+		assert.Equal(t, span.TraceParent(), request.Header.Get("traceparent"))
+		assert.Empty(t, request.Header.Get(common.HeaderNameSdkOperation))
+	}
+
+	snapshot := histogram.Snapshot()
+	data := snapshot["global_tagging.ListTags"]
+	assert.Equal(t, uint64(1), data.Count)
+	assert.Equal(t, uint64(1), data.Retries)
+	assert.Equal(t, uint64(0), data.Errors)
+	assert.Equal(t, map[int]uint64{200: 1}, data.StatusCodes)
+	assert.Len(t, data.Counts, len(DefaultLatencyBounds)+1)
+}
+
+func TestMetricsWithoutTracer(t *testing.T) {
+	fake := mockservers.NewGlobalTaggingV1Server()
+	defer fake.Close()
+
+	service, err := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+		URL:           fake.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	histogram := NewLatencyHistogram(time.Hour, time.Nanosecond)
+	Attach(service.Service, &Options{Metrics: histogram})
+
+	_, _, err = service.DeleteTagWithContext(context.Background(), service.NewDeleteTagOptions("missing"))
+	assert.NotNil(t, err)
+
+	data := histogram.Snapshot()["global_tagging.DeleteTag"]
+	assert.Equal(t, []time.Duration{time.Nanosecond, time.Hour}, data.Bounds)
+	assert.Equal(t, []uint64{0, 1, 0}, data.Counts)
+	assert.Equal(t, uint64(1), data.Errors)
+	assert.Equal(t, uint64(0), data.Retries)
+	assert.Empty(t, fake.Requests()[0].Header.Get("traceparent"))
+}
+
+func TestParseOperation(t *testing.T) {
+	operation := parseOperation("service_name=resource_controller;service_version=V2;operation_id=GetResourceInstance")
+	assert.Equal(t, Operation{ServiceName: "resource_controller", ServiceVersion: "V2", OperationID: "GetResourceInstance"}, operation)
+	assert.Equal(t, "resource_controller.GetResourceInstance", operation.Name(http.MethodGet))
+
+	assert.Equal(t, "HTTP POST", parseOperation("").Name(http.MethodPost))
+}
+
+func TestTraceRequestWithoutOperation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	service, err := core.NewBaseService(&core.ServiceOptions{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	require.Nil(t, err)
+	tracer := &testTracer{}
+	Attach(service, &Options{Tracer: tracer})
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ResolveRequestURL(server.URL, "/ping", nil)
+	require.Nil(t, err)
+	request, err := builder.Build()
+	require.Nil(t, err)
+	_, err = service.Request(request, nil)
+	require.Nil(t, err)
+	require.Len(t, tracer.spans, 1)
+	assert.Equal(t, "HTTP GET", tracer.spans[0].name)
+}
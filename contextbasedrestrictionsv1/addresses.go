@@ -0,0 +1,263 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// addressFields returns the type, value and service reference of "address", whichever of the
+// Address models it is.
+func addressFields(address AddressIntf) (addressType string, value string, ref *ServiceRefValue) {
+	switch address := address.(type) {
+	case *Address:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), address.Ref
+	case *AddressIPAddress:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressIPAddressRange:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressSubnet:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressVPC:
+		return core.StringNilMapper(address.Type), core.StringNilMapper(address.Value), nil
+	case *AddressServiceRef:
+		return core.StringNilMapper(address.Type), "", address.Ref
+	}
+	return
+}
+
+// isIPAddressType returns true if addresses of type "addressType" denote IP addresses.
+func isIPAddressType(addressType string) bool {
+	switch addressType {
+	case AddressTypeIpaddressConst, AddressTypeIprangeConst, AddressTypeSubnetConst:
+		return true
+	}
+	return false
+}
+
+// IPRange : An inclusive range of IP addresses of the same family. IPv4-mapped IPv6 addresses
+// are converted to IPv4 addresses.
+type IPRange struct {
+	First netip.Addr
+	Last  netip.Addr
+}
+
+// ParseAddress returns the range of IP addresses denoted by an "ipAddress", "ipRange" or
+// "subnet" address, in any of its IPv4 or IPv6 forms.
+func ParseAddress(address AddressIntf) (IPRange, error) {
+	addressType, value, _ := addressFields(address)
+	return parseIPRange(addressType, value)
+}
+
+// parseIPRange parses the value of an "ipAddress", "ipRange" or "subnet" address.
+func parseIPRange(addressType string, value string) (r IPRange, err error) {
+	switch addressType {
+	case AddressTypeIpaddressConst:
+		r.First, err = netip.ParseAddr(value)
+		r.First = r.First.Unmap()
+		r.Last = r.First
+	case AddressTypeIprangeConst:
+		first, last, found := strings.Cut(value, "-")
+		if !found {
+			err = fmt.Errorf("the range is not in the 'first-last' format")
+			break
+		}
+		if r.First, err = netip.ParseAddr(strings.TrimSpace(first)); err != nil {
+			break
+		}
+		if r.Last, err = netip.ParseAddr(strings.TrimSpace(last)); err != nil {
+			break
+		}
+		r.First, r.Last = r.First.Unmap(), r.Last.Unmap()
+		if r.First.BitLen() != r.Last.BitLen() || r.First.Compare(r.Last) > 0 {
+			err = fmt.Errorf("the first address must precede the last one and be of the same family")
+		}
+	case AddressTypeSubnetConst:
+		var prefix netip.Prefix
+		prefix, err = netip.ParsePrefix(value)
+		if err == nil {
+			r = PrefixRange(prefix)
+		}
+	default:
+		err = fmt.Errorf("'%s' is not an IP address type", addressType)
+	}
+	if err != nil {
+		errMsg := fmt.Sprintf("invalid %s address '%s': %s", addressType, value, err.Error())
+		err = core.SDKErrorf(err, errMsg, "invalid-address", common.GetComponentInfo())
+	}
+	return
+}
+
+// PrefixRange returns the range of the addresses in "prefix".
+func PrefixRange(prefix netip.Prefix) IPRange {
+	if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+	}
+	prefix = prefix.Masked()
+	first := prefix.Addr()
+	bytes := first.AsSlice()
+	for bit := prefix.Bits(); bit < len(bytes)*8; bit++ {
+		bytes[bit/8] |= 0x80 >> (bit % 8)
+	}
+	last, _ := netip.AddrFromSlice(bytes)
+	return IPRange{First: first, Last: last}
+}
+
+// Contains returns true if "addr" is in the range.
+func (r IPRange) Contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.BitLen() == r.First.BitLen() && r.First.Compare(addr) <= 0 && addr.Compare(r.Last) <= 0
+}
+
+// Overlaps returns true if the range has at least one address in common with "other".
+func (r IPRange) Overlaps(other IPRange) bool {
+	return r.First.BitLen() == other.First.BitLen() && r.First.Compare(other.Last) <= 0 && other.First.Compare(r.Last) <= 0
+}
+
+// Prefixes returns the smallest set of CIDR prefixes that cover exactly the range.
+func (r IPRange) Prefixes() (prefixes []netip.Prefix) {
+	for first := r.First; first.IsValid() && first.Compare(r.Last) <= 0; {
+		// Use the largest prefix that starts at "first" and ends within the range.
+		for bits := 0; bits <= first.BitLen(); bits++ {
+			prefix := netip.PrefixFrom(first, bits)
+			if prefix.Masked().Addr() != first {
+				continue
+			}
+			last := PrefixRange(prefix).Last
+			if last.Compare(r.Last) <= 0 {
+				prefixes = append(prefixes, prefix)
+				first = last.Next()
+				break
+			}
+		}
+	}
+	return
+}
+
+// String returns the range as an address, a CIDR prefix or a "first-last" range.
+func (r IPRange) String() string {
+	if r.First == r.Last {
+		return r.First.String()
+	}
+	if prefixes := r.Prefixes(); len(prefixes) == 1 {
+		return prefixes[0].String()
+	}
+	return r.First.String() + "-" + r.Last.String()
+}
+
+// Address returns the range as an AddressIPAddress, AddressSubnet or AddressIPAddressRange,
+// whichever describes it in a single address.
+func (r IPRange) Address() AddressIntf {
+	if r.First == r.Last {
+		return &AddressIPAddress{Type: core.StringPtr(AddressIPAddressTypeIpaddressConst), Value: core.StringPtr(r.First.String())}
+	}
+	if prefixes := r.Prefixes(); len(prefixes) == 1 {
+		return &AddressSubnet{Type: core.StringPtr(AddressSubnetTypeSubnetConst), Value: core.StringPtr(prefixes[0].String())}
+	}
+	return &AddressIPAddressRange{Type: core.StringPtr(AddressIPAddressRangeTypeIprangeConst), Value: core.StringPtr(r.First.String() + "-" + r.Last.String())}
+}
+
+// compareIPRanges orders IPv4 ranges before IPv6 ones, then by first and last address.
+func compareIPRanges(a IPRange, b IPRange) int {
+	if a.First.BitLen() != b.First.BitLen() {
+		return a.First.BitLen() - b.First.BitLen()
+	}
+	if c := a.First.Compare(b.First); c != 0 {
+		return c
+	}
+	return a.Last.Compare(b.Last)
+}
+
+// MergeRanges returns the sorted ranges that cover the same addresses as "ranges", in which the
+// overlapping and adjacent ranges are merged.
+func MergeRanges(ranges []IPRange) (merged []IPRange) {
+	sorted := slices.Clone(ranges)
+	slices.SortFunc(sorted, compareIPRanges)
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && merged[n-1].First.BitLen() == r.First.BitLen() {
+			previous := &merged[n-1]
+			next := previous.Last.Next()
+			if !next.IsValid() || r.First.Compare(next) <= 0 {
+				if r.Last.Compare(previous.Last) > 0 {
+					previous.Last = r.Last
+				}
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return
+}
+
+// MinimalCIDRs returns the smallest set of CIDR prefixes that cover the same addresses as "ranges".
+func MinimalCIDRs(ranges []IPRange) (prefixes []netip.Prefix) {
+	for _, r := range MergeRanges(ranges) {
+		prefixes = append(prefixes, r.Prefixes()...)
+	}
+	return
+}
+
+// NormalizeAddresses returns the addresses of a zone with the overlapping and adjacent IP
+// addresses merged, each resulting range being described by a single address (see
+// IPRange.Address), followed by the other addresses without duplicates. It can be used to reduce
+// the number of addresses before a zone is created or replaced.
+func NormalizeAddresses(addresses []AddressIntf) (normalized []AddressIntf, err error) {
+	var ranges []IPRange
+	var others []AddressIntf
+	seen := make(map[string]bool)
+	for _, address := range addresses {
+		addressType, _, _ := addressFields(address)
+		if !isIPAddressType(addressType) {
+			if key := addressKey(address); !seen[key] {
+				seen[key] = true
+				others = append(others, address)
+			}
+			continue
+		}
+		var r IPRange
+		r, err = ParseAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+	for _, r := range MergeRanges(ranges) {
+		normalized = append(normalized, r.Address())
+	}
+	return append(normalized, others...), nil
+}
+
+// addressKey returns a string that identifies "address", for use in comparisons.
+func addressKey(address AddressIntf) string {
+	addressType, value, ref := addressFields(address)
+	if ref != nil {
+		value = strings.Join([]string{
+			core.StringNilMapper(ref.AccountID),
+			core.StringNilMapper(ref.ServiceType),
+			core.StringNilMapper(ref.ServiceName),
+			core.StringNilMapper(ref.ServiceInstance),
+			core.StringNilMapper(ref.Location),
+		}, "/")
+	}
+	return addressType + ":" + value
+}
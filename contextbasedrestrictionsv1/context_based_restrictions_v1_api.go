@@ -0,0 +1,76 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.92.1-44330004-20240620-143510
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ContextBasedRestrictionsV1API : The operations supported by the ContextBasedRestrictionsV1 service. This interface is satisfied by
+// *ContextBasedRestrictionsV1 and may be used to substitute a fake or a decorator for the service client.
+type ContextBasedRestrictionsV1API interface {
+	// CreateZoneWithContext : Create a network zone
+	CreateZoneWithContext(ctx context.Context, createZoneOptions *CreateZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+
+	// ListZonesWithContext : List network zones
+	ListZonesWithContext(ctx context.Context, listZonesOptions *ListZonesOptions) (result *ZoneList, response *core.DetailedResponse, err error)
+
+	// GetZoneWithContext : Get a network zone
+	GetZoneWithContext(ctx context.Context, getZoneOptions *GetZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+
+	// ReplaceZoneWithContext : Replace a network zone
+	ReplaceZoneWithContext(ctx context.Context, replaceZoneOptions *ReplaceZoneOptions) (result *Zone, response *core.DetailedResponse, err error)
+
+	// DeleteZoneWithContext : Delete a network zone
+	DeleteZoneWithContext(ctx context.Context, deleteZoneOptions *DeleteZoneOptions) (response *core.DetailedResponse, err error)
+
+	// ListAvailableServicerefTargetsWithContext : List available service reference targets
+	ListAvailableServicerefTargetsWithContext(ctx context.Context, listAvailableServicerefTargetsOptions *ListAvailableServicerefTargetsOptions) (result *ServiceRefTargetList, response *core.DetailedResponse, err error)
+
+	// GetServicerefTargetWithContext : Get service reference target for a specified service name
+	GetServicerefTargetWithContext(ctx context.Context, getServicerefTargetOptions *GetServicerefTargetOptions) (result *ServiceRefTarget, response *core.DetailedResponse, err error)
+
+	// CreateRuleWithContext : Create a rule
+	CreateRuleWithContext(ctx context.Context, createRuleOptions *CreateRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ListRulesWithContext : List rules
+	ListRulesWithContext(ctx context.Context, listRulesOptions *ListRulesOptions) (result *RuleList, response *core.DetailedResponse, err error)
+
+	// GetRuleWithContext : Get a rule
+	GetRuleWithContext(ctx context.Context, getRuleOptions *GetRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// ReplaceRuleWithContext : Replace a rule
+	ReplaceRuleWithContext(ctx context.Context, replaceRuleOptions *ReplaceRuleOptions) (result *Rule, response *core.DetailedResponse, err error)
+
+	// DeleteRuleWithContext : Delete a rule
+	DeleteRuleWithContext(ctx context.Context, deleteRuleOptions *DeleteRuleOptions) (response *core.DetailedResponse, err error)
+
+	// GetAccountSettingsWithContext : Get account settings
+	GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// ListAvailableServiceOperationsWithContext : List available service operations
+	ListAvailableServiceOperationsWithContext(ctx context.Context, listAvailableServiceOperationsOptions *ListAvailableServiceOperationsOptions) (result *OperationsList, response *core.DetailedResponse, err error)
+}
+
+// Verify that ContextBasedRestrictionsV1 implements the ContextBasedRestrictionsV1API interface.
+var _ ContextBasedRestrictionsV1API = (*ContextBasedRestrictionsV1)(nil)
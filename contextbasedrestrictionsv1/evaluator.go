@@ -0,0 +1,372 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The outcomes of the evaluation of an AccessRequest.
+const (
+	// The request is allowed.
+	AccessDecisionAllow = "allow"
+
+	// The request is denied by the enabled rules.
+	AccessDecisionDeny = "deny"
+
+	// The request is allowed, but a rule in report-only mode would deny it.
+	AccessDecisionReport = "report"
+)
+
+// The names of the attributes of resources and rule contexts that are interpreted by the
+// RuleEvaluator.
+const (
+	RuleAttributeAccountID     = "accountId"
+	RuleAttributeServiceName   = "serviceName"
+	RuleAttributeNetworkZoneID = "networkZoneId"
+	RuleAttributeEndpointType  = "endpointType"
+)
+
+// The operators of resource attributes.
+const (
+	ResourceAttributeOperatorStringEquals = "stringEquals"
+	ResourceAttributeOperatorStringMatch  = "stringMatch"
+)
+
+// AccessRequest : A simulated request to a resource that is protected by rules.
+type AccessRequest struct {
+	// The ID of the account of the resource.
+	AccountID string
+
+	// The name of the service of the resource (e.g. "cloud-object-storage").
+	ServiceName string
+
+	// The other attributes of the resource, keyed by name (e.g. "serviceInstance", "region",
+	// "resourceGroupId", "resourceType" or "resource").
+	Attributes map[string]string
+
+	// The tags of the resource, keyed by tag name (e.g. "env" for "env:prod").
+	Tags map[string]string
+
+	// The IP address from which the request is sent.
+	SourceIP string
+
+	// The CRN of the VPC from which the request is sent, if any.
+	SourceVPC string
+
+	// The service from which the request is sent, if any.
+	SourceService *ServiceRefValue
+
+	// The type of endpoint to which the request is sent: "public", "private" or "direct".
+	EndpointType string
+
+	// The ID of the API type of the request. If empty, the rules restricted to specific API
+	// types apply as well.
+	APIType string
+}
+
+// RuleEvaluation : The evaluation of a rule that applies to an AccessRequest.
+type RuleEvaluation struct {
+	Rule *Rule
+
+	// The enforcement mode of the rule ("enabled" or "report").
+	EnforcementMode string
+
+	// Whether the request satisfies a context of the rule.
+	Allowed bool
+
+	// The context that the request satisfies, if any.
+	Context *RuleContext
+}
+
+// AccessDecision : The outcome of the evaluation of an AccessRequest.
+type AccessDecision struct {
+	// AccessDecisionAllow, AccessDecisionDeny or AccessDecisionReport.
+	Decision string
+
+	// The rules that apply to the request, in the order in which they were provided.
+	Rules []RuleEvaluation
+}
+
+// Allowed returns true if the request is allowed, including in the case of AccessDecisionReport.
+func (decision *AccessDecision) Allowed() bool {
+	return decision.Decision != AccessDecisionDeny
+}
+
+// RuleEvaluator : Simulates the access decisions made by the Context Based Restrictions service
+// for a set of rules and zones, without calling the service. This can be used to test rule
+// changes before they are enabled.
+//
+// The request is evaluated against each rule that is not disabled and that applies to it, that
+// is, one of whose resources matches the resource of the request, and whose operations (if any)
+// include the API type of the request. The request satisfies a rule if it satisfies all the
+// attributes of one of the rule contexts; a rule without contexts is never satisfied. The request
+// is denied if enabled rules apply to it and none of them is satisfied. Rules in report-only mode
+// do not affect the decision, but AccessDecisionReport is returned if one of them is not
+// satisfied while the request is allowed.
+type RuleEvaluator struct {
+	rules []Rule
+	zones map[string]*evaluatedZone
+}
+
+// evaluatedZone : The addresses of a zone, with the IP addresses parsed.
+type evaluatedZone struct {
+	addresses []evaluatedAddress
+	excluded  []evaluatedAddress
+}
+
+type evaluatedAddress struct {
+	addressType string
+	value       string
+	ref         *ServiceRefValue
+	ipRange     IPRange
+}
+
+// NewRuleEvaluator returns a RuleEvaluator for "rules", whose network zones must be among
+// "zones". The zones must be complete, i.e. as returned by GetZone rather than ListZones, which
+// only returns a preview of their addresses.
+func NewRuleEvaluator(rules []Rule, zones []Zone) (evaluator *RuleEvaluator, err error) {
+	evaluator = &RuleEvaluator{
+		rules: rules,
+		zones: make(map[string]*evaluatedZone),
+	}
+	for _, zone := range zones {
+		evaluated := &evaluatedZone{}
+		if evaluated.addresses, err = evaluateAddresses(zone.Addresses); err != nil {
+			return nil, err
+		}
+		if evaluated.excluded, err = evaluateAddresses(zone.Excluded); err != nil {
+			return nil, err
+		}
+		evaluator.zones[core.StringNilMapper(zone.ID)] = evaluated
+	}
+	for _, rule := range rules {
+		for _, ruleContext := range rule.Contexts {
+			for _, attribute := range ruleContext.Attributes {
+				if core.StringNilMapper(attribute.Name) != RuleAttributeNetworkZoneID {
+					continue
+				}
+				for _, zoneID := range splitAttributeValue(attribute.Value) {
+					if evaluator.zones[zoneID] == nil {
+						errMsg := fmt.Sprintf("rule '%s' references zone '%s', which was not provided", core.StringNilMapper(rule.ID), zoneID)
+						return nil, core.SDKErrorf(nil, errMsg, "missing-zone", common.GetComponentInfo())
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+func evaluateAddresses(addresses []AddressIntf) (evaluated []evaluatedAddress, err error) {
+	for _, address := range addresses {
+		addressType, value, ref := addressFields(address)
+		item := evaluatedAddress{addressType: addressType, value: value, ref: ref}
+		if isIPAddressType(addressType) {
+			if item.ipRange, err = parseIPRange(addressType, value); err != nil {
+				return
+			}
+		}
+		evaluated = append(evaluated, item)
+	}
+	return
+}
+
+// NewRuleEvaluatorForAccount returns a RuleEvaluator for the current rules and zones of the
+// account identified by "accountID".
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) NewRuleEvaluatorForAccount(ctx context.Context, accountID string) (*RuleEvaluator, error) {
+	ruleList, _, err := contextBasedRestrictions.ListRulesWithContext(ctx, contextBasedRestrictions.NewListRulesOptions(accountID))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-rules-error")
+	}
+	zoneList, _, err := contextBasedRestrictions.ListZonesWithContext(ctx, contextBasedRestrictions.NewListZonesOptions(accountID))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-zones-error")
+	}
+	var zones []Zone
+	for _, summary := range zoneList.Zones {
+		zone, _, err := contextBasedRestrictions.GetZoneWithContext(ctx, contextBasedRestrictions.NewGetZoneOptions(core.StringNilMapper(summary.ID)))
+		if err != nil {
+			return nil, core.RepurposeSDKProblem(err, "get-zone-error")
+		}
+		zones = append(zones, *zone)
+	}
+	return NewRuleEvaluator(ruleList.Rules, zones)
+}
+
+// Evaluate returns the access decision for "request".
+func (evaluator *RuleEvaluator) Evaluate(request *AccessRequest) *AccessDecision {
+	decision := &AccessDecision{Decision: AccessDecisionAllow}
+	var enforced, allowed, reportDenied bool
+	for i := range evaluator.rules {
+		rule := &evaluator.rules[i]
+		mode := core.StringNilMapper(rule.EnforcementMode)
+		if mode == "" {
+			mode = RuleEnforcementModeEnabledConst
+		}
+		if mode == RuleEnforcementModeDisabledConst || !ruleApplies(rule, request) {
+			continue
+		}
+		evaluation := RuleEvaluation{Rule: rule, EnforcementMode: mode}
+		for j := range rule.Contexts {
+			if evaluator.contextSatisfied(&rule.Contexts[j], request) {
+				evaluation.Allowed = true
+				evaluation.Context = &rule.Contexts[j]
+				break
+			}
+		}
+		if mode == RuleEnforcementModeReportConst {
+			reportDenied = reportDenied || !evaluation.Allowed
+		} else {
+			enforced = true
+			allowed = allowed || evaluation.Allowed
+		}
+		decision.Rules = append(decision.Rules, evaluation)
+	}
+	if enforced && !allowed {
+		decision.Decision = AccessDecisionDeny
+	} else if reportDenied {
+		decision.Decision = AccessDecisionReport
+	}
+	return decision
+}
+
+// ruleApplies returns true if "rule" applies to the resource and API type of "request".
+func ruleApplies(rule *Rule, request *AccessRequest) bool {
+	if rule.Operations != nil && len(rule.Operations.APITypes) > 0 && request.APIType != "" {
+		found := slices.ContainsFunc(rule.Operations.APITypes, func(apiType NewRuleOperationsAPITypesItem) bool {
+			return core.StringNilMapper(apiType.APITypeID) == request.APIType
+		})
+		if !found {
+			return false
+		}
+	}
+	return slices.ContainsFunc(rule.Resources, func(resource Resource) bool {
+		return resourceMatches(&resource, request)
+	})
+}
+
+// resourceMatches returns true if the resource of "request" has all the attributes and tags of
+// "resource".
+func resourceMatches(resource *Resource, request *AccessRequest) bool {
+	for _, attribute := range resource.Attributes {
+		var value string
+		var found bool
+		switch name := core.StringNilMapper(attribute.Name); name {
+		case RuleAttributeAccountID:
+			value, found = request.AccountID, request.AccountID != ""
+		case RuleAttributeServiceName:
+			value, found = request.ServiceName, request.ServiceName != ""
+		default:
+			value, found = request.Attributes[name]
+		}
+		if !found || !attributeMatches(attribute.Operator, core.StringNilMapper(attribute.Value), value) {
+			return false
+		}
+	}
+	for _, tag := range resource.Tags {
+		value, found := request.Tags[core.StringNilMapper(tag.Name)]
+		if !found || !attributeMatches(tag.Operator, core.StringNilMapper(tag.Value), value) {
+			return false
+		}
+	}
+	return true
+}
+
+// attributeMatches returns true if "value" matches "expected" with "operator". The stringMatch
+// operator supports the "*" and "?" wildcards.
+func attributeMatches(operator *string, expected string, value string) bool {
+	if core.StringNilMapper(operator) == ResourceAttributeOperatorStringMatch {
+		pattern := strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(regexp.QuoteMeta(expected))
+		return regexp.MustCompile("^" + pattern + "$").MatchString(value)
+	}
+	return expected == value
+}
+
+// contextSatisfied returns true if "request" satisfies all the attributes of "ruleContext".
+// Attributes that the evaluator does not interpret are never satisfied.
+func (evaluator *RuleEvaluator) contextSatisfied(ruleContext *RuleContext, request *AccessRequest) bool {
+	for _, attribute := range ruleContext.Attributes {
+		values := splitAttributeValue(attribute.Value)
+		switch core.StringNilMapper(attribute.Name) {
+		case RuleAttributeNetworkZoneID:
+			if !slices.ContainsFunc(values, func(zoneID string) bool {
+				return evaluator.zones[zoneID].contains(request)
+			}) {
+				return false
+			}
+		case RuleAttributeEndpointType:
+			if !slices.Contains(values, request.EndpointType) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func splitAttributeValue(value *string) (values []string) {
+	for _, item := range strings.Split(core.StringNilMapper(value), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return
+}
+
+// contains returns true if the source of "request" is in the addresses of the zone and not in its
+// excluded addresses.
+func (zone *evaluatedZone) contains(request *AccessRequest) bool {
+	sourceIP, _ := netip.ParseAddr(request.SourceIP)
+	matches := func(address evaluatedAddress) bool {
+		switch address.addressType {
+		case AddressTypeIpaddressConst, AddressTypeIprangeConst, AddressTypeSubnetConst:
+			return sourceIP.IsValid() && address.ipRange.Contains(sourceIP)
+		case AddressTypeVPCConst:
+			return request.SourceVPC != "" && address.value == request.SourceVPC
+		case AddressTypeServicerefConst:
+			return serviceRefMatches(address.ref, request.SourceService)
+		}
+		return false
+	}
+	return slices.ContainsFunc(zone.addresses, matches) && !slices.ContainsFunc(zone.excluded, matches)
+}
+
+// serviceRefMatches returns true if "source" is the service referenced by "ref". The fields that
+// are not set in "ref" match any value.
+func serviceRefMatches(ref *ServiceRefValue, source *ServiceRefValue) bool {
+	if ref == nil || source == nil {
+		return false
+	}
+	fieldMatches := func(expected *string, value *string) bool {
+		return expected == nil || *expected == core.StringNilMapper(value)
+	}
+	return core.StringNilMapper(ref.AccountID) == core.StringNilMapper(source.AccountID) &&
+		fieldMatches(ref.ServiceType, source.ServiceType) &&
+		fieldMatches(ref.ServiceName, source.ServiceName) &&
+		fieldMatches(ref.ServiceInstance, source.ServiceInstance) &&
+		fieldMatches(ref.Location, source.Location)
+}
@@ -0,0 +1,233 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1_test
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ContextBasedRestrictionsV1 RuleEvaluator`, func() {
+	const accountID = mockservers.DefaultAccountID
+	const vpcCRN = "crn:v1:bluemix:public:is:us-south:a/testAccountID::vpc:vpc-1"
+	const readerAPIType = "crn:v1:bluemix:public:context-based-restrictions::::api-type:data-plane"
+
+	address := func(addressType string, value string) contextbasedrestrictionsv1.AddressIntf {
+		return &contextbasedrestrictionsv1.Address{Type: core.StringPtr(addressType), Value: core.StringPtr(value)}
+	}
+	attribute := func(name string, value string) contextbasedrestrictionsv1.RuleContextAttribute {
+		return contextbasedrestrictionsv1.RuleContextAttribute{Name: core.StringPtr(name), Value: core.StringPtr(value)}
+	}
+	resource := func(serviceName string) contextbasedrestrictionsv1.Resource {
+		return contextbasedrestrictionsv1.Resource{
+			Attributes: []contextbasedrestrictionsv1.ResourceAttribute{
+				{Name: core.StringPtr("accountId"), Value: core.StringPtr(accountID)},
+				{Name: core.StringPtr("serviceName"), Value: core.StringPtr(serviceName)},
+			},
+		}
+	}
+
+	var zones []contextbasedrestrictionsv1.Zone
+	var rules []contextbasedrestrictionsv1.Rule
+	var request *contextbasedrestrictionsv1.AccessRequest
+
+	BeforeEach(func() {
+		zones = []contextbasedrestrictionsv1.Zone{
+			{
+				ID: core.StringPtr("office"),
+				Addresses: []contextbasedrestrictionsv1.AddressIntf{
+					address("subnet", "10.0.0.0/16"),
+					address("ipRange", "192.168.1.10-192.168.1.20"),
+					address("ipAddress", "2001:db8::1"),
+				},
+				Excluded: []contextbasedrestrictionsv1.AddressIntf{
+					address("ipAddress", "10.0.5.5"),
+				},
+			},
+			{
+				ID: core.StringPtr("network"),
+				Addresses: []contextbasedrestrictionsv1.AddressIntf{
+					&contextbasedrestrictionsv1.AddressVPC{Type: core.StringPtr("vpc"), Value: core.StringPtr(vpcCRN)},
+					&contextbasedrestrictionsv1.AddressServiceRef{
+						Type: core.StringPtr("serviceRef"),
+						Ref:  &contextbasedrestrictionsv1.ServiceRefValue{AccountID: core.StringPtr(accountID), ServiceName: core.StringPtr("containers-kubernetes")},
+					},
+				},
+			},
+		}
+		rules = []contextbasedrestrictionsv1.Rule{
+			{
+				ID:        core.StringPtr("cos-office"),
+				Resources: []contextbasedrestrictionsv1.Resource{resource("cloud-object-storage")},
+				Contexts: []contextbasedrestrictionsv1.RuleContext{
+					{Attributes: []contextbasedrestrictionsv1.RuleContextAttribute{attribute("networkZoneId", "office"), attribute("endpointType", "private,direct")}},
+					{Attributes: []contextbasedrestrictionsv1.RuleContextAttribute{attribute("networkZoneId", "network")}},
+				},
+				EnforcementMode: core.StringPtr("enabled"),
+			},
+		}
+		request = &contextbasedrestrictionsv1.AccessRequest{
+			AccountID:    accountID,
+			ServiceName:  "cloud-object-storage",
+			SourceIP:     "10.0.1.1",
+			EndpointType: "private",
+		}
+	})
+
+	evaluate := func() *contextbasedrestrictionsv1.AccessDecision {
+		evaluator, err := contextbasedrestrictionsv1.NewRuleEvaluator(rules, zones)
+		Expect(err).To(BeNil())
+		return evaluator.Evaluate(request)
+	}
+
+	It(`Allow the requests that satisfy a context`, func() {
+		decision := evaluate()
+		Expect(decision.Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionAllow))
+		Expect(decision.Allowed()).To(BeTrue())
+		Expect(decision.Rules).To(HaveLen(1))
+		Expect(decision.Rules[0].Allowed).To(BeTrue())
+		Expect(decision.Rules[0].Context).To(Equal(&rules[0].Contexts[0]))
+
+		for _, sourceIP := range []string{"192.168.1.15", "2001:db8::1", "::ffff:10.0.200.1"} {
+			request.SourceIP = sourceIP
+			Expect(evaluate().Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionAllow), sourceIP)
+		}
+
+		request.SourceIP = ""
+		request.SourceVPC = vpcCRN
+		request.EndpointType = "public"
+		Expect(evaluate().Rules[0].Context).To(Equal(&rules[0].Contexts[1]))
+
+		request.SourceVPC = ""
+		request.SourceService = &contextbasedrestrictionsv1.ServiceRefValue{
+			AccountID:       core.StringPtr(accountID),
+			ServiceName:     core.StringPtr("containers-kubernetes"),
+			ServiceInstance: core.StringPtr("cluster-1"),
+		}
+		Expect(evaluate().Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionAllow))
+	})
+	It(`Deny the requests that satisfy no context`, func() {
+		for _, sourceIP := range []string{"10.1.0.1", "10.0.5.5", "192.168.1.21", "2001:db8::2", "not-an-ip"} {
+			request.SourceIP = sourceIP
+			Expect(evaluate().Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionDeny), sourceIP)
+		}
+
+		request.SourceIP = "10.0.1.1"
+		request.EndpointType = "public"
+		decision := evaluate()
+		Expect(decision.Allowed()).To(BeFalse())
+		Expect(decision.Rules[0].Context).To(BeNil())
+
+		rules[0].Contexts = nil
+		request.EndpointType = "private"
+		Expect(evaluate().Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionDeny))
+	})
+	It(`Only evaluate the rules that apply to the request`, func() {
+		request.ServiceName = "kms"
+		request.SourceIP = "172.16.0.1"
+		decision := evaluate()
+		Expect(decision.Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionAllow))
+		Expect(decision.Rules).To(BeEmpty())
+
+		request.ServiceName = "cloud-object-storage"
+		rules[0].Resources[0].Attributes = append(rules[0].Resources[0].Attributes, contextbasedrestrictionsv1.ResourceAttribute{
+			Name:     core.StringPtr("resource"),
+			Value:    core.StringPtr("bucket-*/logs"),
+			Operator: core.StringPtr("stringMatch"),
+		})
+		rules[0].Resources[0].Tags = []contextbasedrestrictionsv1.ResourceTagAttribute{
+			{Name: core.StringPtr("env"), Value: core.StringPtr("prod")},
+		}
+		request.Attributes = map[string]string{"resource": "bucket-a/b/logs"}
+		Expect(evaluate().Rules).To(BeEmpty())
+		request.Tags = map[string]string{"env": "prod"}
+		Expect(evaluate().Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionDeny))
+		request.Attributes["resource"] = "other/logs"
+		Expect(evaluate().Rules).To(BeEmpty())
+
+		request.Attributes["resource"] = "bucket-a/logs"
+		rules[0].Operations = &contextbasedrestrictionsv1.NewRuleOperations{
+			APITypes: []contextbasedrestrictionsv1.NewRuleOperationsAPITypesItem{{APITypeID: core.StringPtr(readerAPIType)}},
+		}
+		request.APIType = "crn:v1:bluemix:public:context-based-restrictions::::api-type:management"
+		Expect(evaluate().Rules).To(BeEmpty())
+		request.APIType = readerAPIType
+		Expect(evaluate().Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionDeny))
+	})
+	It(`Honor the enforcement modes`, func() {
+		request.SourceIP = "172.16.0.1"
+		rules[0].EnforcementMode = core.StringPtr("report")
+		decision := evaluate()
+		Expect(decision.Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionReport))
+		Expect(decision.Allowed()).To(BeTrue())
+		Expect(decision.Rules[0].EnforcementMode).To(Equal("report"))
+
+		rules[0].EnforcementMode = core.StringPtr("disabled")
+		decision = evaluate()
+		Expect(decision.Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionAllow))
+		Expect(decision.Rules).To(BeEmpty())
+
+		// The request is allowed if any of the enabled rules is satisfied.
+		rules[0].EnforcementMode = core.StringPtr("enabled")
+		rules = append(rules, contextbasedrestrictionsv1.Rule{
+			ID:        core.StringPtr("cos-public"),
+			Resources: []contextbasedrestrictionsv1.Resource{resource("cloud-object-storage")},
+			Contexts: []contextbasedrestrictionsv1.RuleContext{
+				{Attributes: []contextbasedrestrictionsv1.RuleContextAttribute{attribute("endpointType", "private")}},
+			},
+		})
+		decision = evaluate()
+		Expect(decision.Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionAllow))
+		Expect(decision.Rules).To(HaveLen(2))
+		Expect(decision.Rules[0].Allowed).To(BeFalse())
+		Expect(decision.Rules[1].Allowed).To(BeTrue())
+	})
+	It(`Reject invalid zones and missing zones`, func() {
+		zones[0].Addresses = append(zones[0].Addresses, address("ipRange", "10.0.0.9-10.0.0.1"))
+		_, err := contextbasedrestrictionsv1.NewRuleEvaluator(rules, zones)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("10.0.0.9-10.0.0.1"))
+
+		_, err = contextbasedrestrictionsv1.NewRuleEvaluator(rules, zones[1:])
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("zone 'office'"))
+	})
+	It(`Load the rules and zones of an account`, func() {
+		fake := mockservers.NewContextBasedRestrictionsV1Server()
+		defer fake.Close()
+		contextBasedRestrictionsService, err := fake.NewClient()
+		Expect(err).To(BeNil())
+
+		zones[0].Addresses = append(zones[0].Addresses, address("ipAddress", "10.1.0.1"), address("ipAddress", "10.1.0.2"),
+			address("ipAddress", "10.1.0.3"), address("ipAddress", "10.1.0.4"), address("ipAddress", "172.16.0.1"))
+		for _, zone := range zones {
+			fake.AddZone(zone)
+		}
+		fake.AddRule(rules[0])
+
+		evaluator, err := contextBasedRestrictionsService.NewRuleEvaluatorForAccount(context.Background(), accountID)
+		Expect(err).To(BeNil())
+		// The last address of the zone is not in the preview returned by ListZones.
+		request.SourceIP = "172.16.0.1"
+		Expect(evaluator.Evaluate(request).Decision).To(Equal(contextbasedrestrictionsv1.AccessDecisionAllow))
+	})
+})
@@ -0,0 +1,147 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The default values of the UpdateOptions fields.
+const (
+	DefaultUpdateMaxAttempts   = 5
+	DefaultUpdateRetryInterval = 100 * time.Millisecond
+)
+
+// ErrConcurrentModification is returned (wrapped) by UpdateZone and UpdateRule when the zone or
+// rule is modified concurrently on every attempt to update it.
+var ErrConcurrentModification = errors.New("the resource was modified concurrently")
+
+// UpdateOptions : The options for the UpdateZone and UpdateRule methods.
+type UpdateOptions struct {
+	// The maximum number of read-modify-write attempts. Defaults to DefaultUpdateMaxAttempts.
+	MaxAttempts int
+
+	// The interval before the first retry, which doubles for each subsequent retry. Defaults to
+	// DefaultUpdateRetryInterval.
+	RetryInterval time.Duration
+}
+
+// UpdateZone updates the zone identified by "zoneID" with "mutate", which modifies the current
+// zone in place. The zone is retrieved, modified and replaced with the ETag of the retrieved
+// version, so that concurrent changes are not overwritten; if the zone was modified in the
+// meantime, the whole sequence, including the call to "mutate", is retried. An error returned by
+// "mutate" stops the update and is returned (wrapped).
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) UpdateZone(ctx context.Context, zoneID string, mutate func(*Zone) error, options *UpdateOptions) (result *Zone, response *core.DetailedResponse, err error) {
+	return readModifyWrite(ctx, options, "zone", zoneID,
+		func(ctx context.Context) (*Zone, *core.DetailedResponse, error) {
+			return contextBasedRestrictions.GetZoneWithContext(ctx, contextBasedRestrictions.NewGetZoneOptions(zoneID))
+		},
+		mutate,
+		func(ctx context.Context, zone *Zone, etag string) (*Zone, *core.DetailedResponse, error) {
+			replaceOptions := contextBasedRestrictions.NewReplaceZoneOptions(zoneID, etag)
+			replaceOptions.Name = zone.Name
+			replaceOptions.AccountID = zone.AccountID
+			replaceOptions.Description = zone.Description
+			replaceOptions.Addresses = zone.Addresses
+			replaceOptions.Excluded = zone.Excluded
+			return contextBasedRestrictions.ReplaceZoneWithContext(ctx, replaceOptions)
+		})
+}
+
+// UpdateRule updates the rule identified by "ruleID" with "mutate", in the same way that
+// UpdateZone updates a zone.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) UpdateRule(ctx context.Context, ruleID string, mutate func(*Rule) error, options *UpdateOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	return readModifyWrite(ctx, options, "rule", ruleID,
+		func(ctx context.Context) (*Rule, *core.DetailedResponse, error) {
+			return contextBasedRestrictions.GetRuleWithContext(ctx, contextBasedRestrictions.NewGetRuleOptions(ruleID))
+		},
+		mutate,
+		func(ctx context.Context, rule *Rule, etag string) (*Rule, *core.DetailedResponse, error) {
+			replaceOptions := contextBasedRestrictions.NewReplaceRuleOptions(ruleID, etag)
+			replaceOptions.Description = rule.Description
+			replaceOptions.Contexts = rule.Contexts
+			replaceOptions.Resources = rule.Resources
+			replaceOptions.Operations = rule.Operations
+			replaceOptions.EnforcementMode = rule.EnforcementMode
+			return contextBasedRestrictions.ReplaceRuleWithContext(ctx, replaceOptions)
+		})
+}
+
+// readModifyWrite retrieves an entity with "get", modifies it with "mutate" and replaces it with
+// "replace", retrying the sequence when the replacement fails because the ETag no longer matches.
+func readModifyWrite[T any](ctx context.Context, options *UpdateOptions, kind string, id string,
+	get func(ctx context.Context) (*T, *core.DetailedResponse, error),
+	mutate func(*T) error,
+	replace func(ctx context.Context, entity *T, etag string) (*T, *core.DetailedResponse, error)) (result *T, response *core.DetailedResponse, err error) {
+	maxAttempts := DefaultUpdateMaxAttempts
+	retryInterval := DefaultUpdateRetryInterval
+	if options != nil && options.MaxAttempts > 0 {
+		maxAttempts = options.MaxAttempts
+	}
+	if options != nil && options.RetryInterval > 0 {
+		retryInterval = options.RetryInterval
+	}
+
+	for attempt := 1; ; attempt++ {
+		var entity *T
+		entity, response, err = get(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, fmt.Sprintf("get-%s-error", kind))
+			return
+		}
+		etag := response.GetHeaders().Get("ETag")
+		if etag == "" {
+			errMsg := fmt.Sprintf("no ETag was returned for %s '%s'", kind, id)
+			err = core.SDKErrorf(nil, errMsg, "missing-etag", common.GetComponentInfo())
+			return
+		}
+		if err = mutate(entity); err != nil {
+			err = core.SDKErrorf(err, "", fmt.Sprintf("update-%s-mutate-error", kind), common.GetComponentInfo())
+			return
+		}
+
+		result, response, err = replace(ctx, entity, etag)
+		if err == nil {
+			return
+		}
+		if response == nil || response.StatusCode != http.StatusPreconditionFailed {
+			err = core.RepurposeSDKProblem(err, fmt.Sprintf("replace-%s-error", kind))
+			return
+		}
+		if attempt >= maxAttempts {
+			errMsg := fmt.Sprintf("%s '%s' was modified concurrently on each of %d attempts to update it", kind, id, attempt)
+			err = core.SDKErrorf(ErrConcurrentModification, errMsg, fmt.Sprintf("update-%s-conflict", kind), common.GetComponentInfo())
+			return
+		}
+
+		timer := time.NewTimer(retryInterval << (attempt - 1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = core.SDKErrorf(ctx.Err(), "", fmt.Sprintf("update-%s-cancelled", kind), common.GetComponentInfo())
+			return
+		case <-timer.C:
+		}
+	}
+}
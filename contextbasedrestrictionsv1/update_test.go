@@ -0,0 +1,169 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ContextBasedRestrictionsV1 UpdateZone and UpdateRule`, func() {
+	var fake *mockservers.ContextBasedRestrictionsV1Server
+	var contextBasedRestrictionsService *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
+	var zoneID string
+	var ruleID string
+	options := &contextbasedrestrictionsv1.UpdateOptions{MaxAttempts: 3, RetryInterval: time.Millisecond}
+
+	address := func(value string) contextbasedrestrictionsv1.AddressIntf {
+		return &contextbasedrestrictionsv1.AddressIPAddress{Type: core.StringPtr("ipAddress"), Value: core.StringPtr(value)}
+	}
+
+	addressValues := func(addresses []contextbasedrestrictionsv1.AddressIntf) (result []string) {
+		for _, item := range addresses {
+			if item, ok := item.(*contextbasedrestrictionsv1.AddressIPAddress); ok {
+				result = append(result, *item.Value)
+			}
+		}
+		return
+	}
+
+	// concurrentZoneEdit adds "value" to the addresses of the zone, as another client would.
+	concurrentZoneEdit := func(value string) {
+		zone, response, err := contextBasedRestrictionsService.GetZone(contextBasedRestrictionsService.NewGetZoneOptions(zoneID))
+		Expect(err).To(BeNil())
+		replaceOptions := contextBasedRestrictionsService.NewReplaceZoneOptions(zoneID, response.GetHeaders().Get("ETag"))
+		replaceOptions.Name = zone.Name
+		replaceOptions.AccountID = zone.AccountID
+		replaceOptions.Addresses = append(zone.Addresses, address(value))
+		_, _, err = contextBasedRestrictionsService.ReplaceZone(replaceOptions)
+		Expect(err).To(BeNil())
+	}
+
+	BeforeEach(func() {
+		var err error
+		fake = mockservers.NewContextBasedRestrictionsV1Server()
+		contextBasedRestrictionsService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+
+		zoneID = *fake.AddZone(contextbasedrestrictionsv1.Zone{
+			Name:      core.StringPtr("office"),
+			Addresses: []contextbasedrestrictionsv1.AddressIntf{address("10.0.0.1")},
+		}).ID
+		ruleID = *fake.AddRule(contextbasedrestrictionsv1.Rule{
+			Resources: []contextbasedrestrictionsv1.Resource{
+				{Attributes: []contextbasedrestrictionsv1.ResourceAttribute{
+					{Name: core.StringPtr("accountId"), Value: core.StringPtr(mockservers.DefaultAccountID)},
+					{Name: core.StringPtr("serviceName"), Value: core.StringPtr("kms")},
+				}},
+			},
+			EnforcementMode: core.StringPtr("report"),
+		}).ID
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It(`Update a zone`, func() {
+		zone, response, err := contextBasedRestrictionsService.UpdateZone(context.Background(), zoneID, func(zone *contextbasedrestrictionsv1.Zone) error {
+			zone.Addresses = append(zone.Addresses, address("10.0.0.2"))
+			zone.Description = core.StringPtr("Office network")
+			return nil
+		}, nil)
+		Expect(err).To(BeNil())
+		Expect(response.GetHeaders().Get("ETag")).ToNot(BeEmpty())
+		Expect(zone.Addresses).To(HaveLen(2))
+		Expect(*zone.Name).To(Equal("office"))
+		Expect(*zone.Description).To(Equal("Office network"))
+	})
+	It(`Retry the update of a zone modified concurrently`, func() {
+		calls := 0
+		zone, _, err := contextBasedRestrictionsService.UpdateZone(context.Background(), zoneID, func(zone *contextbasedrestrictionsv1.Zone) error {
+			calls++
+			if calls == 1 {
+				concurrentZoneEdit("10.0.0.3")
+			}
+			zone.Addresses = append(zone.Addresses, address("10.0.0.2"))
+			return nil
+		}, options)
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal(2))
+		Expect(zone.Addresses).To(HaveLen(3))
+		Expect(addressValues(zone.Addresses)).To(Equal([]string{"10.0.0.1", "10.0.0.3", "10.0.0.2"}))
+	})
+	It(`Give up after the maximum number of attempts`, func() {
+		calls := 0
+		_, _, err := contextBasedRestrictionsService.UpdateZone(context.Background(), zoneID, func(zone *contextbasedrestrictionsv1.Zone) error {
+			calls++
+			concurrentZoneEdit(fmt.Sprintf("10.0.1.%d", calls))
+			return nil
+		}, options)
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, contextbasedrestrictionsv1.ErrConcurrentModification)).To(BeTrue())
+		Expect(calls).To(Equal(3))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		_, _, err = contextBasedRestrictionsService.UpdateZone(ctx, zoneID, func(zone *contextbasedrestrictionsv1.Zone) error {
+			concurrentZoneEdit("10.0.2.1")
+			cancel()
+			return nil
+		}, &contextbasedrestrictionsv1.UpdateOptions{RetryInterval: time.Hour})
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+	})
+	It(`Stop on the errors of the mutation and of the service`, func() {
+		failure := errors.New("no change")
+		_, _, err := contextBasedRestrictionsService.UpdateZone(context.Background(), zoneID, func(zone *contextbasedrestrictionsv1.Zone) error {
+			return failure
+		}, nil)
+		Expect(errors.Is(err, failure)).To(BeTrue())
+
+		_, response, err := contextBasedRestrictionsService.UpdateZone(context.Background(), "missing", func(zone *contextbasedrestrictionsv1.Zone) error {
+			return nil
+		}, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+
+		_, response, err = contextBasedRestrictionsService.UpdateZone(context.Background(), zoneID, func(zone *contextbasedrestrictionsv1.Zone) error {
+			zone.Name = core.StringPtr("")
+			return nil
+		}, nil)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+	})
+	It(`Update a rule`, func() {
+		rule, _, err := contextBasedRestrictionsService.UpdateRule(context.Background(), ruleID, func(rule *contextbasedrestrictionsv1.Rule) error {
+			rule.EnforcementMode = core.StringPtr("enabled")
+			rule.Contexts = append(rule.Contexts, contextbasedrestrictionsv1.RuleContext{
+				Attributes: []contextbasedrestrictionsv1.RuleContextAttribute{
+					{Name: core.StringPtr("networkZoneId"), Value: core.StringPtr(zoneID)},
+				},
+			})
+			return nil
+		}, nil)
+		Expect(err).To(BeNil())
+		Expect(*rule.EnforcementMode).To(Equal("enabled"))
+		Expect(rule.Contexts).To(HaveLen(1))
+		Expect(rule.Resources).To(HaveLen(1))
+	})
+})
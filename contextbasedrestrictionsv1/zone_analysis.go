@@ -0,0 +1,210 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The kinds of ZoneFinding.
+const (
+	// The address is not valid.
+	ZoneFindingKindInvalid = "invalid"
+
+	// The address overlaps another address of the same zone.
+	ZoneFindingKindRedundant = "redundant"
+
+	// The address overlaps an address of another zone.
+	ZoneFindingKindDuplicate = "duplicate"
+
+	// The address covers a range at least as large as the broad prefix length.
+	ZoneFindingKindBroad = "broad"
+)
+
+// The default values of the ZoneAnalysisOptions fields.
+const (
+	DefaultBroadPrefixLengthIPv4 = 8
+	DefaultBroadPrefixLengthIPv6 = 32
+)
+
+// ZoneAnalysisOptions : The options for AnalyzeZones.
+type ZoneAnalysisOptions struct {
+	// The addresses that cover a CIDR prefix of this length or shorter are reported as broad.
+	// Default to DefaultBroadPrefixLengthIPv4 and DefaultBroadPrefixLengthIPv6.
+	BroadPrefixLengthIPv4 int
+	BroadPrefixLengthIPv6 int
+}
+
+// ZoneFinding : An issue with an address of a zone.
+type ZoneFinding struct {
+	// The kind of issue (e.g. ZoneFindingKindDuplicate).
+	Kind string
+
+	ZoneID  string
+	Address string
+
+	// The zone and address that the address overlaps, for redundant and duplicate addresses.
+	OtherZoneID  string
+	OtherAddress string
+
+	Message string
+}
+
+// ZoneAddressSummary : The addresses of a zone before and after normalization.
+type ZoneAddressSummary struct {
+	ZoneID string
+
+	// The number of addresses of the zone.
+	AddressCount int
+
+	// The addresses returned by NormalizeAddresses, or nil if the zone has invalid addresses.
+	Normalized []AddressIntf
+}
+
+// ZoneAnalysis : The outcome of AnalyzeZones.
+type ZoneAnalysis struct {
+	Findings []ZoneFinding
+	Zones    []ZoneAddressSummary
+}
+
+// FindingsOfKind returns the findings of the specified kind.
+func (analysis *ZoneAnalysis) FindingsOfKind(kind string) (findings []ZoneFinding) {
+	for _, finding := range analysis.Findings {
+		if finding.Kind == kind {
+			findings = append(findings, finding)
+		}
+	}
+	return
+}
+
+// zoneAddressEntry : An address of a zone, with its range if it is an IP address.
+type zoneAddressEntry struct {
+	zoneID  string
+	value   string
+	key     string
+	ipRange IPRange
+	isIP    bool
+}
+
+// AnalyzeZones checks the addresses (but not the excluded addresses) of "zones" for invalid,
+// redundant, duplicate and overly broad addresses, and computes the normalized addresses of each
+// zone.
+func AnalyzeZones(zones []Zone, options *ZoneAnalysisOptions) *ZoneAnalysis {
+	broadIPv4, broadIPv6 := DefaultBroadPrefixLengthIPv4, DefaultBroadPrefixLengthIPv6
+	if options != nil && options.BroadPrefixLengthIPv4 > 0 {
+		broadIPv4 = options.BroadPrefixLengthIPv4
+	}
+	if options != nil && options.BroadPrefixLengthIPv6 > 0 {
+		broadIPv6 = options.BroadPrefixLengthIPv6
+	}
+
+	analysis := &ZoneAnalysis{}
+	var entries []zoneAddressEntry
+	for _, zone := range zones {
+		zoneID := core.StringNilMapper(zone.ID)
+		summary := ZoneAddressSummary{ZoneID: zoneID, AddressCount: len(zone.Addresses)}
+		summary.Normalized, _ = NormalizeAddresses(zone.Addresses)
+		analysis.Zones = append(analysis.Zones, summary)
+
+		for _, address := range zone.Addresses {
+			addressType, value, _ := addressFields(address)
+			entry := zoneAddressEntry{zoneID: zoneID, value: value, key: addressKey(address)}
+			if entry.value == "" {
+				entry.value = entry.key
+			}
+			if isIPAddressType(addressType) {
+				var err error
+				entry.ipRange, err = ParseAddress(address)
+				if err != nil {
+					analysis.Findings = append(analysis.Findings, ZoneFinding{
+						Kind:    ZoneFindingKindInvalid,
+						ZoneID:  zoneID,
+						Address: value,
+						Message: err.Error(),
+					})
+					continue
+				}
+				entry.isIP = true
+
+				broad := broadIPv6
+				if entry.ipRange.First.Is4() {
+					broad = broadIPv4
+				}
+				if slices.ContainsFunc(entry.ipRange.Prefixes(), func(prefix netip.Prefix) bool { return prefix.Bits() <= broad }) {
+					analysis.Findings = append(analysis.Findings, ZoneFinding{
+						Kind:    ZoneFindingKindBroad,
+						ZoneID:  zoneID,
+						Address: value,
+						Message: fmt.Sprintf("the address '%s' covers a /%d range or larger", value, broad),
+					})
+				}
+			}
+			entries = append(entries, entry)
+		}
+	}
+	analysis.Findings = append(analysis.Findings, overlappingAddresses(entries)...)
+	return analysis
+}
+
+// overlappingAddresses returns the redundant and duplicate findings for "entries".
+func overlappingAddresses(entries []zoneAddressEntry) (findings []ZoneFinding) {
+	overlap := func(entry zoneAddressEntry, other zoneAddressEntry) {
+		finding := ZoneFinding{
+			Kind:         ZoneFindingKindDuplicate,
+			ZoneID:       entry.zoneID,
+			Address:      entry.value,
+			OtherZoneID:  other.zoneID,
+			OtherAddress: other.value,
+			Message:      fmt.Sprintf("the address '%s' overlaps the address '%s' of zone '%s'", entry.value, other.value, other.zoneID),
+		}
+		if entry.zoneID == other.zoneID {
+			finding.Kind = ZoneFindingKindRedundant
+			finding.Message = fmt.Sprintf("the address '%s' overlaps the address '%s' of the same zone", entry.value, other.value)
+		}
+		findings = append(findings, finding)
+	}
+
+	// The IP addresses are sorted so that only the following ranges that start before the end of
+	// a range need to be compared with it.
+	var ipEntries []zoneAddressEntry
+	otherEntries := make(map[string]zoneAddressEntry)
+	for _, entry := range entries {
+		if entry.isIP {
+			ipEntries = append(ipEntries, entry)
+		} else if other, found := otherEntries[entry.key]; found {
+			overlap(entry, other)
+		} else {
+			otherEntries[entry.key] = entry
+		}
+	}
+	slices.SortStableFunc(ipEntries, func(a zoneAddressEntry, b zoneAddressEntry) int {
+		return compareIPRanges(a.ipRange, b.ipRange)
+	})
+	for i, other := range ipEntries {
+		for _, entry := range ipEntries[i+1:] {
+			if !entry.ipRange.Overlaps(other.ipRange) {
+				break
+			}
+			overlap(entry, other)
+		}
+	}
+	return
+}
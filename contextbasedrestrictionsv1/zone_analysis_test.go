@@ -0,0 +1,199 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1_test
+
+import (
+	"net/netip"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ContextBasedRestrictionsV1 zone analysis`, func() {
+	address := func(addressType string, value string) contextbasedrestrictionsv1.AddressIntf {
+		return &contextbasedrestrictionsv1.Address{Type: core.StringPtr(addressType), Value: core.StringPtr(value)}
+	}
+	parse := func(addressType string, value string) contextbasedrestrictionsv1.IPRange {
+		r, err := contextbasedrestrictionsv1.ParseAddress(address(addressType, value))
+		Expect(err).To(BeNil())
+		return r
+	}
+	values := func(addresses []contextbasedrestrictionsv1.AddressIntf) (result []string) {
+		for _, item := range addresses {
+			switch item := item.(type) {
+			case *contextbasedrestrictionsv1.AddressIPAddress:
+				result = append(result, "ipAddress "+*item.Value)
+			case *contextbasedrestrictionsv1.AddressSubnet:
+				result = append(result, "subnet "+*item.Value)
+			case *contextbasedrestrictionsv1.AddressIPAddressRange:
+				result = append(result, "ipRange "+*item.Value)
+			case *contextbasedrestrictionsv1.Address:
+				result = append(result, *item.Type+" "+*item.Value)
+			}
+		}
+		return
+	}
+
+	Context(`Addresses`, func() {
+		It(`Parse the IPv4 and IPv6 addresses`, func() {
+			Expect(parse("ipAddress", "10.0.0.1").String()).To(Equal("10.0.0.1"))
+			Expect(parse("ipAddress", "::ffff:10.0.0.1").String()).To(Equal("10.0.0.1"))
+			Expect(parse("subnet", "10.0.0.7/24")).To(Equal(contextbasedrestrictionsv1.IPRange{
+				First: netip.MustParseAddr("10.0.0.0"),
+				Last:  netip.MustParseAddr("10.0.0.255"),
+			}))
+			Expect(parse("subnet", "::ffff:10.0.0.0/120").String()).To(Equal("10.0.0.0/24"))
+			Expect(parse("subnet", "2001:db8::/32").Last.String()).To(Equal("2001:db8:ffff:ffff:ffff:ffff:ffff:ffff"))
+			Expect(parse("ipRange", "2001:db8::-2001:db8::ff").String()).To(Equal("2001:db8::/120"))
+			Expect(parse("ipRange", "10.0.0.1-10.0.0.6").String()).To(Equal("10.0.0.1-10.0.0.6"))
+
+			for _, invalid := range [][2]string{
+				{"ipAddress", "10.0.0.256"},
+				{"ipRange", "10.0.0.1"},
+				{"ipRange", "10.0.0.1-2001:db8::1"},
+				{"subnet", "10.0.0.0/33"},
+				{"vpc", "crn:v1:vpc"},
+			} {
+				_, err := contextbasedrestrictionsv1.ParseAddress(address(invalid[0], invalid[1]))
+				Expect(err).ToNot(BeNil(), invalid[1])
+			}
+		})
+		It(`Compute the minimal CIDR set`, func() {
+			r := parse("ipRange", "10.0.0.1-10.0.0.6")
+			Expect(r.Prefixes()).To(Equal([]netip.Prefix{
+				netip.MustParsePrefix("10.0.0.1/32"),
+				netip.MustParsePrefix("10.0.0.2/31"),
+				netip.MustParsePrefix("10.0.0.4/31"),
+				netip.MustParsePrefix("10.0.0.6/32"),
+			}))
+			Expect(parse("subnet", "0.0.0.0/0").Prefixes()).To(Equal([]netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")}))
+
+			Expect(contextbasedrestrictionsv1.MinimalCIDRs([]contextbasedrestrictionsv1.IPRange{
+				parse("subnet", "10.0.1.0/24"),
+				parse("ipRange", "10.0.0.0-10.0.0.255"),
+				parse("ipAddress", "10.0.1.7"),
+				parse("ipAddress", "2001:db8::1"),
+				parse("ipAddress", "2001:db8::"),
+			})).To(Equal([]netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/23"),
+				netip.MustParsePrefix("2001:db8::/127"),
+			}))
+		})
+		It(`Merge the overlapping and adjacent ranges`, func() {
+			merged := contextbasedrestrictionsv1.MergeRanges([]contextbasedrestrictionsv1.IPRange{
+				parse("ipRange", "10.0.0.10-10.0.0.20"),
+				parse("ipAddress", "255.255.255.255"),
+				parse("ipRange", "10.0.0.21-10.0.0.30"),
+				parse("subnet", "255.255.255.0/24"),
+				parse("ipRange", "10.0.0.1-10.0.0.5"),
+			})
+			Expect(merged).To(HaveLen(3))
+			Expect(merged[0].String()).To(Equal("10.0.0.1-10.0.0.5"))
+			Expect(merged[1].String()).To(Equal("10.0.0.10-10.0.0.30"))
+			Expect(merged[2].String()).To(Equal("255.255.255.0/24"))
+		})
+		It(`Normalize the addresses of a zone`, func() {
+			normalized, err := contextbasedrestrictionsv1.NormalizeAddresses([]contextbasedrestrictionsv1.AddressIntf{
+				address("vpc", "crn:v1:vpc-1"),
+				address("ipAddress", "10.0.0.4"),
+				address("ipRange", "10.0.0.0-10.0.0.3"),
+				address("ipAddress", "10.0.0.9"),
+				address("vpc", "crn:v1:vpc-1"),
+				address("ipRange", "10.0.0.20-10.0.0.22"),
+			})
+			Expect(err).To(BeNil())
+			Expect(values(normalized)).To(Equal([]string{
+				"ipRange 10.0.0.0-10.0.0.4",
+				"ipAddress 10.0.0.9",
+				"ipRange 10.0.0.20-10.0.0.22",
+				"vpc crn:v1:vpc-1",
+			}))
+
+			normalized, err = contextbasedrestrictionsv1.NormalizeAddresses([]contextbasedrestrictionsv1.AddressIntf{
+				address("ipRange", "10.0.0.0-10.0.0.127"),
+				address("ipRange", "10.0.0.128-10.0.0.255"),
+			})
+			Expect(err).To(BeNil())
+			Expect(values(normalized)).To(Equal([]string{"subnet 10.0.0.0/24"}))
+
+			_, err = contextbasedrestrictionsv1.NormalizeAddresses([]contextbasedrestrictionsv1.AddressIntf{address("subnet", "10.0.0.0")})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Context(`AnalyzeZones`, func() {
+		It(`Report the invalid, redundant, duplicate and broad addresses`, func() {
+			zones := []contextbasedrestrictionsv1.Zone{
+				{
+					ID: core.StringPtr("zone-1"),
+					Addresses: []contextbasedrestrictionsv1.AddressIntf{
+						address("subnet", "10.0.0.0/24"),
+						address("ipAddress", "10.0.0.7"),
+						address("vpc", "crn:v1:vpc-1"),
+						address("ipAddress", "not-an-ip"),
+					},
+				},
+				{
+					ID: core.StringPtr("zone-2"),
+					Addresses: []contextbasedrestrictionsv1.AddressIntf{
+						address("ipRange", "10.0.0.200-10.0.1.10"),
+						address("vpc", "crn:v1:vpc-1"),
+						address("subnet", "11.0.0.0/8"),
+						address("subnet", "2001:db8::/16"),
+					},
+				},
+			}
+			analysis := contextbasedrestrictionsv1.AnalyzeZones(zones, nil)
+
+			invalid := analysis.FindingsOfKind(contextbasedrestrictionsv1.ZoneFindingKindInvalid)
+			Expect(invalid).To(HaveLen(1))
+			Expect(invalid[0].Address).To(Equal("not-an-ip"))
+
+			redundant := analysis.FindingsOfKind(contextbasedrestrictionsv1.ZoneFindingKindRedundant)
+			Expect(redundant).To(HaveLen(1))
+			Expect(redundant[0].Address).To(Equal("10.0.0.7"))
+			Expect(redundant[0].OtherAddress).To(Equal("10.0.0.0/24"))
+
+			duplicate := analysis.FindingsOfKind(contextbasedrestrictionsv1.ZoneFindingKindDuplicate)
+			Expect(duplicate).To(HaveLen(2))
+			Expect(duplicate[0].ZoneID).To(Equal("zone-2"))
+			Expect(duplicate[0].Address).To(Equal("crn:v1:vpc-1"))
+			Expect(duplicate[1].Address).To(Equal("10.0.0.200-10.0.1.10"))
+			Expect(duplicate[1].OtherZoneID).To(Equal("zone-1"))
+			Expect(duplicate[1].Message).To(Equal("the address '10.0.0.200-10.0.1.10' overlaps the address '10.0.0.0/24' of zone 'zone-1'"))
+
+			broad := analysis.FindingsOfKind(contextbasedrestrictionsv1.ZoneFindingKindBroad)
+			Expect(broad).To(HaveLen(2))
+			Expect(broad[0].Address).To(Equal("11.0.0.0/8"))
+			Expect(broad[1].Address).To(Equal("2001:db8::/16"))
+
+			Expect(analysis.Zones).To(HaveLen(2))
+			Expect(analysis.Zones[0].Normalized).To(BeNil())
+			Expect(analysis.Zones[1].AddressCount).To(Equal(4))
+			Expect(analysis.Zones[1].Normalized).To(HaveLen(4))
+
+			analysis = contextbasedrestrictionsv1.AnalyzeZones(zones[1:], &contextbasedrestrictionsv1.ZoneAnalysisOptions{
+				BroadPrefixLengthIPv4: 7,
+				BroadPrefixLengthIPv6: 16,
+			})
+			Expect(analysis.Findings).To(HaveLen(1))
+			Expect(analysis.Findings[0].Address).To(Equal("2001:db8::/16"))
+		})
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -1136,6 +1137,19 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) NewBillingUnitsPager(opt
 	return
 }
 
+// NewBillingUnitsPagerFromCheckpoint returns a new BillingUnitsPager instance that resumes
+// from the position recorded by BillingUnitsPager.Checkpoint().
+func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) NewBillingUnitsPagerFromCheckpoint(checkpoint []byte) (pager *BillingUnitsPager, err error) {
+	pager = &BillingUnitsPager{
+		client: enterpriseBillingUnits,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListBillingUnitsOptions, string]("BillingUnitsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *BillingUnitsPager) HasNext() bool {
 	return pager.hasNext
@@ -1195,6 +1209,19 @@ func (pager *BillingUnitsPager) GetAll() (allItems []BillingUnit, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *BillingUnitsPager) All(ctx context.Context) iter.Seq2[BillingUnit, error] {
+	return common.All[BillingUnit](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewBillingUnitsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *BillingUnitsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("BillingUnitsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 //
 // BillingOptionsPager can be used to simplify the use of the "ListBillingOptions" method.
 //
@@ -1223,6 +1250,19 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) NewBillingOptionsPager(o
 	return
 }
 
+// NewBillingOptionsPagerFromCheckpoint returns a new BillingOptionsPager instance that resumes
+// from the position recorded by BillingOptionsPager.Checkpoint().
+func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) NewBillingOptionsPagerFromCheckpoint(checkpoint []byte) (pager *BillingOptionsPager, err error) {
+	pager = &BillingOptionsPager{
+		client: enterpriseBillingUnits,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListBillingOptionsOptions, string]("BillingOptionsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *BillingOptionsPager) HasNext() bool {
 	return pager.hasNext
@@ -1281,3 +1321,16 @@ func (pager *BillingOptionsPager) GetNext() (page []BillingOption, err error) {
 func (pager *BillingOptionsPager) GetAll() (allItems []BillingOption, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *BillingOptionsPager) All(ctx context.Context) iter.Seq2[BillingOption, error] {
+	return common.All[BillingOption](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewBillingOptionsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *BillingOptionsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("BillingOptionsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
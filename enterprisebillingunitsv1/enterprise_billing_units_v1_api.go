@@ -0,0 +1,46 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.64.1-cee95189-20230124-211647
+ */
+
+package enterprisebillingunitsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// EnterpriseBillingUnitsV1API : The operations supported by the EnterpriseBillingUnitsV1 service. This interface is satisfied by
+// *EnterpriseBillingUnitsV1 and may be used to substitute a fake or a decorator for the service client.
+type EnterpriseBillingUnitsV1API interface {
+	// GetBillingUnitWithContext : Get billing unit by ID
+	GetBillingUnitWithContext(ctx context.Context, getBillingUnitOptions *GetBillingUnitOptions) (result *BillingUnit, response *core.DetailedResponse, err error)
+
+	// ListBillingUnitsWithContext : List billing units
+	ListBillingUnitsWithContext(ctx context.Context, listBillingUnitsOptions *ListBillingUnitsOptions) (result *BillingUnitsList, response *core.DetailedResponse, err error)
+
+	// ListBillingOptionsWithContext : List billing options
+	ListBillingOptionsWithContext(ctx context.Context, listBillingOptionsOptions *ListBillingOptionsOptions) (result *BillingOptionsList, response *core.DetailedResponse, err error)
+
+	// GetCreditPoolsWithContext : Get credit pools
+	GetCreditPoolsWithContext(ctx context.Context, getCreditPoolsOptions *GetCreditPoolsOptions) (result *CreditPoolsList, response *core.DetailedResponse, err error)
+}
+
+// Verify that EnterpriseBillingUnitsV1 implements the EnterpriseBillingUnitsV1API interface.
+var _ EnterpriseBillingUnitsV1API = (*EnterpriseBillingUnitsV1)(nil)
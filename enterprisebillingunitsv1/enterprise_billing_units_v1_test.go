@@ -690,6 +690,46 @@ var _ = Describe(`EnterpriseBillingUnitsV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use BillingUnitsPager.Checkpoint successfully`, func() {
+				enterpriseBillingUnitsService, serviceErr := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseBillingUnitsService).ToNot(BeNil())
+
+				listBillingUnitsOptionsModel := &enterprisebillingunitsv1.ListBillingUnitsOptions{
+					AccountID: core.StringPtr("testString"),
+					EnterpriseID: core.StringPtr("testString"),
+					AccountGroupID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseBillingUnitsService.NewBillingUnitsPager(listBillingUnitsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisebillingunitsv1.BillingUnit
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = enterpriseBillingUnitsService.NewBillingUnitsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use BillingUnitsPager.GetAll successfully`, func() {
 				enterpriseBillingUnitsService, serviceErr := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
 					URL:           testServer.URL,
@@ -714,6 +754,32 @@ var _ = Describe(`EnterpriseBillingUnitsV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use BillingUnitsPager.All successfully`, func() {
+				enterpriseBillingUnitsService, serviceErr := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseBillingUnitsService).ToNot(BeNil())
+
+				listBillingUnitsOptionsModel := &enterprisebillingunitsv1.ListBillingUnitsOptions{
+					AccountID: core.StringPtr("testString"),
+					EnterpriseID: core.StringPtr("testString"),
+					AccountGroupID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseBillingUnitsService.NewBillingUnitsPager(listBillingUnitsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisebillingunitsv1.BillingUnit
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListBillingOptions(listBillingOptionsOptions *ListBillingOptionsOptions) - Operation response error`, func() {
@@ -1020,6 +1086,44 @@ var _ = Describe(`EnterpriseBillingUnitsV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use BillingOptionsPager.Checkpoint successfully`, func() {
+				enterpriseBillingUnitsService, serviceErr := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseBillingUnitsService).ToNot(BeNil())
+
+				listBillingOptionsOptionsModel := &enterprisebillingunitsv1.ListBillingOptionsOptions{
+					BillingUnitID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseBillingUnitsService.NewBillingOptionsPager(listBillingOptionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisebillingunitsv1.BillingOption
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = enterpriseBillingUnitsService.NewBillingOptionsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use BillingOptionsPager.GetAll successfully`, func() {
 				enterpriseBillingUnitsService, serviceErr := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
 					URL:           testServer.URL,
@@ -1042,6 +1146,30 @@ var _ = Describe(`EnterpriseBillingUnitsV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use BillingOptionsPager.All successfully`, func() {
+				enterpriseBillingUnitsService, serviceErr := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(&enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseBillingUnitsService).ToNot(BeNil())
+
+				listBillingOptionsOptionsModel := &enterprisebillingunitsv1.ListBillingOptionsOptions{
+					BillingUnitID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseBillingUnitsService.NewBillingOptionsPager(listBillingOptionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisebillingunitsv1.BillingOption
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetCreditPools(getCreditPoolsOptions *GetCreditPoolsOptions) - Operation response error`, func() {
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -2760,6 +2761,19 @@ func (enterpriseManagement *EnterpriseManagementV1) NewEnterprisesPager(options
 	return
 }
 
+// NewEnterprisesPagerFromCheckpoint returns a new EnterprisesPager instance that resumes
+// from the position recorded by EnterprisesPager.Checkpoint().
+func (enterpriseManagement *EnterpriseManagementV1) NewEnterprisesPagerFromCheckpoint(checkpoint []byte) (pager *EnterprisesPager, err error) {
+	pager = &EnterprisesPager{
+		client: enterpriseManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListEnterprisesOptions, string]("EnterprisesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *EnterprisesPager) HasNext() bool {
 	return pager.hasNext
@@ -2826,6 +2840,19 @@ func (pager *EnterprisesPager) GetAll() (allItems []Enterprise, err error) {
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *EnterprisesPager) All(ctx context.Context) iter.Seq2[Enterprise, error] {
+	return common.All[Enterprise](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewEnterprisesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *EnterprisesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("EnterprisesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // AccountsPager can be used to simplify the use of the "ListAccounts" method.
 type AccountsPager struct {
 	hasNext     bool
@@ -2852,6 +2879,19 @@ func (enterpriseManagement *EnterpriseManagementV1) NewAccountsPager(options *Li
 	return
 }
 
+// NewAccountsPagerFromCheckpoint returns a new AccountsPager instance that resumes
+// from the position recorded by AccountsPager.Checkpoint().
+func (enterpriseManagement *EnterpriseManagementV1) NewAccountsPagerFromCheckpoint(checkpoint []byte) (pager *AccountsPager, err error) {
+	pager = &AccountsPager{
+		client: enterpriseManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListAccountsOptions, string]("AccountsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *AccountsPager) HasNext() bool {
 	return pager.hasNext
@@ -2918,6 +2958,19 @@ func (pager *AccountsPager) GetAll() (allItems []Account, err error) {
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *AccountsPager) All(ctx context.Context) iter.Seq2[Account, error] {
+	return common.All[Account](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewAccountsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *AccountsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("AccountsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // AccountGroupsPager can be used to simplify the use of the "ListAccountGroups" method.
 type AccountGroupsPager struct {
 	hasNext     bool
@@ -2944,6 +2997,19 @@ func (enterpriseManagement *EnterpriseManagementV1) NewAccountGroupsPager(option
 	return
 }
 
+// NewAccountGroupsPagerFromCheckpoint returns a new AccountGroupsPager instance that resumes
+// from the position recorded by AccountGroupsPager.Checkpoint().
+func (enterpriseManagement *EnterpriseManagementV1) NewAccountGroupsPagerFromCheckpoint(checkpoint []byte) (pager *AccountGroupsPager, err error) {
+	pager = &AccountGroupsPager{
+		client: enterpriseManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListAccountGroupsOptions, string]("AccountGroupsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *AccountGroupsPager) HasNext() bool {
 	return pager.hasNext
@@ -3009,3 +3075,16 @@ func (pager *AccountGroupsPager) GetAll() (allItems []AccountGroup, err error) {
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *AccountGroupsPager) All(ctx context.Context) iter.Seq2[AccountGroup, error] {
+	return common.All[AccountGroup](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewAccountGroupsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *AccountGroupsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("AccountGroupsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
@@ -0,0 +1,79 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.89.1-ed9d96f4-20240417-193115
+ */
+
+package enterprisemanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// EnterpriseManagementV1API : The operations supported by the EnterpriseManagementV1 service. This interface is satisfied by
+// *EnterpriseManagementV1 and may be used to substitute a fake or a decorator for the service client.
+type EnterpriseManagementV1API interface {
+	// CreateEnterpriseWithContext : Create an enterprise
+	CreateEnterpriseWithContext(ctx context.Context, createEnterpriseOptions *CreateEnterpriseOptions) (result *CreateEnterpriseResponse, response *core.DetailedResponse, err error)
+
+	// ListEnterprisesWithContext : List enterprises
+	ListEnterprisesWithContext(ctx context.Context, listEnterprisesOptions *ListEnterprisesOptions) (result *ListEnterprisesResponse, response *core.DetailedResponse, err error)
+
+	// GetEnterpriseWithContext : Get enterprise by ID
+	GetEnterpriseWithContext(ctx context.Context, getEnterpriseOptions *GetEnterpriseOptions) (result *Enterprise, response *core.DetailedResponse, err error)
+
+	// UpdateEnterpriseWithContext : Update an enterprise
+	UpdateEnterpriseWithContext(ctx context.Context, updateEnterpriseOptions *UpdateEnterpriseOptions) (response *core.DetailedResponse, err error)
+
+	// ImportAccountToEnterpriseWithContext : Import an account into an enterprise
+	ImportAccountToEnterpriseWithContext(ctx context.Context, importAccountToEnterpriseOptions *ImportAccountToEnterpriseOptions) (response *core.DetailedResponse, err error)
+
+	// CreateAccountWithContext : Create a new account in an enterprise
+	CreateAccountWithContext(ctx context.Context, createAccountOptions *CreateAccountOptions) (result *CreateAccountResponse, response *core.DetailedResponse, err error)
+
+	// ListAccountsWithContext : List accounts
+	ListAccountsWithContext(ctx context.Context, listAccountsOptions *ListAccountsOptions) (result *ListAccountsResponse, response *core.DetailedResponse, err error)
+
+	// GetAccountWithContext : Get account by ID
+	GetAccountWithContext(ctx context.Context, getAccountOptions *GetAccountOptions) (result *Account, response *core.DetailedResponse, err error)
+
+	// UpdateAccountWithContext : Move an account within the enterprise
+	UpdateAccountWithContext(ctx context.Context, updateAccountOptions *UpdateAccountOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteAccountWithContext : Remove an account from its enterprise
+	DeleteAccountWithContext(ctx context.Context, deleteAccountOptions *DeleteAccountOptions) (response *core.DetailedResponse, err error)
+
+	// CreateAccountGroupWithContext : Create an account group
+	CreateAccountGroupWithContext(ctx context.Context, createAccountGroupOptions *CreateAccountGroupOptions) (result *CreateAccountGroupResponse, response *core.DetailedResponse, err error)
+
+	// ListAccountGroupsWithContext : List account groups
+	ListAccountGroupsWithContext(ctx context.Context, listAccountGroupsOptions *ListAccountGroupsOptions) (result *ListAccountGroupsResponse, response *core.DetailedResponse, err error)
+
+	// GetAccountGroupWithContext : Get account group by ID
+	GetAccountGroupWithContext(ctx context.Context, getAccountGroupOptions *GetAccountGroupOptions) (result *AccountGroup, response *core.DetailedResponse, err error)
+
+	// UpdateAccountGroupWithContext : Update an account group
+	UpdateAccountGroupWithContext(ctx context.Context, updateAccountGroupOptions *UpdateAccountGroupOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteAccountGroupWithContext : Delete an account group from the enterprise
+	DeleteAccountGroupWithContext(ctx context.Context, deleteAccountGroupOptions *DeleteAccountGroupOptions) (response *core.DetailedResponse, err error)
+}
+
+// Verify that EnterpriseManagementV1 implements the EnterpriseManagementV1API interface.
+var _ EnterpriseManagementV1API = (*EnterpriseManagementV1)(nil)
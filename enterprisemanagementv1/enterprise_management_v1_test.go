@@ -738,6 +738,46 @@ var _ = Describe(`EnterpriseManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use EnterprisesPager.Checkpoint successfully`, func() {
+				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseManagementService).ToNot(BeNil())
+
+				listEnterprisesOptionsModel := &enterprisemanagementv1.ListEnterprisesOptions{
+					EnterpriseAccountID: core.StringPtr("testString"),
+					AccountGroupID: core.StringPtr("testString"),
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseManagementService.NewEnterprisesPager(listEnterprisesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisemanagementv1.Enterprise
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = enterpriseManagementService.NewEnterprisesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use EnterprisesPager.GetAll successfully`, func() {
 				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
 					URL:           testServer.URL,
@@ -762,6 +802,32 @@ var _ = Describe(`EnterpriseManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use EnterprisesPager.All successfully`, func() {
+				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseManagementService).ToNot(BeNil())
+
+				listEnterprisesOptionsModel := &enterprisemanagementv1.ListEnterprisesOptions{
+					EnterpriseAccountID: core.StringPtr("testString"),
+					AccountGroupID: core.StringPtr("testString"),
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseManagementService.NewEnterprisesPager(listEnterprisesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisemanagementv1.Enterprise
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetEnterprise(getEnterpriseOptions *GetEnterpriseOptions) - Operation response error`, func() {
@@ -1789,6 +1855,47 @@ var _ = Describe(`EnterpriseManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccountsPager.Checkpoint successfully`, func() {
+				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseManagementService).ToNot(BeNil())
+
+				listAccountsOptionsModel := &enterprisemanagementv1.ListAccountsOptions{
+					EnterpriseID: core.StringPtr("testString"),
+					AccountGroupID: core.StringPtr("testString"),
+					Parent: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+					IncludeDeleted: core.BoolPtr(true),
+				}
+
+				pager, err := enterpriseManagementService.NewAccountsPager(listAccountsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisemanagementv1.Account
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = enterpriseManagementService.NewAccountsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use AccountsPager.GetAll successfully`, func() {
 				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
 					URL:           testServer.URL,
@@ -1814,6 +1921,33 @@ var _ = Describe(`EnterpriseManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccountsPager.All successfully`, func() {
+				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseManagementService).ToNot(BeNil())
+
+				listAccountsOptionsModel := &enterprisemanagementv1.ListAccountsOptions{
+					EnterpriseID: core.StringPtr("testString"),
+					AccountGroupID: core.StringPtr("testString"),
+					Parent: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+					IncludeDeleted: core.BoolPtr(true),
+				}
+
+				pager, err := enterpriseManagementService.NewAccountsPager(listAccountsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisemanagementv1.Account
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetAccount(getAccountOptions *GetAccountOptions) - Operation response error`, func() {
@@ -2760,6 +2894,47 @@ var _ = Describe(`EnterpriseManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccountGroupsPager.Checkpoint successfully`, func() {
+				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseManagementService).ToNot(BeNil())
+
+				listAccountGroupsOptionsModel := &enterprisemanagementv1.ListAccountGroupsOptions{
+					EnterpriseID: core.StringPtr("testString"),
+					ParentAccountGroupID: core.StringPtr("testString"),
+					Parent: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+					IncludeDeleted: core.BoolPtr(true),
+				}
+
+				pager, err := enterpriseManagementService.NewAccountGroupsPager(listAccountGroupsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisemanagementv1.AccountGroup
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = enterpriseManagementService.NewAccountGroupsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use AccountGroupsPager.GetAll successfully`, func() {
 				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
 					URL:           testServer.URL,
@@ -2785,6 +2960,33 @@ var _ = Describe(`EnterpriseManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccountGroupsPager.All successfully`, func() {
+				enterpriseManagementService, serviceErr := enterprisemanagementv1.NewEnterpriseManagementV1(&enterprisemanagementv1.EnterpriseManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseManagementService).ToNot(BeNil())
+
+				listAccountGroupsOptionsModel := &enterprisemanagementv1.ListAccountGroupsOptions{
+					EnterpriseID: core.StringPtr("testString"),
+					ParentAccountGroupID: core.StringPtr("testString"),
+					Parent: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+					IncludeDeleted: core.BoolPtr(true),
+				}
+
+				pager, err := enterpriseManagementService.NewAccountGroupsPager(listAccountGroupsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterprisemanagementv1.AccountGroup
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetAccountGroup(getAccountGroupOptions *GetAccountGroupOptions) - Operation response error`, func() {
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -721,6 +722,19 @@ func (enterpriseUsageReports *EnterpriseUsageReportsV1) NewGetResourceUsageRepor
 	return
 }
 
+// NewGetResourceUsageReportPagerFromCheckpoint returns a new GetResourceUsageReportPager instance that resumes
+// from the position recorded by GetResourceUsageReportPager.Checkpoint().
+func (enterpriseUsageReports *EnterpriseUsageReportsV1) NewGetResourceUsageReportPagerFromCheckpoint(checkpoint []byte) (pager *GetResourceUsageReportPager, err error) {
+	pager = &GetResourceUsageReportPager{
+		client: enterpriseUsageReports,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetResourceUsageReportOptions, string]("GetResourceUsageReportPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetResourceUsageReportPager) HasNext() bool {
 	return pager.hasNext
@@ -779,3 +793,16 @@ func (pager *GetResourceUsageReportPager) GetNext() (page []ResourceUsageReport,
 func (pager *GetResourceUsageReportPager) GetAll() (allItems []ResourceUsageReport, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetResourceUsageReportPager) All(ctx context.Context) iter.Seq2[ResourceUsageReport, error] {
+	return common.All[ResourceUsageReport](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetResourceUsageReportPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetResourceUsageReportPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetResourceUsageReportPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
@@ -0,0 +1,37 @@
+/**
+ * (C) Copyright IBM Corp. 2020, 2022.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.60.0-13f6e1ba-20221019-164457
+ */
+
+package enterpriseusagereportsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// EnterpriseUsageReportsV1API : The operations supported by the EnterpriseUsageReportsV1 service. This interface is satisfied by
+// *EnterpriseUsageReportsV1 and may be used to substitute a fake or a decorator for the service client.
+type EnterpriseUsageReportsV1API interface {
+	// GetResourceUsageReportWithContext : Get usage reports for enterprise entities
+	GetResourceUsageReportWithContext(ctx context.Context, getResourceUsageReportOptions *GetResourceUsageReportOptions) (result *Reports, response *core.DetailedResponse, err error)
+}
+
+// Verify that EnterpriseUsageReportsV1 implements the EnterpriseUsageReportsV1API interface.
+var _ EnterpriseUsageReportsV1API = (*EnterpriseUsageReportsV1)(nil)
@@ -509,6 +509,49 @@ var _ = Describe(`EnterpriseUsageReportsV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageReportPager.Checkpoint successfully`, func() {
+				enterpriseUsageReportsService, serviceErr := enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(&enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseUsageReportsService).ToNot(BeNil())
+
+				getResourceUsageReportOptionsModel := &enterpriseusagereportsv1.GetResourceUsageReportOptions{
+					EnterpriseID: core.StringPtr("abc12340d4bf4e36b0423d209b286f24"),
+					AccountGroupID: core.StringPtr("def456a237b94b9a9238ef024e204c9f"),
+					AccountID: core.StringPtr("987abcba31834216b8c726a7dd9eb8d6"),
+					Children: core.BoolPtr(true),
+					Month: core.StringPtr("2019-06"),
+					BillingUnitID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseUsageReportsService.NewGetResourceUsageReportPager(getResourceUsageReportOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterpriseusagereportsv1.ResourceUsageReport
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = enterpriseUsageReportsService.NewGetResourceUsageReportPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetResourceUsageReportPager.GetAll successfully`, func() {
 				enterpriseUsageReportsService, serviceErr := enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(&enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
 					URL:           testServer.URL,
@@ -536,6 +579,35 @@ var _ = Describe(`EnterpriseUsageReportsV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageReportPager.All successfully`, func() {
+				enterpriseUsageReportsService, serviceErr := enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(&enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(enterpriseUsageReportsService).ToNot(BeNil())
+
+				getResourceUsageReportOptionsModel := &enterpriseusagereportsv1.GetResourceUsageReportOptions{
+					EnterpriseID: core.StringPtr("abc12340d4bf4e36b0423d209b286f24"),
+					AccountGroupID: core.StringPtr("def456a237b94b9a9238ef024e204c9f"),
+					AccountID: core.StringPtr("987abcba31834216b8c726a7dd9eb8d6"),
+					Children: core.BoolPtr(true),
+					Month: core.StringPtr("2019-06"),
+					BillingUnitID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := enterpriseUsageReportsService.NewGetResourceUsageReportPager(getResourceUsageReportOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []enterpriseusagereportsv1.ResourceUsageReport
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`Model constructor tests`, func() {
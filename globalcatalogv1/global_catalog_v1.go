@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -2586,6 +2587,26 @@ func UnmarshalEntrySearchResult(m map[string]json.RawMessage, result interface{}
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results.
+// The next offset is computed from the offset, size and overall count of this page of results.
+func (resp *EntrySearchResult) GetNextOffset() (*int64, error) {
+	if core.IsNil(resp.Count) {
+		return nil, nil
+	}
+	var offset int64
+	if resp.Offset != nil {
+		offset = *resp.Offset
+	}
+	pageSize := int64(len(resp.Resources))
+	if resp.ResourceCount != nil {
+		pageSize = *resp.ResourceCount
+	}
+	if pageSize <= 0 || offset+pageSize >= *resp.Count {
+		return nil, nil
+	}
+	return core.Int64Ptr(offset + pageSize), nil
+}
+
 // GetArtifactOptions : The GetArtifact options.
 type GetArtifactOptions struct {
 	// The object's unique ID.
@@ -4875,3 +4896,213 @@ func UnmarshalVisibilityDetailAccounts(m map[string]json.RawMessage, result inte
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// CatalogEntriesPager can be used to simplify the use of the "ListCatalogEntries" method.
+type CatalogEntriesPager struct {
+	hasNext     bool
+	options     *ListCatalogEntriesOptions
+	client      *GlobalCatalogV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewCatalogEntriesPager returns a new CatalogEntriesPager instance.
+func (globalCatalog *GlobalCatalogV1) NewCatalogEntriesPager(options *ListCatalogEntriesOptions) (pager *CatalogEntriesPager, err error) {
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListCatalogEntriesOptions = *options
+	pager = &CatalogEntriesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  globalCatalog,
+	}
+	return
+}
+
+// NewCatalogEntriesPagerFromCheckpoint returns a new CatalogEntriesPager instance that resumes
+// from the position recorded by CatalogEntriesPager.Checkpoint().
+func (globalCatalog *GlobalCatalogV1) NewCatalogEntriesPagerFromCheckpoint(checkpoint []byte) (pager *CatalogEntriesPager, err error) {
+	pager = &CatalogEntriesPager{
+		client: globalCatalog,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListCatalogEntriesOptions, int64]("CatalogEntriesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *CatalogEntriesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *CatalogEntriesPager) GetNextWithContext(ctx context.Context) (page []CatalogEntry, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListCatalogEntriesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Resources
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *CatalogEntriesPager) GetAllWithContext(ctx context.Context) (allItems []CatalogEntry, err error) {
+	for pager.HasNext() {
+		var nextPage []CatalogEntry
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *CatalogEntriesPager) GetNext() (page []CatalogEntry, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *CatalogEntriesPager) GetAll() (allItems []CatalogEntry, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *CatalogEntriesPager) All(ctx context.Context) iter.Seq2[CatalogEntry, error] {
+	return common.All[CatalogEntry](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewCatalogEntriesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *CatalogEntriesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("CatalogEntriesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
+// ChildObjectsPager can be used to simplify the use of the "GetChildObjects" method.
+type ChildObjectsPager struct {
+	hasNext     bool
+	options     *GetChildObjectsOptions
+	client      *GlobalCatalogV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewChildObjectsPager returns a new ChildObjectsPager instance.
+func (globalCatalog *GlobalCatalogV1) NewChildObjectsPager(options *GetChildObjectsOptions) (pager *ChildObjectsPager, err error) {
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy GetChildObjectsOptions = *options
+	pager = &ChildObjectsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  globalCatalog,
+	}
+	return
+}
+
+// NewChildObjectsPagerFromCheckpoint returns a new ChildObjectsPager instance that resumes
+// from the position recorded by ChildObjectsPager.Checkpoint().
+func (globalCatalog *GlobalCatalogV1) NewChildObjectsPagerFromCheckpoint(checkpoint []byte) (pager *ChildObjectsPager, err error) {
+	pager = &ChildObjectsPager{
+		client: globalCatalog,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetChildObjectsOptions, int64]("ChildObjectsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ChildObjectsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ChildObjectsPager) GetNextWithContext(ctx context.Context) (page []CatalogEntry, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.GetChildObjectsWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	next, err := result.GetNextOffset()
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Resources
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ChildObjectsPager) GetAllWithContext(ctx context.Context) (allItems []CatalogEntry, err error) {
+	for pager.HasNext() {
+		var nextPage []CatalogEntry
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ChildObjectsPager) GetNext() (page []CatalogEntry, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ChildObjectsPager) GetAll() (allItems []CatalogEntry, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ChildObjectsPager) All(ctx context.Context) iter.Seq2[CatalogEntry, error] {
+	return common.All[CatalogEntry](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewChildObjectsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ChildObjectsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ChildObjectsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
@@ -0,0 +1,83 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.70.0-7df966bf-20230419-195904
+ */
+
+package globalcatalogv1
+
+import (
+	"context"
+	"io"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GlobalCatalogV1API : The operations supported by the GlobalCatalogV1 service. This interface is satisfied by
+// *GlobalCatalogV1 and may be used to substitute a fake or a decorator for the service client.
+type GlobalCatalogV1API interface {
+	// ListCatalogEntriesWithContext : Returns parent catalog entries
+	ListCatalogEntriesWithContext(ctx context.Context, listCatalogEntriesOptions *ListCatalogEntriesOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+
+	// CreateCatalogEntryWithContext : Create a catalog entry
+	CreateCatalogEntryWithContext(ctx context.Context, createCatalogEntryOptions *CreateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// GetCatalogEntryWithContext : Get a specific catalog object
+	GetCatalogEntryWithContext(ctx context.Context, getCatalogEntryOptions *GetCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// UpdateCatalogEntryWithContext : Update a catalog entry
+	UpdateCatalogEntryWithContext(ctx context.Context, updateCatalogEntryOptions *UpdateCatalogEntryOptions) (result *CatalogEntry, response *core.DetailedResponse, err error)
+
+	// DeleteCatalogEntryWithContext : Delete a catalog entry
+	DeleteCatalogEntryWithContext(ctx context.Context, deleteCatalogEntryOptions *DeleteCatalogEntryOptions) (response *core.DetailedResponse, err error)
+
+	// GetChildObjectsWithContext : Get child catalog entries of a specific kind
+	GetChildObjectsWithContext(ctx context.Context, getChildObjectsOptions *GetChildObjectsOptions) (result *EntrySearchResult, response *core.DetailedResponse, err error)
+
+	// RestoreCatalogEntryWithContext : Restore archived catalog entry
+	RestoreCatalogEntryWithContext(ctx context.Context, restoreCatalogEntryOptions *RestoreCatalogEntryOptions) (response *core.DetailedResponse, err error)
+
+	// GetVisibilityWithContext : Get the visibility constraints for an object
+	GetVisibilityWithContext(ctx context.Context, getVisibilityOptions *GetVisibilityOptions) (result *Visibility, response *core.DetailedResponse, err error)
+
+	// UpdateVisibilityWithContext : Update visibility
+	UpdateVisibilityWithContext(ctx context.Context, updateVisibilityOptions *UpdateVisibilityOptions) (response *core.DetailedResponse, err error)
+
+	// GetPricingWithContext : Get the pricing for an object
+	GetPricingWithContext(ctx context.Context, getPricingOptions *GetPricingOptions) (result *PricingGet, response *core.DetailedResponse, err error)
+
+	// GetPricingDeploymentsWithContext : Get the pricing deployments for a plan
+	GetPricingDeploymentsWithContext(ctx context.Context, getPricingDeploymentsOptions *GetPricingDeploymentsOptions) (result *PricingSearchResult, response *core.DetailedResponse, err error)
+
+	// GetAuditLogsWithContext : Get the audit logs for an object
+	GetAuditLogsWithContext(ctx context.Context, getAuditLogsOptions *GetAuditLogsOptions) (result *AuditSearchResult, response *core.DetailedResponse, err error)
+
+	// ListArtifactsWithContext : Get artifacts
+	ListArtifactsWithContext(ctx context.Context, listArtifactsOptions *ListArtifactsOptions) (result *Artifacts, response *core.DetailedResponse, err error)
+
+	// GetArtifactWithContext : Get artifact
+	GetArtifactWithContext(ctx context.Context, getArtifactOptions *GetArtifactOptions) (result io.ReadCloser, response *core.DetailedResponse, err error)
+
+	// UploadArtifactWithContext : Upload artifact
+	UploadArtifactWithContext(ctx context.Context, uploadArtifactOptions *UploadArtifactOptions) (response *core.DetailedResponse, err error)
+
+	// DeleteArtifactWithContext : Delete artifact
+	DeleteArtifactWithContext(ctx context.Context, deleteArtifactOptions *DeleteArtifactOptions) (response *core.DetailedResponse, err error)
+}
+
+// Verify that GlobalCatalogV1 implements the GlobalCatalogV1API interface.
+var _ GlobalCatalogV1API = (*GlobalCatalogV1)(nil)
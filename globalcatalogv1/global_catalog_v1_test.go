@@ -442,6 +442,201 @@ var _ = Describe(`GlobalCatalogV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextOffset successfully`, func() {
+				responseObject := new(globalcatalogv1.EntrySearchResult)
+				responseObject.Offset = core.Int64Ptr(int64(10))
+				responseObject.Count = core.Int64Ptr(int64(25))
+				responseObject.ResourceCount = core.Int64Ptr(int64(10))
+
+				value, err := responseObject.GetNextOffset()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.Int64Ptr(int64(20))))
+			})
+			It(`Invoke GetNextOffset on the last page of results`, func() {
+				responseObject := new(globalcatalogv1.EntrySearchResult)
+				responseObject.Offset = core.Int64Ptr(int64(20))
+				responseObject.Count = core.Int64Ptr(int64(25))
+				responseObject.ResourceCount = core.Int64Ptr(int64(5))
+
+				value, err := responseObject.GetNextOffset()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextOffset without a "Count" property in the response`, func() {
+				responseObject := new(globalcatalogv1.EntrySearchResult)
+				responseObject.ResourceCount = core.Int64Ptr(int64(5))
+
+				value, err := responseObject.GetNextOffset()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listCatalogEntriesPath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.URL.Query()["q"]).To(Equal([]string{"testString"}))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["_offset"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"offset":0,"limit":1,"count":2,"resource_count":1,"resources":[{"name":"Name","kind":"service","id":"ID"}]}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["_offset"]).To(Equal([]string{"1"}))
+						fmt.Fprintf(res, "%s", `{"offset":1,"limit":1,"count":2,"resource_count":1,"resources":[{"name":"Name","kind":"service","id":"ID"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use CatalogEntriesPager.GetNext successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				listCatalogEntriesOptionsModel := &globalcatalogv1.ListCatalogEntriesOptions{
+					Account:    core.StringPtr("testString"),
+					Include:    core.StringPtr("testString"),
+					Q:          core.StringPtr("testString"),
+					SortBy:     core.StringPtr("testString"),
+					Descending: core.StringPtr("testString"),
+					Languages:  core.StringPtr("testString"),
+					Catalog:    core.BoolPtr(true),
+					Complete:   core.BoolPtr(true),
+					Limit:      core.Int64Ptr(int64(200)),
+				}
+
+				pager, err := globalCatalogService.NewCatalogEntriesPager(listCatalogEntriesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []globalcatalogv1.CatalogEntry
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use CatalogEntriesPager.Checkpoint successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				listCatalogEntriesOptionsModel := &globalcatalogv1.ListCatalogEntriesOptions{
+					Account:    core.StringPtr("testString"),
+					Include:    core.StringPtr("testString"),
+					Q:          core.StringPtr("testString"),
+					SortBy:     core.StringPtr("testString"),
+					Descending: core.StringPtr("testString"),
+					Languages:  core.StringPtr("testString"),
+					Catalog:    core.BoolPtr(true),
+					Complete:   core.BoolPtr(true),
+					Limit:      core.Int64Ptr(int64(200)),
+				}
+
+				pager, err := globalCatalogService.NewCatalogEntriesPager(listCatalogEntriesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []globalcatalogv1.CatalogEntry
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = globalCatalogService.NewCatalogEntriesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use CatalogEntriesPager.GetAll successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				listCatalogEntriesOptionsModel := &globalcatalogv1.ListCatalogEntriesOptions{
+					Account:    core.StringPtr("testString"),
+					Include:    core.StringPtr("testString"),
+					Q:          core.StringPtr("testString"),
+					SortBy:     core.StringPtr("testString"),
+					Descending: core.StringPtr("testString"),
+					Languages:  core.StringPtr("testString"),
+					Catalog:    core.BoolPtr(true),
+					Complete:   core.BoolPtr(true),
+					Limit:      core.Int64Ptr(int64(200)),
+				}
+
+				pager, err := globalCatalogService.NewCatalogEntriesPager(listCatalogEntriesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use CatalogEntriesPager.All successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				listCatalogEntriesOptionsModel := &globalcatalogv1.ListCatalogEntriesOptions{
+					Account:    core.StringPtr("testString"),
+					Include:    core.StringPtr("testString"),
+					Q:          core.StringPtr("testString"),
+					SortBy:     core.StringPtr("testString"),
+					Descending: core.StringPtr("testString"),
+					Languages:  core.StringPtr("testString"),
+					Catalog:    core.BoolPtr(true),
+					Complete:   core.BoolPtr(true),
+					Limit:      core.Int64Ptr(int64(200)),
+				}
+
+				pager, err := globalCatalogService.NewCatalogEntriesPager(listCatalogEntriesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []globalcatalogv1.CatalogEntry
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateCatalogEntry(createCatalogEntryOptions *CreateCatalogEntryOptions) - Operation response error`, func() {
 		createCatalogEntryPath := "/"
@@ -3899,6 +4094,175 @@ var _ = Describe(`GlobalCatalogV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(getChildObjectsPath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.URL.Query()["q"]).To(Equal([]string{"testString"}))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						Expect(req.URL.Query()["_offset"]).To(BeNil())
+						fmt.Fprintf(res, "%s", `{"offset":0,"limit":1,"count":2,"resource_count":1,"resources":[{"name":"Name","kind":"service","id":"ID"}]}`)
+					} else if requestNumber == 2 {
+						Expect(req.URL.Query()["_offset"]).To(Equal([]string{"1"}))
+						fmt.Fprintf(res, "%s", `{"offset":1,"limit":1,"count":2,"resource_count":1,"resources":[{"name":"Name","kind":"service","id":"ID"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ChildObjectsPager.GetNext successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				getChildObjectsOptionsModel := &globalcatalogv1.GetChildObjectsOptions{
+					ID:         core.StringPtr("testString"),
+					Kind:       core.StringPtr("testString"),
+					Account:    core.StringPtr("testString"),
+					Include:    core.StringPtr("testString"),
+					Q:          core.StringPtr("testString"),
+					SortBy:     core.StringPtr("testString"),
+					Descending: core.StringPtr("testString"),
+					Languages:  core.StringPtr("testString"),
+					Complete:   core.BoolPtr(true),
+					Limit:      core.Int64Ptr(int64(200)),
+				}
+
+				pager, err := globalCatalogService.NewChildObjectsPager(getChildObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []globalcatalogv1.CatalogEntry
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ChildObjectsPager.Checkpoint successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				getChildObjectsOptionsModel := &globalcatalogv1.GetChildObjectsOptions{
+					ID:         core.StringPtr("testString"),
+					Kind:       core.StringPtr("testString"),
+					Account:    core.StringPtr("testString"),
+					Include:    core.StringPtr("testString"),
+					Q:          core.StringPtr("testString"),
+					SortBy:     core.StringPtr("testString"),
+					Descending: core.StringPtr("testString"),
+					Languages:  core.StringPtr("testString"),
+					Complete:   core.BoolPtr(true),
+					Limit:      core.Int64Ptr(int64(200)),
+				}
+
+				pager, err := globalCatalogService.NewChildObjectsPager(getChildObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []globalcatalogv1.CatalogEntry
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = globalCatalogService.NewChildObjectsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ChildObjectsPager.GetAll successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				getChildObjectsOptionsModel := &globalcatalogv1.GetChildObjectsOptions{
+					ID:         core.StringPtr("testString"),
+					Kind:       core.StringPtr("testString"),
+					Account:    core.StringPtr("testString"),
+					Include:    core.StringPtr("testString"),
+					Q:          core.StringPtr("testString"),
+					SortBy:     core.StringPtr("testString"),
+					Descending: core.StringPtr("testString"),
+					Languages:  core.StringPtr("testString"),
+					Complete:   core.BoolPtr(true),
+					Limit:      core.Int64Ptr(int64(200)),
+				}
+
+				pager, err := globalCatalogService.NewChildObjectsPager(getChildObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ChildObjectsPager.All successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				getChildObjectsOptionsModel := &globalcatalogv1.GetChildObjectsOptions{
+					ID:         core.StringPtr("testString"),
+					Kind:       core.StringPtr("testString"),
+					Account:    core.StringPtr("testString"),
+					Include:    core.StringPtr("testString"),
+					Q:          core.StringPtr("testString"),
+					SortBy:     core.StringPtr("testString"),
+					Descending: core.StringPtr("testString"),
+					Languages:  core.StringPtr("testString"),
+					Complete:   core.BoolPtr(true),
+					Limit:      core.Int64Ptr(int64(200)),
+				}
+
+				pager, err := globalCatalogService.NewChildObjectsPager(getChildObjectsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []globalcatalogv1.CatalogEntry
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`RestoreCatalogEntry(restoreCatalogEntryOptions *RestoreCatalogEntryOptions)`, func() {
 		restoreCatalogEntryPath := "/testString/restore"
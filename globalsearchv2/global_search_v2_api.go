@@ -0,0 +1,37 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.87.0-91c7c775-20240320-213027
+ */
+
+package globalsearchv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GlobalSearchV2API : The operations supported by the GlobalSearchV2 service. This interface is satisfied by
+// *GlobalSearchV2 and may be used to substitute a fake or a decorator for the service client.
+type GlobalSearchV2API interface {
+	// SearchWithContext : Find instances of resources (v3)
+	SearchWithContext(ctx context.Context, searchOptions *SearchOptions) (result *ScanResult, response *core.DetailedResponse, err error)
+}
+
+// Verify that GlobalSearchV2 implements the GlobalSearchV2API interface.
+var _ GlobalSearchV2API = (*GlobalSearchV2)(nil)
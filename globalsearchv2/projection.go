@@ -0,0 +1,188 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/go-openapi/strfmt"
+)
+
+// ResultItemTypeResourceInstance is the "type" of the result items that are resource instances.
+const ResultItemTypeResourceInstance = "resource-instance"
+
+// ResourceSummary : A projection of the fields that are common to all the result items.
+type ResourceSummary struct {
+	CRN             string           `json:"crn,omitempty"`
+	Name            string           `json:"name,omitempty"`
+	AccountID       string           `json:"account_id,omitempty"`
+	Region          string           `json:"region,omitempty"`
+	ResourceGroupID string           `json:"resource_group_id,omitempty"`
+	Family          string           `json:"family,omitempty"`
+	Type            string           `json:"type,omitempty"`
+	Tags            []string         `json:"tags,omitempty"`
+	CreationDate    *strfmt.DateTime `json:"creation_date,omitempty"`
+}
+
+// ResourceInstanceSummary : A projection of the result items that are resource instances, which
+// includes fields of the Resource Controller document of the instance.
+type ResourceInstanceSummary struct {
+	ResourceSummary
+	Doc *ResourceInstanceDoc `json:"doc,omitempty"`
+}
+
+// ResourceInstanceDoc : The fields of the Resource Controller document of a resource instance.
+type ResourceInstanceDoc struct {
+	GUID           string `json:"guid,omitempty"`
+	State          string `json:"state,omitempty"`
+	ResourceID     string `json:"resource_id,omitempty"`
+	ResourcePlanID string `json:"resource_plan_id,omitempty"`
+	DashboardURL   string `json:"dashboard_url,omitempty"`
+}
+
+// ResourceInstance returns the resource instance described by the result item, in which only the
+// fields of the projection are set. An error is returned if the item is not a resource instance.
+func (summary *ResourceInstanceSummary) ResourceInstance() (*resourcecontrollerv2.ResourceInstance, error) {
+	if summary.Type != ResultItemTypeResourceInstance {
+		errMsg := fmt.Sprintf("the result item '%s' is of type '%s', not '%s'", summary.CRN, summary.Type, ResultItemTypeResourceInstance)
+		return nil, core.SDKErrorf(nil, errMsg, "not-a-resource-instance", common.GetComponentInfo())
+	}
+	stringPtr := func(s string) *string {
+		if s == "" {
+			return nil
+		}
+		return core.StringPtr(s)
+	}
+	instance := &resourcecontrollerv2.ResourceInstance{
+		ID:              stringPtr(summary.CRN),
+		CRN:             stringPtr(summary.CRN),
+		Name:            stringPtr(summary.Name),
+		AccountID:       stringPtr(summary.AccountID),
+		RegionID:        stringPtr(summary.Region),
+		ResourceGroupID: stringPtr(summary.ResourceGroupID),
+		CreatedAt:       summary.CreationDate,
+	}
+	if summary.Doc != nil {
+		instance.GUID = stringPtr(summary.Doc.GUID)
+		instance.State = stringPtr(summary.Doc.State)
+		instance.ResourceID = stringPtr(summary.Doc.ResourceID)
+		instance.ResourcePlanID = stringPtr(summary.Doc.ResourcePlanID)
+		instance.DashboardURL = stringPtr(summary.Doc.DashboardURL)
+	}
+	return instance, nil
+}
+
+// DecodeResultItem decodes the properties of "item" into a new instance of T, a projection struct
+// whose fields are mapped to the properties of the item by their "json" tags.
+func DecodeResultItem[T any](item *ResultItem) (result *T, err error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "result-item-marshal-error", common.GetComponentInfo())
+		return
+	}
+	result = new(T)
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "result-item-unmarshal-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// ProjectionFields returns the fields to request in SearchOptions.Fields to populate the
+// projection struct T. The fields are named after the "json" tags of T; the fields of embedded
+// structs are included, and those of nested structs are named with a "." separator
+// (e.g. "doc.state").
+func ProjectionFields[T any]() []string {
+	return projectionFields(reflect.TypeFor[T](), "")
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+func projectionFields(structType reflect.Type, prefix string) (fields []string) {
+	for structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			fields = append(fields, projectionFields(fieldType, prefix)...)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if fieldType.Kind() == reflect.Struct && !isProjectionLeaf(fieldType) {
+			fields = append(fields, projectionFields(fieldType, prefix+name+".")...)
+			continue
+		}
+		fields = append(fields, prefix+name)
+	}
+	return
+}
+
+// isProjectionLeaf returns true if values of "fieldType" are decoded from a single property even
+// though it is a struct (e.g. strfmt.DateTime).
+func isProjectionLeaf(fieldType reflect.Type) bool {
+	pointerType := reflect.PointerTo(fieldType)
+	return pointerType.Implements(jsonUnmarshalerType) || pointerType.Implements(textUnmarshalerType)
+}
+
+// SearchProjected runs the search specified by "options", with its Fields set to the
+// ProjectionFields of T, and decodes all the result items into T.
+func SearchProjected[T any](ctx context.Context, globalSearch *GlobalSearchV2, options *SearchOptions) (results []*T, err error) {
+	optionsCopy := *options
+	optionsCopy.Fields = ProjectionFields[T]()
+	pager, err := globalSearch.NewSearchPager(&optionsCopy)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "search-pager-error")
+		return
+	}
+	items, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "search-error")
+		return
+	}
+	for i := range items {
+		var result *T
+		result, err = DecodeResultItem[T](&items[i])
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return
+}
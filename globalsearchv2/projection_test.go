@@ -0,0 +1,108 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalSearchV2 projections`, func() {
+	const instanceCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/account-1:guid-1::"
+	const instanceJSON = `{"crn":%q,"name":"my-bucket-store","account_id":"account-1","region":"global","resource_group_id":"group-1",` +
+		`"family":"resource_controller","type":"resource-instance","tags":["env:prod"],"creation_date":"2024-03-01T10:00:00Z",` +
+		`"doc":{"guid":"guid-1","state":"active","resource_id":"service-1","resource_plan_id":"plan-1"}}`
+
+	It(`Derive the fields from the projection`, func() {
+		Expect(globalsearchv2.ProjectionFields[globalsearchv2.ResourceSummary]()).To(Equal([]string{
+			"crn", "name", "account_id", "region", "resource_group_id", "family", "type", "tags", "creation_date",
+		}))
+		Expect(globalsearchv2.ProjectionFields[globalsearchv2.ResourceInstanceSummary]()).To(ContainElements(
+			"crn", "creation_date", "doc.guid", "doc.state", "doc.resource_plan_id",
+		))
+
+		type custom struct {
+			Name    string `json:"name"`
+			Ignored string `json:"-"`
+			Service struct {
+				Plan *struct {
+					ID string `json:"id"`
+				} `json:"plan"`
+			} `json:"service,omitempty"`
+			unexported string
+		}
+		Expect(globalsearchv2.ProjectionFields[custom]()).To(Equal([]string{"name", "service.plan.id"}))
+	})
+	It(`Decode a result item into a resource instance`, func() {
+		var raw map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(fmt.Sprintf(instanceJSON, instanceCRN)), &raw)).To(Succeed())
+		var item *globalsearchv2.ResultItem
+		Expect(globalsearchv2.UnmarshalResultItem(raw, &item)).To(Succeed())
+
+		summary, err := globalsearchv2.DecodeResultItem[globalsearchv2.ResourceInstanceSummary](item)
+		Expect(err).To(BeNil())
+		Expect(summary.Name).To(Equal("my-bucket-store"))
+		Expect(summary.Tags).To(Equal([]string{"env:prod"}))
+		Expect(time.Time(*summary.CreationDate)).To(Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)))
+
+		instance, err := summary.ResourceInstance()
+		Expect(err).To(BeNil())
+		Expect(*instance.ID).To(Equal(instanceCRN))
+		Expect(*instance.GUID).To(Equal("guid-1"))
+		Expect(*instance.State).To(Equal("active"))
+		Expect(*instance.RegionID).To(Equal("global"))
+		Expect(*instance.ResourcePlanID).To(Equal("plan-1"))
+		Expect(instance.DashboardURL).To(BeNil())
+
+		summary.Type = "resource-group"
+		_, err = summary.ResourceInstance()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Search with a projection`, func() {
+		var fields []string
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			body := &globalsearchv2.SearchOptions{}
+			_ = json.NewDecoder(req.Body).Decode(body)
+			fields = body.Fields
+			res.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(res, `{"limit":10,"items":[`+instanceJSON+`]}`, instanceCRN)
+		}))
+		defer testServer.Close()
+		globalSearchService, err := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+
+		searchOptions := globalSearchService.NewSearchOptions().SetQuery(globalsearchv2.Q().Type("resource-instance").String())
+		results, err := globalsearchv2.SearchProjected[globalsearchv2.ResourceSummary](context.Background(), globalSearchService, searchOptions)
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].ResourceGroupID).To(Equal("group-1"))
+		Expect(fields).To(Equal(globalsearchv2.ProjectionFields[globalsearchv2.ResourceSummary]()))
+		Expect(searchOptions.Fields).To(BeNil())
+	})
+})
@@ -0,0 +1,263 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	"strings"
+	"time"
+)
+
+// The boolean operators of the Lucene query syntax.
+const (
+	queryOperatorAnd = "AND"
+	queryOperatorOr  = "OR"
+	queryOperatorNot = "NOT"
+)
+
+// luceneSpecialCharacters are the characters that must be escaped in unquoted Lucene terms.
+const luceneSpecialCharacters = `+-&|!(){}[]^"~*?:\/ `
+
+// Query : A Lucene query for the "Search" method, built with Q() so that the values are quoted
+// and escaped correctly:
+//
+//	query := globalsearchv2.Q().Type("resource-instance").Tag("env:prod").
+//		And(globalsearchv2.Q().Region("us-south").Or(globalsearchv2.Q().Region("eu-de")))
+//	searchOptions := globalSearchService.NewSearchOptions().SetQuery(query.String())
+//
+// A Query is immutable: its methods return a new Query.
+type Query struct {
+	expr string
+
+	// The operator that joins the top-level terms of expr, or "" if it is a single term.
+	op string
+
+	// Whether the query matches no resource.
+	none bool
+}
+
+// Q returns an empty query, which matches every resource.
+func Q() *Query {
+	return &Query{}
+}
+
+// matchNothing returns a query that matches no resource. It absorbs the queries with which it is
+// combined using AND, and is ignored by OR.
+func matchNothing() *Query {
+	return &Query{expr: queryOperatorNot + " *", op: queryOperatorNot, none: true}
+}
+
+// String returns the query in the Lucene syntax.
+func (query *Query) String() string {
+	if query.isEmpty() {
+		return "*"
+	}
+	return query.expr
+}
+
+func (query *Query) isEmpty() bool {
+	return query == nil || query.expr == ""
+}
+
+// And returns a query that matches the resources matched by the query and all of "others".
+func (query *Query) And(others ...*Query) *Query {
+	return combineQueries(queryOperatorAnd, append([]*Query{query}, others...))
+}
+
+// Or returns a query that matches the resources matched by the query or any of "others".
+func (query *Query) Or(others ...*Query) *Query {
+	return combineQueries(queryOperatorOr, append([]*Query{query}, others...))
+}
+
+// Not returns a query that matches the resources not matched by the query. The negation of the
+// empty query matches no resource, and vice versa.
+func (query *Query) Not() *Query {
+	if query.isEmpty() {
+		return matchNothing()
+	}
+	if query.none {
+		return Q()
+	}
+	return &Query{expr: queryOperatorNot + " " + query.operand(queryOperatorNot), op: queryOperatorNot}
+}
+
+func combineQueries(op string, queries []*Query) *Query {
+	var nonEmpty []*Query
+	var none *Query
+	for _, query := range queries {
+		switch {
+		case query.isEmpty():
+		case query.none:
+			none = query
+		default:
+			nonEmpty = append(nonEmpty, query)
+		}
+	}
+	if none != nil && (op == queryOperatorAnd || len(nonEmpty) == 0) {
+		return none
+	}
+	switch len(nonEmpty) {
+	case 0:
+		return Q()
+	case 1:
+		return nonEmpty[0]
+	}
+	terms := make([]string, len(nonEmpty))
+	for i, query := range nonEmpty {
+		terms[i] = query.operand(op)
+	}
+	return &Query{expr: strings.Join(terms, " "+op+" "), op: op}
+}
+
+// operand returns the query as an operand of "op", in parentheses unless that is unnecessary. A
+// negation is only left bare under AND: Lucene reads "a OR NOT b" as "a AND NOT b".
+func (query *Query) operand(op string) string {
+	if query.op == "" || (query.op == op && op != queryOperatorNot) || (query.op == queryOperatorNot && op == queryOperatorAnd) {
+		return query.expr
+	}
+	return "(" + query.expr + ")"
+}
+
+// Field returns a builder for a clause on the field named "name", which is combined with the
+// query using AND.
+func (query *Query) Field(name string) *FieldQuery {
+	return &FieldQuery{query: query, name: escapeQueryTerm(name, "")}
+}
+
+// Tag restricts the query to the resources with the user tag "tagName" (e.g. "env:prod").
+func (query *Query) Tag(tagName string) *Query {
+	return query.Field("tags").Eq(tagName)
+}
+
+// ServiceTag restricts the query to the resources with the service tag "tagName".
+func (query *Query) ServiceTag(tagName string) *Query {
+	return query.Field("service_tags").Eq(tagName)
+}
+
+// AccessTag restricts the query to the resources with the access tag "tagName".
+func (query *Query) AccessTag(tagName string) *Query {
+	return query.Field("access_tags").Eq(tagName)
+}
+
+// CRN restricts the query to the resources with any of "crns".
+func (query *Query) CRN(crns ...string) *Query {
+	return query.Field("crn").In(crns...)
+}
+
+// Family restricts the query to the resources of the family "family" (e.g. "resource_controller").
+func (query *Query) Family(family string) *Query {
+	return query.Field("family").Eq(family)
+}
+
+// Type restricts the query to the resources of the type "resourceType" (e.g. "resource-instance").
+func (query *Query) Type(resourceType string) *Query {
+	return query.Field("type").Eq(resourceType)
+}
+
+// Region restricts the query to the resources in the region "region".
+func (query *Query) Region(region string) *Query {
+	return query.Field("region").Eq(region)
+}
+
+// ServiceName restricts the query to the resources of the service "serviceName".
+func (query *Query) ServiceName(serviceName string) *Query {
+	return query.Field("service_name").Eq(serviceName)
+}
+
+// FieldQuery : A builder for a clause on a single field of a Query.
+type FieldQuery struct {
+	query *Query
+	name  string
+}
+
+func (field *FieldQuery) clause(value string) *Query {
+	return field.query.And(&Query{expr: field.name + ":" + value})
+}
+
+// Eq restricts the query to the resources whose field has the value "value". The value is quoted,
+// so it may contain any character.
+func (field *FieldQuery) Eq(value string) *Query {
+	return field.clause(quoteQueryValue(value))
+}
+
+// In restricts the query to the resources whose field has any of "values". If no values are
+// specified, the query matches no resource.
+func (field *FieldQuery) In(values ...string) *Query {
+	if len(values) == 0 {
+		return field.query.And(matchNothing())
+	}
+	if len(values) == 1 {
+		return field.Eq(values[0])
+	}
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = quoteQueryValue(value)
+	}
+	return field.clause("(" + strings.Join(quoted, " "+queryOperatorOr+" ") + ")")
+}
+
+// Wildcard restricts the query to the resources whose field matches "pattern", in which "*"
+// matches any sequence of characters and "?" any single character. The other characters of the
+// pattern are matched literally.
+func (field *FieldQuery) Wildcard(pattern string) *Query {
+	return field.clause(escapeQueryTerm(pattern, "*?"))
+}
+
+// Exists restricts the query to the resources that have a value for the field.
+func (field *FieldQuery) Exists() *Query {
+	return field.query.And(&Query{expr: "_exists_:" + field.name})
+}
+
+// Range restricts the query to the resources whose field is between "from" and "to", inclusive.
+// An empty bound leaves the range open on that side.
+func (field *FieldQuery) Range(from string, to string) *Query {
+	bound := func(value string) string {
+		if value == "" {
+			return "*"
+		}
+		return quoteQueryValue(value)
+	}
+	return field.clause("[" + bound(from) + " TO " + bound(to) + "]")
+}
+
+// TimeRange restricts the query to the resources whose date field (e.g. "creation_date") is
+// between "from" and "to", inclusive. A zero bound leaves the range open on that side.
+func (field *FieldQuery) TimeRange(from time.Time, to time.Time) *Query {
+	format := func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	return field.Range(format(from), format(to))
+}
+
+// quoteQueryValue returns "value" as a quoted Lucene phrase.
+func quoteQueryValue(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// escapeQueryTerm escapes the Lucene special characters of "term", except those in "keep".
+func escapeQueryTerm(term string, keep string) string {
+	var builder strings.Builder
+	for _, r := range term {
+		if strings.ContainsRune(luceneSpecialCharacters, r) && !strings.ContainsRune(keep, r) {
+			builder.WriteRune('\\')
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
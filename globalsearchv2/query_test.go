@@ -0,0 +1,77 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalSearchV2 Query`, func() {
+	It(`Quote and escape the values`, func() {
+		Expect(globalsearchv2.Q().Field("type").Eq("resource-instance").And(globalsearchv2.Q().Tag("env:prod")).String()).To(Equal(`type:"resource-instance" AND tags:"env:prod"`))
+		Expect(globalsearchv2.Q().Tag(`say "hi" \o/`).String()).To(Equal(`tags:"say \"hi\" \\o/"`))
+		Expect(globalsearchv2.Q().CRN("crn:v1:bluemix:public:a::").String()).To(Equal(`crn:"crn:v1:bluemix:public:a::"`))
+		Expect(globalsearchv2.Q().CRN("crn:1", "crn:2").String()).To(Equal(`crn:("crn:1" OR "crn:2")`))
+		Expect(globalsearchv2.Q().Field("doc.extended:name").Eq("x").String()).To(Equal(`doc.extended\:name:"x"`))
+	})
+	It(`Chain the clauses with AND`, func() {
+		query := globalsearchv2.Q().Family("resource_controller").Type("resource-instance").Region("us-south").ServiceName("cloud-object-storage")
+		Expect(query.String()).To(Equal(`family:"resource_controller" AND type:"resource-instance" AND region:"us-south" AND service_name:"cloud-object-storage"`))
+		Expect(globalsearchv2.Q().ServiceTag("billing:shared").AccessTag("project:a").String()).To(Equal(`service_tags:"billing:shared" AND access_tags:"project:a"`))
+	})
+	It(`Group the nested queries`, func() {
+		regions := globalsearchv2.Q().Region("us-south").Or(globalsearchv2.Q().Region("eu-de"))
+		Expect(globalsearchv2.Q().Tag("env:prod").And(regions).String()).To(Equal(`tags:"env:prod" AND (region:"us-south" OR region:"eu-de")`))
+		Expect(regions.Or(globalsearchv2.Q().Region("jp-tok")).String()).To(Equal(`region:"us-south" OR region:"eu-de" OR region:"jp-tok"`))
+		Expect(globalsearchv2.Q().Tag("a:1").Tag("b:2").Or(globalsearchv2.Q().Tag("c:3")).String()).To(Equal(`(tags:"a:1" AND tags:"b:2") OR tags:"c:3"`))
+	})
+	It(`Negate the queries`, func() {
+		Expect(globalsearchv2.Q().Type("resource-instance").And(globalsearchv2.Q().Tag("env:prod").Not()).String()).To(Equal(`type:"resource-instance" AND NOT tags:"env:prod"`))
+		Expect(globalsearchv2.Q().Region("us-south").Or(globalsearchv2.Q().Region("eu-de")).Not().String()).To(Equal(`NOT (region:"us-south" OR region:"eu-de")`))
+		Expect(globalsearchv2.Q().Tag("a:1").Not().Not().String()).To(Equal(`NOT (NOT tags:"a:1")`))
+		Expect(globalsearchv2.Q().Tag("b:2").Or(globalsearchv2.Q().Tag("a:1").Not()).String()).To(Equal(`tags:"b:2" OR (NOT tags:"a:1")`))
+		Expect(globalsearchv2.Q().Tag("a:1").Not().Or(globalsearchv2.Q().Tag("b:2")).String()).To(Equal(`(NOT tags:"a:1") OR tags:"b:2"`))
+	})
+	It(`Build wildcard, range and exists clauses`, func() {
+		Expect(globalsearchv2.Q().Field("name").Wildcard("my app-*?").String()).To(Equal(`name:my\ app\-*?`))
+		Expect(globalsearchv2.Q().Field("name").Exists().String()).To(Equal(`_exists_:name`))
+		Expect(globalsearchv2.Q().Field("name").Range("a", "").String()).To(Equal(`name:["a" TO *]`))
+
+		from := time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+		Expect(globalsearchv2.Q().Field("creation_date").TimeRange(from, time.Time{}).String()).To(Equal(`creation_date:["2024-01-02T02:04:05Z" TO *]`))
+	})
+	It(`Match nothing with the negation of the empty query and an empty set of values`, func() {
+		nothing := globalsearchv2.Q().Not()
+		Expect(nothing.String()).To(Equal("NOT *"))
+		Expect(nothing.Not().String()).To(Equal("*"))
+		Expect(globalsearchv2.Q().Tag("a:1").And(nothing).String()).To(Equal("NOT *"))
+		Expect(globalsearchv2.Q().Tag("a:1").Or(nothing).String()).To(Equal(`tags:"a:1"`))
+		Expect(nothing.Or(globalsearchv2.Q().Not()).String()).To(Equal("NOT *"))
+
+		Expect(globalsearchv2.Q().Field("crn").In().String()).To(Equal("NOT *"))
+		Expect(globalsearchv2.Q().Type("resource-instance").CRN().String()).To(Equal("NOT *"))
+		Expect(globalsearchv2.Q().Type("resource-instance").CRN().Or(globalsearchv2.Q().Tag("a:1")).String()).To(Equal(`tags:"a:1"`))
+	})
+	It(`Ignore the empty queries`, func() {
+		Expect(globalsearchv2.Q().String()).To(Equal("*"))
+		Expect(globalsearchv2.Q().And(globalsearchv2.Q(), globalsearchv2.Q().Tag("a:1")).String()).To(Equal(`tags:"a:1"`))
+	})
+})
@@ -0,0 +1,206 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// SearchPager can be used to simplify the use of the "Search" method.
+//
+// The pager feeds the search cursor returned by each call back into the next one, using
+// the "Limit" field of the options (if set) as the page size. Retrieval ends when the
+// service stops returning a cursor or returns an empty page. Items whose CRN has already
+// been returned by an earlier page are dropped.
+type SearchPager struct {
+	hasNext     bool
+	options     *SearchOptions
+	client      *GlobalSearchV2
+	seen        map[string]struct{}
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewSearchPager returns a new SearchPager instance.
+func (globalSearch *GlobalSearchV2) NewSearchPager(options *SearchOptions) (pager *SearchPager, err error) {
+	if options.SearchCursor != nil && *options.SearchCursor != "" {
+		err = core.SDKErrorf(nil, "the 'options.SearchCursor' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy SearchOptions = *options
+	pager = &SearchPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  globalSearch,
+		seen:    make(map[string]struct{}),
+	}
+	return
+}
+
+// searchPagerCheckpoint is the serialized state of a SearchPager.
+type searchPagerCheckpoint struct {
+	// The state shared by all pagers, as serialized by common.MarshalPagerCheckpoint().
+	Pager json.RawMessage `json:"pager"`
+
+	// The CRNs of the items returned so far, used to drop duplicates from later pages.
+	Seen []string `json:"seen,omitempty"`
+}
+
+// NewSearchPagerFromCheckpoint returns a new SearchPager instance that resumes
+// from the position recorded by SearchPager.Checkpoint().
+func (globalSearch *GlobalSearchV2) NewSearchPagerFromCheckpoint(checkpoint []byte) (pager *SearchPager, err error) {
+	state := &searchPagerCheckpoint{}
+	err = json.Unmarshal(checkpoint, state)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "checkpoint-unmarshal-error", common.GetComponentInfo())
+		return nil, err
+	}
+
+	pager = &SearchPager{
+		client: globalSearch,
+		seen:   make(map[string]struct{}, len(state.Seen)),
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[SearchOptions, string]("SearchPager", state.Pager)
+	if err != nil {
+		return nil, err
+	}
+	for _, crn := range state.Seen {
+		pager.seen[crn] = struct{}{}
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *SearchPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *SearchPager) GetNextWithContext(ctx context.Context) (page []ResultItem, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.SearchCursor = pager.pageContext.next
+
+	result, _, err := pager.client.SearchWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	page = make([]ResultItem, 0, len(result.Items))
+	for _, item := range result.Items {
+		if item.CRN != nil {
+			if _, found := pager.seen[*item.CRN]; found {
+				continue
+			}
+			pager.seen[*item.CRN] = struct{}{}
+		}
+		page = append(page, item)
+	}
+
+	var next *string
+	if result.SearchCursor != nil && *result.SearchCursor != "" && len(result.Items) > 0 {
+		next = result.SearchCursor
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *SearchPager) GetAllWithContext(ctx context.Context) (allItems []ResultItem, err error) {
+	for pager.HasNext() {
+		var nextPage []ResultItem
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *SearchPager) GetNext() (page []ResultItem, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *SearchPager) GetAll() (allItems []ResultItem, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *SearchPager) All(ctx context.Context) iter.Seq2[ResultItem, error] {
+	return common.All[ResultItem](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options, the search cursor
+// of the next page of results and the CRNs returned so far. It can be passed to
+// NewSearchPagerFromCheckpoint() to resume retrieving results, e.g. after a restart.
+// Note that the service may expire a search cursor that is not used for some time.
+func (pager *SearchPager) Checkpoint() ([]byte, error) {
+	state := &searchPagerCheckpoint{}
+	var err error
+	state.Pager, err = common.MarshalPagerCheckpoint("SearchPager", pager.options, pager.hasNext, pager.pageContext.next)
+	if err != nil {
+		return nil, err
+	}
+	for crn := range pager.seen {
+		state.Seen = append(state.Seen, crn)
+	}
+	sort.Strings(state.Seen)
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "checkpoint-marshal-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return data, nil
+}
+
+// Scan returns an iterator over every resource matched by "searchOptions", following the
+// search cursor from page to page. It is a shorthand for creating a SearchPager and
+// ranging over its All() method; an error creating the pager is yielded as the only element.
+func (globalSearch *GlobalSearchV2) Scan(ctx context.Context, searchOptions *SearchOptions) iter.Seq2[ResultItem, error] {
+	pager, err := globalSearch.NewSearchPager(searchOptions)
+	if err != nil {
+		return func(yield func(ResultItem, error) bool) {
+			yield(ResultItem{}, err)
+		}
+	}
+	return pager.All(ctx)
+}
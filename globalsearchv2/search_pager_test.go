@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalSearchV2 SearchPager`, func() {
+	var testServer *httptest.Server
+	var requestCursors []interface{}
+	searchPath := "/v3/resources/search"
+
+	BeforeEach(func() {
+		var requestNumber int = 0
+		requestCursors = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			// Verify the contents of the request
+			Expect(req.URL.EscapedPath()).To(Equal(searchPath))
+			Expect(req.Method).To(Equal("POST"))
+			Expect(req.URL.Query()["limit"]).To(Equal([]string{"2"}))
+
+			var body map[string]interface{}
+			bodyBytes, _ := io.ReadAll(req.Body)
+			Expect(json.Unmarshal(bodyBytes, &body)).To(Succeed())
+			requestCursors = append(requestCursors, body["search_cursor"])
+
+			// Set mock response
+			res.Header().Set("Content-type", "application/json")
+			requestNumber++
+			if requestNumber == 1 {
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"search_cursor":"cursor-1","limit":2,"items":[{"crn":"crn-1"},{"crn":"crn-2"}]}`)
+			} else if requestNumber == 2 {
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"search_cursor":"cursor-2","limit":2,"items":[{"crn":"crn-2"},{"crn":"crn-3"}]}`)
+			} else if requestNumber == 3 {
+				res.WriteHeader(200)
+				fmt.Fprintf(res, "%s", `{"search_cursor":"cursor-3","limit":2,"items":[]}`)
+			} else {
+				res.WriteHeader(400)
+			}
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func() *globalsearchv2.GlobalSearchV2 {
+		globalSearchService, serviceErr := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(globalSearchService).ToNot(BeNil())
+		return globalSearchService
+	}
+
+	crnsOf := func(items []globalsearchv2.ResultItem) (crns []string) {
+		for _, item := range items {
+			crns = append(crns, *item.CRN)
+		}
+		return
+	}
+
+	It(`Use SearchPager.GetNext successfully`, func() {
+		searchOptionsModel := &globalsearchv2.SearchOptions{
+			Query: core.StringPtr("testString"),
+			Limit: core.Int64Ptr(int64(2)),
+		}
+
+		pager, err := newService().NewSearchPager(searchOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(pager).ToNot(BeNil())
+
+		var allResults []globalsearchv2.ResultItem
+		for pager.HasNext() {
+			nextPage, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(nextPage).ToNot(BeNil())
+			allResults = append(allResults, nextPage...)
+		}
+		Expect(crnsOf(allResults)).To(Equal([]string{"crn-1", "crn-2", "crn-3"}))
+		Expect(requestCursors).To(Equal([]interface{}{nil, "cursor-1", "cursor-2"}))
+		Expect(searchOptionsModel.SearchCursor).To(BeNil())
+	})
+	It(`Use SearchPager.Checkpoint successfully`, func() {
+		searchOptionsModel := &globalsearchv2.SearchOptions{
+			Query: core.StringPtr("testString"),
+			Limit: core.Int64Ptr(int64(2)),
+		}
+
+		pager, err := newService().NewSearchPager(searchOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(pager).ToNot(BeNil())
+
+		var allResults []globalsearchv2.ResultItem
+		nextPage, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		allResults = append(allResults, nextPage...)
+
+		checkpoint, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+		Expect(checkpoint).ToNot(BeNil())
+
+		pager, err = newService().NewSearchPagerFromCheckpoint(checkpoint)
+		Expect(err).To(BeNil())
+		Expect(pager).ToNot(BeNil())
+
+		for pager.HasNext() {
+			nextPage, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			Expect(nextPage).ToNot(BeNil())
+			allResults = append(allResults, nextPage...)
+		}
+		Expect(crnsOf(allResults)).To(Equal([]string{"crn-1", "crn-2", "crn-3"}))
+		Expect(requestCursors).To(Equal([]interface{}{nil, "cursor-1", "cursor-2"}))
+		Expect(searchOptionsModel.SearchCursor).To(BeNil())
+	})
+	It(`Use SearchPager.GetAll successfully`, func() {
+		searchOptionsModel := &globalsearchv2.SearchOptions{
+			Query: core.StringPtr("testString"),
+			Limit: core.Int64Ptr(int64(2)),
+		}
+
+		pager, err := newService().NewSearchPager(searchOptionsModel)
+		Expect(err).To(BeNil())
+		Expect(pager).ToNot(BeNil())
+
+		allResults, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(crnsOf(allResults)).To(Equal([]string{"crn-1", "crn-2", "crn-3"}))
+	})
+	It(`Use Scan successfully`, func() {
+		searchOptionsModel := &globalsearchv2.SearchOptions{
+			Query: core.StringPtr("testString"),
+			Limit: core.Int64Ptr(int64(2)),
+		}
+
+		var allResults []globalsearchv2.ResultItem
+		for item, err := range newService().Scan(context.Background(), searchOptionsModel) {
+			Expect(err).To(BeNil())
+			allResults = append(allResults, item)
+		}
+		Expect(crnsOf(allResults)).To(Equal([]string{"crn-1", "crn-2", "crn-3"}))
+	})
+	It(`Invoke NewSearchPager with error: SearchCursor already set`, func() {
+		searchOptionsModel := &globalsearchv2.SearchOptions{
+			SearchCursor: core.StringPtr("testString"),
+		}
+
+		pager, err := newService().NewSearchPager(searchOptionsModel)
+		Expect(err).ToNot(BeNil())
+		Expect(pager).To(BeNil())
+
+		var errs []error
+		for _, err := range newService().Scan(context.Background(), searchOptionsModel) {
+			errs = append(errs, err)
+		}
+		Expect(len(errs)).To(Equal(1))
+		Expect(errs[0]).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,300 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The default values of the BulkTagOptions fields.
+const (
+	DefaultBulkTagBatchSize     = 100
+	DefaultBulkTagConcurrency   = 4
+	DefaultBulkTagMaxRetries    = 3
+	DefaultBulkTagRetryInterval = time.Second
+)
+
+// ErrTagResultIsError is the error recorded for a resource whose result in the response to an
+// attach or detach request has "is_error" set.
+var ErrTagResultIsError = errors.New("the tagging service reported an error for the resource")
+
+// BulkTagOptions : The options for the BulkAttachTags and BulkDetachTags methods.
+type BulkTagOptions struct {
+	// The names of the tags to attach or detach.
+	TagNames []string
+
+	// The type of the tags. Defaults to "user".
+	TagType string
+
+	// The ID of the billing account of the resources, which is required for service tags.
+	AccountID string
+
+	// Whether the tags replace, or update, those attached to the resources (BulkAttachTags only).
+	// See AttachTagOptions.
+	Replace bool
+	Update  bool
+
+	// The maximum number of resources in each request. Defaults to DefaultBulkTagBatchSize.
+	BatchSize int
+
+	// The maximum number of concurrent requests. Defaults to DefaultBulkTagConcurrency.
+	Concurrency int
+
+	// The maximum number of times that the failed resources are retried. Defaults to
+	// DefaultBulkTagMaxRetries; a negative value disables retries.
+	MaxRetries int
+
+	// The interval before the first retry, which doubles for each subsequent retry. Defaults to
+	// DefaultBulkTagRetryInterval.
+	RetryInterval time.Duration
+}
+
+// BulkTagResult : The outcome of a bulk tag operation for a single resource.
+type BulkTagResult struct {
+	// The number of requests in which the resource was specified.
+	Attempts int
+
+	// The error of the last attempt, or nil if the operation succeeded.
+	Err error
+}
+
+// BulkTagReport : The outcome of a bulk tag operation.
+type BulkTagReport struct {
+	// The outcome for each resource, keyed by CRN.
+	Results map[string]*BulkTagResult
+
+	// The number of attach or detach requests that were sent.
+	Requests int
+}
+
+// Succeeded returns the sorted CRNs of the resources for which the operation succeeded.
+func (report *BulkTagReport) Succeeded() (crns []string) {
+	for crn, result := range report.Results {
+		if result.Err == nil {
+			crns = append(crns, crn)
+		}
+	}
+	sort.Strings(crns)
+	return
+}
+
+// Failed returns the errors of the resources for which the operation failed, keyed by CRN.
+func (report *BulkTagReport) Failed() map[string]error {
+	failed := make(map[string]error)
+	for crn, result := range report.Results {
+		if result.Err != nil {
+			failed[crn] = result.Err
+		}
+	}
+	return failed
+}
+
+// BulkAttachTags attaches tags to any number of resources, identified by "crns". The resources are
+// split into batches that are sent concurrently, and the resources that fail are retried. The
+// outcome for each resource is recorded in the report; an error is returned only if the options
+// are invalid or "ctx" is done.
+func (globalTagging *GlobalTaggingV1) BulkAttachTags(ctx context.Context, crns []string, options *BulkTagOptions) (*BulkTagReport, error) {
+	return globalTagging.bulkTag(ctx, crns, options, func(ctx context.Context, resources []Resource) (*TagResults, *core.DetailedResponse, error) {
+		attachOptions := globalTagging.NewAttachTagOptions(resources).SetTagNames(options.TagNames)
+		if options.TagType != "" {
+			attachOptions.SetTagType(options.TagType)
+		}
+		if options.AccountID != "" {
+			attachOptions.SetAccountID(options.AccountID)
+		}
+		if options.Replace {
+			attachOptions.SetReplace(true)
+		}
+		if options.Update {
+			attachOptions.SetUpdate(true)
+		}
+		return globalTagging.AttachTagWithContext(ctx, attachOptions)
+	})
+}
+
+// BulkDetachTags detaches tags from any number of resources, identified by "crns", in the same
+// way that BulkAttachTags attaches them.
+func (globalTagging *GlobalTaggingV1) BulkDetachTags(ctx context.Context, crns []string, options *BulkTagOptions) (*BulkTagReport, error) {
+	return globalTagging.bulkTag(ctx, crns, options, func(ctx context.Context, resources []Resource) (*TagResults, *core.DetailedResponse, error) {
+		detachOptions := globalTagging.NewDetachTagOptions(resources).SetTagNames(options.TagNames)
+		if options.TagType != "" {
+			detachOptions.SetTagType(options.TagType)
+		}
+		if options.AccountID != "" {
+			detachOptions.SetAccountID(options.AccountID)
+		}
+		return globalTagging.DetachTagWithContext(ctx, detachOptions)
+	})
+}
+
+// bulkTagOperation sends an attach or detach request for "resources".
+type bulkTagOperation func(ctx context.Context, resources []Resource) (*TagResults, *core.DetailedResponse, error)
+
+func (globalTagging *GlobalTaggingV1) bulkTag(ctx context.Context, crns []string, options *BulkTagOptions, operation bulkTagOperation) (report *BulkTagReport, err error) {
+	if options == nil || len(options.TagNames) == 0 {
+		err = core.SDKErrorf(nil, "at least one tag name must be specified", "no-tag-names", common.GetComponentInfo())
+		return
+	}
+	batchSize := options.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBulkTagBatchSize
+	}
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkTagConcurrency
+	}
+	maxRetries := options.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultBulkTagMaxRetries
+	}
+	retryInterval := options.RetryInterval
+	if retryInterval <= 0 {
+		retryInterval = DefaultBulkTagRetryInterval
+	}
+
+	report = &BulkTagReport{
+		Results: make(map[string]*BulkTagResult),
+	}
+	var pending []string
+	for _, crn := range crns {
+		if _, found := report.Results[crn]; !found {
+			report.Results[crn] = &BulkTagResult{}
+			pending = append(pending, crn)
+		}
+	}
+
+	for attempt := 0; len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(retryInterval << (attempt - 1))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				err = core.SDKErrorf(ctx.Err(), "", "bulk-tag-cancelled", common.GetComponentInfo())
+				return
+			case <-timer.C:
+			}
+		}
+		retryable := runBulkTagBatches(ctx, report, pending, batchSize, concurrency, operation)
+		if ctx.Err() != nil {
+			err = core.SDKErrorf(ctx.Err(), "", "bulk-tag-cancelled", common.GetComponentInfo())
+			return
+		}
+		if attempt >= maxRetries {
+			break
+		}
+		pending = retryable
+	}
+	return
+}
+
+// runBulkTagBatches sends the requests for "crns" in batches of "batchSize", at most "concurrency"
+// at a time, records the outcomes in "report" and returns the CRNs of the resources that failed
+// and can be retried.
+func runBulkTagBatches(ctx context.Context, report *BulkTagReport, crns []string, batchSize int, concurrency int, operation bulkTagOperation) (retryable []string) {
+	batches := make(chan []string)
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	for range min(concurrency, (len(crns)+batchSize-1)/batchSize) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				failed := runBulkTagBatch(ctx, report, &mutex, batch, operation)
+				mutex.Lock()
+				retryable = append(retryable, failed...)
+				mutex.Unlock()
+			}
+		}()
+	}
+	for start := 0; start < len(crns); start += batchSize {
+		batches <- crns[start:min(start+batchSize, len(crns))]
+	}
+	close(batches)
+	wg.Wait()
+
+	// Retry the resources in their original order.
+	order := make(map[string]int, len(crns))
+	for i, crn := range crns {
+		order[crn] = i
+	}
+	sort.Slice(retryable, func(i, j int) bool {
+		return order[retryable[i]] < order[retryable[j]]
+	})
+	return
+}
+
+// runBulkTagBatch sends a single request for "batch" and returns the CRNs of the resources that
+// failed and can be retried.
+func runBulkTagBatch(ctx context.Context, report *BulkTagReport, mutex *sync.Mutex, batch []string, operation bulkTagOperation) (retryable []string) {
+	resources := make([]Resource, len(batch))
+	for i, crn := range batch {
+		resources[i] = Resource{ResourceID: core.StringPtr(crn)}
+	}
+	tagResults, response, err := operation(ctx, resources)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	report.Requests++
+	for _, crn := range batch {
+		report.Results[crn].Attempts++
+	}
+
+	if err != nil {
+		// Only the failures that may be transient are retried.
+		canRetry := response == nil || response.StatusCode == 429 || response.StatusCode >= 500
+		for _, crn := range batch {
+			report.Results[crn].Err = err
+			if canRetry {
+				retryable = append(retryable, crn)
+			}
+		}
+		return
+	}
+
+	reported := make(map[string]bool, len(batch))
+	if tagResults != nil {
+		for _, item := range tagResults.Results {
+			crn := core.StringNilMapper(item.ResourceID)
+			result, found := report.Results[crn]
+			if !found {
+				continue
+			}
+			reported[crn] = true
+			if item.IsError != nil && *item.IsError {
+				result.Err = core.SDKErrorf(ErrTagResultIsError, "", "tag-result-error", common.GetComponentInfo())
+				retryable = append(retryable, crn)
+			} else {
+				result.Err = nil
+			}
+		}
+	}
+	for _, crn := range batch {
+		if !reported[crn] {
+			report.Results[crn].Err = core.SDKErrorf(nil, fmt.Sprintf("no result was returned for resource '%s'", crn), "missing-tag-result", common.GetComponentInfo())
+			retryable = append(retryable, crn)
+		}
+	}
+	return
+}
@@ -0,0 +1,131 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1 bulk tag operations`, func() {
+	var fake *mockservers.GlobalTaggingV1Server
+	var globalTaggingService *globaltaggingv1.GlobalTaggingV1
+	var crns []string
+	var bulkOptions *globaltaggingv1.BulkTagOptions
+
+	BeforeEach(func() {
+		fake = mockservers.NewGlobalTaggingV1Server()
+		fake.SetMaxResourcesPerRequest(globaltaggingv1.DefaultBulkTagBatchSize)
+		var err error
+		globalTaggingService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+
+		crns = nil
+		for i := range 250 {
+			crns = append(crns, fmt.Sprintf("crn:v1:bluemix:public:service:us-south:a/account-1:instance-%d::", i))
+		}
+		bulkOptions = &globaltaggingv1.BulkTagOptions{
+			TagNames:      []string{"env:prod", "team:data"},
+			RetryInterval: time.Millisecond,
+		}
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It(`Attach and detach tags in batches`, func() {
+		report, err := globalTaggingService.BulkAttachTags(context.Background(), append(crns, crns[0]), bulkOptions)
+		Expect(err).To(BeNil())
+		Expect(report.Requests).To(Equal(3))
+		Expect(report.Results).To(HaveLen(250))
+		Expect(report.Failed()).To(BeEmpty())
+		Expect(report.Succeeded()).To(HaveLen(250))
+		Expect(report.Results[crns[249]].Attempts).To(Equal(1))
+		Expect(fake.AttachedTags("user", crns[249])).To(Equal([]string{"env:prod", "team:data"}))
+
+		bulkOptions.TagNames = []string{"team:data"}
+		bulkOptions.BatchSize = 50
+		report, err = globalTaggingService.BulkDetachTags(context.Background(), crns, bulkOptions)
+		Expect(err).To(BeNil())
+		Expect(report.Requests).To(Equal(5))
+		Expect(report.Failed()).To(BeEmpty())
+		Expect(fake.AttachedTags("user", crns[0])).To(Equal([]string{"env:prod"}))
+	})
+	It(`Retry only the resources that failed`, func() {
+		fake.FailResource(crns[10], 2)
+		fake.FailResource(crns[200], 100)
+
+		report, err := globalTaggingService.BulkAttachTags(context.Background(), crns, bulkOptions)
+		Expect(err).To(BeNil())
+
+		// 3 initial requests, then 2 retries of both resources and a last retry of the second.
+		Expect(report.Requests).To(Equal(6))
+		Expect(report.Results[crns[0]].Attempts).To(Equal(1))
+		Expect(report.Results[crns[10]].Attempts).To(Equal(3))
+		Expect(report.Results[crns[10]].Err).To(BeNil())
+		Expect(report.Results[crns[200]].Attempts).To(Equal(4))
+
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(errors.Is(failed[crns[200]], globaltaggingv1.ErrTagResultIsError)).To(BeTrue())
+		Expect(fake.AttachedTags("user", crns[200])).To(BeEmpty())
+	})
+	It(`Retry the batches that fail with a transient error`, func() {
+		fake.AddFault(mockservers.Fault{
+			Method:     http.MethodPost,
+			Path:       "/v3/tags/attach",
+			StatusCode: 503,
+		})
+		bulkOptions.Concurrency = 1
+		report, err := globalTaggingService.BulkAttachTags(context.Background(), crns, bulkOptions)
+		Expect(err).To(BeNil())
+		Expect(report.Requests).To(Equal(4))
+		Expect(report.Failed()).To(BeEmpty())
+		Expect(report.Results[crns[0]].Attempts).To(Equal(2))
+		Expect(report.Results[crns[100]].Attempts).To(Equal(1))
+	})
+	It(`Do not retry the batches that are rejected`, func() {
+		bulkOptions.BatchSize = 200
+		report, err := globalTaggingService.BulkAttachTags(context.Background(), crns, bulkOptions)
+		Expect(err).To(BeNil())
+		Expect(report.Requests).To(Equal(2))
+		Expect(report.Failed()).To(HaveLen(200))
+		Expect(report.Results[crns[0]].Err.Error()).To(ContainSubstring("at most 100 resources"))
+		Expect(report.Results[crns[249]].Err).To(BeNil())
+	})
+	It(`Stop when the context is cancelled`, func() {
+		fake.FailResource(crns[0], 100)
+		bulkOptions.RetryInterval = time.Hour
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		report, err := globalTaggingService.BulkAttachTags(ctx, crns, bulkOptions)
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(report.Failed()).To(HaveLen(1))
+	})
+	It(`Require at least one tag name`, func() {
+		_, err := globalTaggingService.BulkAttachTags(context.Background(), crns, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,252 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The reasons for which an unattached tag is kept by a TagCleanupPlan.
+const (
+	TagCleanupSkipNotIncluded = "not_included"
+	TagCleanupSkipExcluded    = "excluded"
+	TagCleanupSkipTooRecent   = "too_recent"
+)
+
+// TagCleanupOptions : The options for the PlanTagCleanup method.
+type TagCleanupOptions struct {
+	// The type of the tags to clean up. Defaults to "user".
+	TagType string
+
+	// The ID of the account, which is required for service tags.
+	AccountID string
+
+	// If set, only the tags whose name matches at least one of these patterns are deleted.
+	Include []*regexp.Regexp
+
+	// The tags whose name matches any of these patterns are kept.
+	Exclude []*regexp.Regexp
+
+	// If set, only the tags that were created at least this long ago are deleted. As the tagging
+	// service does not report when tags were created, TagCreatedAt must be set as well.
+	MinAge time.Duration
+
+	// Returns the time at which a tag was created (e.g. from an inventory or the activity log).
+	// A zero time means that it is unknown, in which case the tag is kept.
+	TagCreatedAt func(ctx context.Context, tagType string, tagName string) (time.Time, error)
+
+	// Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// SkippedTag : An unattached tag that a TagCleanupPlan keeps.
+type SkippedTag struct {
+	TagName string
+
+	// The reason for which the tag is kept (e.g. TagCleanupSkipExcluded).
+	Reason string
+}
+
+// TagCleanupPlan : The unattached tags to delete. The plan can be reviewed, and Tags narrowed
+// down, before it is applied with ApplyTagCleanup.
+type TagCleanupPlan struct {
+	TagType   string
+	AccountID string
+
+	// The sorted names of the tags to delete.
+	Tags []string
+
+	// The unattached tags that are kept.
+	Skipped []SkippedTag
+}
+
+// Empty returns true if the plan deletes no tags.
+func (plan *TagCleanupPlan) Empty() bool {
+	return len(plan.Tags) == 0
+}
+
+// String returns a preview of the plan.
+func (plan *TagCleanupPlan) String() string {
+	if plan.Empty() {
+		return fmt.Sprintf("No unattached %s tags to delete.\n", plan.TagType)
+	}
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%d unattached %s tag(s) to delete:\n", len(plan.Tags), plan.TagType)
+	for _, tagName := range plan.Tags {
+		fmt.Fprintf(&builder, "  - %s\n", tagName)
+	}
+	if len(plan.Skipped) > 0 {
+		fmt.Fprintf(&builder, "%d unattached %s tag(s) kept:\n", len(plan.Skipped), plan.TagType)
+		for _, skipped := range plan.Skipped {
+			fmt.Fprintf(&builder, "    %s (%s)\n", skipped.TagName, skipped.Reason)
+		}
+	}
+	return builder.String()
+}
+
+// TagDeletionResult : The outcome of the deletion of a tag.
+type TagDeletionResult struct {
+	TagName string
+
+	// The error of the deletion, or nil if the tag was deleted.
+	Err error
+}
+
+// TagCleanupReport : The outcome of applying a TagCleanupPlan.
+type TagCleanupReport struct {
+	Results []TagDeletionResult
+}
+
+// Deleted returns the names of the tags that were deleted.
+func (report *TagCleanupReport) Deleted() (tagNames []string) {
+	for _, result := range report.Results {
+		if result.Err == nil {
+			tagNames = append(tagNames, result.TagName)
+		}
+	}
+	return
+}
+
+// Failed returns the errors of the tags that could not be deleted, keyed by tag name.
+func (report *TagCleanupReport) Failed() map[string]error {
+	failed := make(map[string]error)
+	for _, result := range report.Results {
+		if result.Err != nil {
+			failed[result.TagName] = result.Err
+		}
+	}
+	return failed
+}
+
+// PlanTagCleanup lists the tags that are attached to no resource and selects those to delete
+// according to "options". Unlike DeleteTagAll, nothing is deleted until the plan is applied.
+func (globalTagging *GlobalTaggingV1) PlanTagCleanup(ctx context.Context, options *TagCleanupOptions) (plan *TagCleanupPlan, err error) {
+	if options == nil {
+		options = &TagCleanupOptions{}
+	}
+	if options.MinAge > 0 && options.TagCreatedAt == nil {
+		err = core.SDKErrorf(nil, "TagCreatedAt must be set to filter the tags by age", "no-tag-created-at", common.GetComponentInfo())
+		return
+	}
+	tagType := options.TagType
+	if tagType == "" {
+		tagType = ListTagsOptionsTagTypeUserConst
+	}
+	now := time.Now
+	if options.Now != nil {
+		now = options.Now
+	}
+
+	// The unattached tags are those that are not returned when only the attached tags are listed.
+	allTags, err := globalTagging.listAllTagNames(ctx, tagType, options.AccountID, false)
+	if err != nil {
+		return
+	}
+	attachedTags, err := globalTagging.listAllTagNames(ctx, tagType, options.AccountID, true)
+	if err != nil {
+		return
+	}
+
+	plan = &TagCleanupPlan{
+		TagType:   tagType,
+		AccountID: options.AccountID,
+	}
+	skip := func(tagName string, reason string) {
+		plan.Skipped = append(plan.Skipped, SkippedTag{TagName: tagName, Reason: reason})
+	}
+	attached := make(map[string]bool, len(attachedTags))
+	for _, tagName := range attachedTags {
+		attached[tagName] = true
+	}
+	slices.Sort(allTags)
+	for _, tagName := range allTags {
+		if attached[tagName] {
+			continue
+		}
+		if len(options.Include) > 0 && !matchesAny(options.Include, tagName) {
+			skip(tagName, TagCleanupSkipNotIncluded)
+			continue
+		}
+		if matchesAny(options.Exclude, tagName) {
+			skip(tagName, TagCleanupSkipExcluded)
+			continue
+		}
+		if options.MinAge > 0 {
+			var createdAt time.Time
+			createdAt, err = options.TagCreatedAt(ctx, tagType, tagName)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "tag-created-at-error", common.GetComponentInfo())
+				return
+			}
+			if createdAt.IsZero() || now().Sub(createdAt) < options.MinAge {
+				skip(tagName, TagCleanupSkipTooRecent)
+				continue
+			}
+		}
+		plan.Tags = append(plan.Tags, tagName)
+	}
+	return
+}
+
+func matchesAny(patterns []*regexp.Regexp, s string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyTagCleanup deletes the tags of "plan" one at a time and records the outcome for each of
+// them. A tag that was attached to a resource after the plan was computed is not deleted. An error
+// is returned only if "ctx" is done.
+func (globalTagging *GlobalTaggingV1) ApplyTagCleanup(ctx context.Context, plan *TagCleanupPlan) (report *TagCleanupReport, err error) {
+	report = &TagCleanupReport{}
+	for _, tagName := range plan.Tags {
+		if ctx.Err() != nil {
+			err = core.SDKErrorf(ctx.Err(), "", "tag-cleanup-cancelled", common.GetComponentInfo())
+			return
+		}
+		deleteOptions := globalTagging.NewDeleteTagOptions(tagName).SetTagType(plan.TagType)
+		if plan.AccountID != "" {
+			deleteOptions.SetAccountID(plan.AccountID)
+		}
+		result := TagDeletionResult{TagName: tagName}
+		var deleteResults *DeleteTagResults
+		deleteResults, _, result.Err = globalTagging.DeleteTagWithContext(ctx, deleteOptions)
+		if result.Err != nil {
+			result.Err = core.RepurposeSDKProblem(result.Err, "delete-tag-error")
+		} else {
+			for _, item := range deleteResults.Results {
+				if item.IsError != nil && *item.IsError {
+					result.Err = core.SDKErrorf(nil, fmt.Sprintf("the provider '%s' reported an error for tag '%s'", core.StringNilMapper(item.Provider), tagName), "delete-tag-result-error", common.GetComponentInfo())
+					break
+				}
+			}
+		}
+		report.Results = append(report.Results, result)
+	}
+	return
+}
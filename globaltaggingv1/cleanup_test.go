@@ -0,0 +1,123 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1 tag cleanup`, func() {
+	const crn1 = "crn:v1:bluemix:public:service:us-south:a/account-1:instance-1::"
+
+	var fake *mockservers.GlobalTaggingV1Server
+	var globalTaggingService *globaltaggingv1.GlobalTaggingV1
+
+	BeforeEach(func() {
+		fake = mockservers.NewGlobalTaggingV1Server()
+		var err error
+		globalTaggingService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+
+		fake.AttachTag("user", crn1, "env:prod")
+		fake.AddTag("user", "env:old", "team:data", "keep:forever")
+		fake.AddTag("access", "project:old")
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It(`Plan and apply the deletion of the unattached tags`, func() {
+		plan, err := globalTaggingService.PlanTagCleanup(context.Background(), &globaltaggingv1.TagCleanupOptions{
+			Exclude: []*regexp.Regexp{regexp.MustCompile(`^keep:`)},
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Tags).To(Equal([]string{"env:old", "team:data"}))
+		Expect(plan.Skipped).To(Equal([]globaltaggingv1.SkippedTag{{TagName: "keep:forever", Reason: globaltaggingv1.TagCleanupSkipExcluded}}))
+		Expect(plan.String()).To(Equal("2 unattached user tag(s) to delete:\n  - env:old\n  - team:data\n" +
+			"1 unattached user tag(s) kept:\n    keep:forever (excluded)\n"))
+		Expect(fake.Requests()).To(HaveLen(2))
+
+		report, err := globalTaggingService.ApplyTagCleanup(context.Background(), plan)
+		Expect(err).To(BeNil())
+		Expect(report.Deleted()).To(Equal([]string{"env:old", "team:data"}))
+		Expect(report.Failed()).To(BeEmpty())
+
+		plan, err = globalTaggingService.PlanTagCleanup(context.Background(), nil)
+		Expect(err).To(BeNil())
+		Expect(plan.Tags).To(Equal([]string{"keep:forever"}))
+	})
+	It(`Select the tags by pattern and age`, func() {
+		now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		createdAt := map[string]time.Time{
+			"env:old":   now.Add(-90 * 24 * time.Hour),
+			"team:data": now.Add(-time.Hour),
+		}
+		plan, err := globalTaggingService.PlanTagCleanup(context.Background(), &globaltaggingv1.TagCleanupOptions{
+			Include: []*regexp.Regexp{regexp.MustCompile(`^(env|team):`)},
+			MinAge:  30 * 24 * time.Hour,
+			TagCreatedAt: func(_ context.Context, tagType string, tagName string) (time.Time, error) {
+				return createdAt[tagName], nil
+			},
+			Now: func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Tags).To(Equal([]string{"env:old"}))
+		Expect(plan.Skipped).To(Equal([]globaltaggingv1.SkippedTag{
+			{TagName: "keep:forever", Reason: globaltaggingv1.TagCleanupSkipNotIncluded},
+			{TagName: "team:data", Reason: globaltaggingv1.TagCleanupSkipTooRecent},
+		}))
+	})
+	It(`Record the tags that cannot be deleted`, func() {
+		plan, err := globalTaggingService.PlanTagCleanup(context.Background(), &globaltaggingv1.TagCleanupOptions{
+			TagType: "access",
+		})
+		Expect(err).To(BeNil())
+		Expect(plan.Tags).To(Equal([]string{"project:old"}))
+
+		fake.AttachTag("access", crn1, "project:old")
+		plan.Tags = append(plan.Tags, "project:missing")
+		report, err := globalTaggingService.ApplyTagCleanup(context.Background(), plan)
+		Expect(err).To(BeNil())
+		Expect(report.Deleted()).To(BeEmpty())
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(2))
+		Expect(failed["project:old"].Error()).To(ContainSubstring("attached"))
+		Expect(fake.AttachedTags("access", crn1)).To(Equal([]string{"project:old"}))
+	})
+	It(`Require TagCreatedAt to filter by age`, func() {
+		_, err := globalTaggingService.PlanTagCleanup(context.Background(), &globaltaggingv1.TagCleanupOptions{
+			MinAge: time.Hour,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Stop when the context is cancelled`, func() {
+		plan, err := globalTaggingService.PlanTagCleanup(context.Background(), nil)
+		Expect(err).To(BeNil())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		report, err := globalTaggingService.ApplyTagCleanup(ctx, plan)
+		Expect(err).ToNot(BeNil())
+		Expect(report.Results).To(BeEmpty())
+	})
+})
@@ -0,0 +1,52 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.87.0-91c7c775-20240320-213027
+ */
+
+package globaltaggingv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// GlobalTaggingV1API : The operations supported by the GlobalTaggingV1 service. This interface is satisfied by
+// *GlobalTaggingV1 and may be used to substitute a fake or a decorator for the service client.
+type GlobalTaggingV1API interface {
+	// ListTagsWithContext : Get all tags
+	ListTagsWithContext(ctx context.Context, listTagsOptions *ListTagsOptions) (result *TagList, response *core.DetailedResponse, err error)
+
+	// CreateTagWithContext : Create an access management tag
+	CreateTagWithContext(ctx context.Context, createTagOptions *CreateTagOptions) (result *CreateTagResults, response *core.DetailedResponse, err error)
+
+	// DeleteTagAllWithContext : Delete all unused tags
+	DeleteTagAllWithContext(ctx context.Context, deleteTagAllOptions *DeleteTagAllOptions) (result *DeleteTagsResult, response *core.DetailedResponse, err error)
+
+	// DeleteTagWithContext : Delete an unused tag
+	DeleteTagWithContext(ctx context.Context, deleteTagOptions *DeleteTagOptions) (result *DeleteTagResults, response *core.DetailedResponse, err error)
+
+	// AttachTagWithContext : Attach tags
+	AttachTagWithContext(ctx context.Context, attachTagOptions *AttachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+
+	// DetachTagWithContext : Detach tags
+	DetachTagWithContext(ctx context.Context, detachTagOptions *DetachTagOptions) (result *TagResults, response *core.DetailedResponse, err error)
+}
+
+// Verify that GlobalTaggingV1 implements the GlobalTaggingV1API interface.
+var _ GlobalTaggingV1API = (*GlobalTaggingV1)(nil)
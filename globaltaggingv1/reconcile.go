@@ -0,0 +1,402 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+)
+
+// The tag types, in the order in which they are reconciled.
+var reconciledTagTypes = []string{
+	AttachTagOptionsTagTypeUserConst,
+	AttachTagOptionsTagTypeServiceConst,
+	AttachTagOptionsTagTypeAccessConst,
+}
+
+// The Global Search fields that hold the tags of each type.
+var searchTagFields = map[string]string{
+	AttachTagOptionsTagTypeUserConst:    "tags",
+	AttachTagOptionsTagTypeServiceConst: "service_tags",
+	AttachTagOptionsTagTypeAccessConst:  "access_tags",
+}
+
+// searchCRNsPerQuery is the number of CRNs included in each Global Search query.
+const searchCRNsPerQuery = 50
+
+// The actions of a TagChange.
+const (
+	TagChangeActionAttach  = "attach"
+	TagChangeActionDetach  = "detach"
+	TagChangeActionReplace = "replace"
+)
+
+// ResourceTags : The tags of each type attached to a resource. In a desired state, a nil slice
+// leaves the tags of that type unmanaged, while an empty slice detaches all of them.
+type ResourceTags struct {
+	User    []string `json:"user,omitempty"`
+	Service []string `json:"service,omitempty"`
+	Access  []string `json:"access,omitempty"`
+}
+
+// ofType returns the tags of type "tagType".
+func (tags *ResourceTags) ofType(tagType string) []string {
+	switch tagType {
+	case AttachTagOptionsTagTypeServiceConst:
+		return tags.Service
+	case AttachTagOptionsTagTypeAccessConst:
+		return tags.Access
+	default:
+		return tags.User
+	}
+}
+
+// setType sets the tags of type "tagType".
+func (tags *ResourceTags) setType(tagType string, names []string) {
+	switch tagType {
+	case AttachTagOptionsTagTypeServiceConst:
+		tags.Service = names
+	case AttachTagOptionsTagTypeAccessConst:
+		tags.Access = names
+	default:
+		tags.User = names
+	}
+}
+
+// ReconcileTagsOptions : The options for the PlanTagReconciliation, ApplyTagReconciliation and
+// ReconcileTags methods.
+type ReconcileTagsOptions struct {
+	// The ID of the billing account of the resources, which is required for service tags.
+	AccountID string
+
+	// An optional Global Search client. If it is provided, the current tags of the resources are
+	// retrieved with a few search queries rather than with a ListTags request per resource and
+	// tag type.
+	Search *globalsearchv2.GlobalSearchV2
+
+	// If true, ReconcileTags only computes the plan.
+	DryRun bool
+
+	// The options for the attach and detach requests (only the batching, concurrency and retry
+	// fields are used).
+	BulkOptions *BulkTagOptions
+}
+
+// TagChange : The change to the tags of one type attached to a resource.
+type TagChange struct {
+	ResourceID string
+	TagType    string
+
+	// TagChangeActionAttach, TagChangeActionDetach or TagChangeActionReplace. A replacement,
+	// which attaches Desired with "replace" set, is used when tags are both added and removed.
+	Action string
+
+	// The tags that are added and removed.
+	Attach []string
+	Detach []string
+
+	// The current and desired tags.
+	Current []string
+	Desired []string
+}
+
+// TagReconciliationPlan : The changes that bring the tags of resources to a desired state.
+type TagReconciliationPlan struct {
+	// The changes, ordered by resource and tag type.
+	Changes []TagChange
+}
+
+// Empty returns true if the tags are already in the desired state.
+func (plan *TagReconciliationPlan) Empty() bool {
+	return len(plan.Changes) == 0
+}
+
+// String returns the plan as a diff, listing the tags that are added ("+") and removed ("-")
+// for each resource and tag type.
+func (plan *TagReconciliationPlan) String() string {
+	if plan.Empty() {
+		return "No changes. The tags are in the desired state.\n"
+	}
+	var builder strings.Builder
+	resourceID := ""
+	for _, change := range plan.Changes {
+		if change.ResourceID != resourceID {
+			resourceID = change.ResourceID
+			fmt.Fprintf(&builder, "%s\n", resourceID)
+		}
+		fmt.Fprintf(&builder, "  %s tags (%s):\n", change.TagType, change.Action)
+		for _, tag := range change.Attach {
+			fmt.Fprintf(&builder, "    + %s\n", tag)
+		}
+		for _, tag := range change.Detach {
+			fmt.Fprintf(&builder, "    - %s\n", tag)
+		}
+	}
+	return builder.String()
+}
+
+// TagReconciliationOperation : A bulk attach or detach operation run to apply a plan.
+type TagReconciliationOperation struct {
+	Action   string
+	TagType  string
+	TagNames []string
+	Report   *BulkTagReport
+}
+
+// TagReconciliationReport : The outcome of applying a TagReconciliationPlan.
+type TagReconciliationReport struct {
+	Operations []TagReconciliationOperation
+}
+
+// Failed returns the errors of the changes that failed, keyed by resource CRN and tag type.
+func (report *TagReconciliationReport) Failed() map[string]map[string]error {
+	failed := make(map[string]map[string]error)
+	for _, operation := range report.Operations {
+		for crn, err := range operation.Report.Failed() {
+			if failed[crn] == nil {
+				failed[crn] = make(map[string]error)
+			}
+			failed[crn][operation.TagType] = err
+		}
+	}
+	return failed
+}
+
+// CurrentTags returns the tags currently attached to the resources identified by "crns".
+func (globalTagging *GlobalTaggingV1) CurrentTags(ctx context.Context, crns []string, options *ReconcileTagsOptions) (current map[string]*ResourceTags, err error) {
+	if options == nil {
+		options = &ReconcileTagsOptions{}
+	}
+	current = make(map[string]*ResourceTags, len(crns))
+	for _, crn := range crns {
+		current[crn] = &ResourceTags{}
+	}
+	if options.Search != nil {
+		err = searchCurrentTags(ctx, options.Search, crns, current)
+		return
+	}
+
+	for _, crn := range crns {
+		for _, tagType := range reconciledTagTypes {
+			listOptions := globalTagging.NewListTagsOptions().SetAttachedTo(crn).SetTagType(tagType).SetLimit(1000)
+			if tagType == AttachTagOptionsTagTypeServiceConst {
+				if options.AccountID == "" {
+					continue
+				}
+				listOptions.SetAccountID(options.AccountID)
+			}
+			var tagList *TagList
+			tagList, _, err = globalTagging.ListTagsWithContext(ctx, listOptions)
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "list-tags-error")
+				return
+			}
+			names := []string{}
+			for _, tag := range tagList.Items {
+				names = append(names, core.StringNilMapper(tag.Name))
+			}
+			current[crn].setType(tagType, names)
+		}
+	}
+	return
+}
+
+// searchCurrentTags fills "current" with the tags of the resources identified by "crns",
+// retrieved with Global Search queries.
+func searchCurrentTags(ctx context.Context, globalSearch *globalsearchv2.GlobalSearchV2, crns []string, current map[string]*ResourceTags) error {
+	fields := []string{"crn"}
+	for _, tagType := range reconciledTagTypes {
+		fields = append(fields, searchTagFields[tagType])
+	}
+	for start := 0; start < len(crns); start += searchCRNsPerQuery {
+		query := globalsearchv2.Q().CRN(crns[start:min(start+searchCRNsPerQuery, len(crns))]...)
+		pager, err := globalSearch.NewSearchPager(&globalsearchv2.SearchOptions{
+			Query:  core.StringPtr(query.String()),
+			Fields: fields,
+		})
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "search-error")
+		}
+		items, err := pager.GetAllWithContext(ctx)
+		if err != nil {
+			return core.RepurposeSDKProblem(err, "search-error")
+		}
+		for _, item := range items {
+			tags, found := current[core.StringNilMapper(item.CRN)]
+			if !found {
+				continue
+			}
+			for _, tagType := range reconciledTagTypes {
+				names := []string{}
+				if values, ok := item.GetProperty(searchTagFields[tagType]).([]interface{}); ok {
+					for _, value := range values {
+						if name, ok := value.(string); ok {
+							names = append(names, name)
+						}
+					}
+				}
+				tags.setType(tagType, names)
+			}
+		}
+	}
+	return nil
+}
+
+// PlanTagReconciliation computes the minimal set of changes that bring the tags attached to the
+// resources in "desired", which is keyed by CRN, to the desired state.
+func (globalTagging *GlobalTaggingV1) PlanTagReconciliation(ctx context.Context, desired map[string]ResourceTags, options *ReconcileTagsOptions) (plan *TagReconciliationPlan, err error) {
+	crns := make([]string, 0, len(desired))
+	for crn, tags := range desired {
+		if tags.Service != nil && (options == nil || options.AccountID == "") {
+			err = core.SDKErrorf(nil, "the account ID must be specified to reconcile service tags", "no-account-id", common.GetComponentInfo())
+			return
+		}
+		crns = append(crns, crn)
+	}
+	sort.Strings(crns)
+	current, err := globalTagging.CurrentTags(ctx, crns, options)
+	if err != nil {
+		return
+	}
+
+	plan = &TagReconciliationPlan{}
+	for _, crn := range crns {
+		desiredTags := desired[crn]
+		for _, tagType := range reconciledTagTypes {
+			want := desiredTags.ofType(tagType)
+			if want == nil {
+				continue
+			}
+			change := TagChange{
+				ResourceID: crn,
+				TagType:    tagType,
+				Current:    sortedTagNames(current[crn].ofType(tagType)),
+				Desired:    sortedTagNames(want),
+			}
+			for _, tag := range change.Desired {
+				if !slices.Contains(change.Current, tag) {
+					change.Attach = append(change.Attach, tag)
+				}
+			}
+			for _, tag := range change.Current {
+				if !slices.Contains(change.Desired, tag) {
+					change.Detach = append(change.Detach, tag)
+				}
+			}
+			switch {
+			case len(change.Attach) > 0 && len(change.Detach) > 0:
+				change.Action = TagChangeActionReplace
+			case len(change.Attach) > 0:
+				change.Action = TagChangeActionAttach
+			case len(change.Detach) > 0:
+				change.Action = TagChangeActionDetach
+			default:
+				continue
+			}
+			plan.Changes = append(plan.Changes, change)
+		}
+	}
+	return
+}
+
+// ApplyTagReconciliation applies the changes of "plan". Changes that attach or detach the same
+// tags are combined into bulk operations; the outcome of each change is recorded in the report.
+func (globalTagging *GlobalTaggingV1) ApplyTagReconciliation(ctx context.Context, plan *TagReconciliationPlan, options *ReconcileTagsOptions) (report *TagReconciliationReport, err error) {
+	if options == nil {
+		options = &ReconcileTagsOptions{}
+	}
+	type operationKey struct {
+		action, tagType, tagNames string
+	}
+	var keys []operationKey
+	resources := make(map[operationKey][]string)
+	for _, change := range plan.Changes {
+		tagNames := change.Attach
+		switch change.Action {
+		case TagChangeActionDetach:
+			tagNames = change.Detach
+		case TagChangeActionReplace:
+			tagNames = change.Desired
+		}
+		key := operationKey{change.Action, change.TagType, strings.Join(tagNames, ",")}
+		if _, found := resources[key]; !found {
+			keys = append(keys, key)
+		}
+		resources[key] = append(resources[key], change.ResourceID)
+	}
+
+	report = &TagReconciliationReport{}
+	for _, key := range keys {
+		bulkOptions := &BulkTagOptions{}
+		if options.BulkOptions != nil {
+			*bulkOptions = *options.BulkOptions
+		}
+		bulkOptions.TagNames = strings.Split(key.tagNames, ",")
+		bulkOptions.TagType = key.tagType
+		bulkOptions.AccountID = ""
+		if key.tagType == AttachTagOptionsTagTypeServiceConst {
+			bulkOptions.AccountID = options.AccountID
+		}
+		bulkOptions.Replace = key.action == TagChangeActionReplace
+		bulkOptions.Update = false
+
+		var bulkReport *BulkTagReport
+		if key.action == TagChangeActionDetach {
+			bulkReport, err = globalTagging.BulkDetachTags(ctx, resources[key], bulkOptions)
+		} else {
+			bulkReport, err = globalTagging.BulkAttachTags(ctx, resources[key], bulkOptions)
+		}
+		report.Operations = append(report.Operations, TagReconciliationOperation{
+			Action:   key.action,
+			TagType:  key.tagType,
+			TagNames: bulkOptions.TagNames,
+			Report:   bulkReport,
+		})
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// ReconcileTags brings the tags attached to the resources in "desired", which is keyed by CRN, to
+// the desired state, and returns the plan that it computed along with the report of its
+// application. If options.DryRun is set, the plan is not applied and the report is nil.
+func (globalTagging *GlobalTaggingV1) ReconcileTags(ctx context.Context, desired map[string]ResourceTags, options *ReconcileTagsOptions) (plan *TagReconciliationPlan, report *TagReconciliationReport, err error) {
+	if options == nil {
+		options = &ReconcileTagsOptions{}
+	}
+	plan, err = globalTagging.PlanTagReconciliation(ctx, desired, options)
+	if err != nil || options.DryRun || plan.Empty() {
+		return
+	}
+	report, err = globalTagging.ApplyTagReconciliation(ctx, plan, options)
+	return
+}
+
+func sortedTagNames(names []string) []string {
+	sorted := slices.Clone(names)
+	sort.Strings(sorted)
+	return slices.Compact(sorted)
+}
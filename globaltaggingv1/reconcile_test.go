@@ -0,0 +1,160 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1 tag reconciliation`, func() {
+	const crn1 = "crn:v1:bluemix:public:service:us-south:a/account-1:instance-1::"
+	const crn2 = "crn:v1:bluemix:public:service:us-south:a/account-1:instance-2::"
+	const crn3 = "crn:v1:bluemix:public:service:us-south:a/account-1:instance-3::"
+
+	var fake *mockservers.GlobalTaggingV1Server
+	var globalTaggingService *globaltaggingv1.GlobalTaggingV1
+	var desired map[string]globaltaggingv1.ResourceTags
+	var reconcileOptions *globaltaggingv1.ReconcileTagsOptions
+
+	BeforeEach(func() {
+		fake = mockservers.NewGlobalTaggingV1Server()
+		var err error
+		globalTaggingService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+
+		fake.AttachTag("user", crn1, "env:dev", "team:data")
+		fake.AttachTag("user", crn2, "env:prod")
+		fake.AttachTag("service", crn2, "billing:shared")
+		fake.AttachTag("access", crn3, "project:old")
+		fake.AddTag("access", "project:new")
+
+		desired = map[string]globaltaggingv1.ResourceTags{
+			crn1: {User: []string{"env:prod", "team:data"}},
+			crn2: {User: []string{"env:prod"}, Service: []string{}},
+			crn3: {User: []string{"env:prod"}, Access: []string{"project:new"}},
+		}
+		reconcileOptions = &globaltaggingv1.ReconcileTagsOptions{
+			AccountID: "account-1",
+		}
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It(`Plan the changes and print them as a diff`, func() {
+		plan, err := globalTaggingService.PlanTagReconciliation(context.Background(), desired, reconcileOptions)
+		Expect(err).To(BeNil())
+		Expect(plan.Changes).To(HaveLen(4))
+		Expect(plan.Changes[0]).To(Equal(globaltaggingv1.TagChange{
+			ResourceID: crn1,
+			TagType:    "user",
+			Action:     globaltaggingv1.TagChangeActionReplace,
+			Attach:     []string{"env:prod"},
+			Detach:     []string{"env:dev"},
+			Current:    []string{"env:dev", "team:data"},
+			Desired:    []string{"env:prod", "team:data"},
+		}))
+		Expect(plan.Changes[1].Action).To(Equal(globaltaggingv1.TagChangeActionDetach))
+		Expect(plan.Changes[1].TagType).To(Equal("service"))
+		Expect(plan.Changes[2].Action).To(Equal(globaltaggingv1.TagChangeActionAttach))
+
+		Expect(plan.String()).To(Equal(crn1 + "\n" +
+			"  user tags (replace):\n    + env:prod\n    - env:dev\n" +
+			crn2 + "\n" +
+			"  service tags (detach):\n    - billing:shared\n" +
+			crn3 + "\n" +
+			"  user tags (attach):\n    + env:prod\n" +
+			"  access tags (replace):\n    + project:new\n    - project:old\n"))
+	})
+	It(`Reconcile the tags`, func() {
+		plan, report, err := globalTaggingService.ReconcileTags(context.Background(), desired, reconcileOptions)
+		Expect(err).To(BeNil())
+		Expect(plan.Empty()).To(BeFalse())
+		Expect(report.Failed()).To(BeEmpty())
+		Expect(report.Operations).To(HaveLen(4))
+
+		Expect(fake.AttachedTags("user", crn1)).To(Equal([]string{"env:prod", "team:data"}))
+		Expect(fake.AttachedTags("service", crn2)).To(BeEmpty())
+		Expect(fake.AttachedTags("user", crn3)).To(Equal([]string{"env:prod"}))
+		Expect(fake.AttachedTags("access", crn3)).To(Equal([]string{"project:new"}))
+
+		plan, report, err = globalTaggingService.ReconcileTags(context.Background(), desired, reconcileOptions)
+		Expect(err).To(BeNil())
+		Expect(plan.Empty()).To(BeTrue())
+		Expect(report).To(BeNil())
+	})
+	It(`Report the changes that fail`, func() {
+		delete(desired, crn2)
+		fake.FailResource(crn3, 100)
+		reconcileOptions.BulkOptions = &globaltaggingv1.BulkTagOptions{MaxRetries: -1}
+		_, report, err := globalTaggingService.ReconcileTags(context.Background(), desired, reconcileOptions)
+		Expect(err).To(BeNil())
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(failed[crn3]).To(HaveKey("user"))
+		Expect(failed[crn3]).To(HaveKey("access"))
+		Expect(fake.AttachedTags("user", crn1)).To(Equal([]string{"env:prod", "team:data"}))
+	})
+	It(`Only compute the plan in a dry run`, func() {
+		reconcileOptions.DryRun = true
+		plan, report, err := globalTaggingService.ReconcileTags(context.Background(), desired, reconcileOptions)
+		Expect(err).To(BeNil())
+		Expect(plan.Changes).To(HaveLen(4))
+		Expect(report).To(BeNil())
+		Expect(fake.AttachedTags("user", crn1)).To(Equal([]string{"env:dev", "team:data"}))
+	})
+	It(`Retrieve the current tags with Global Search`, func() {
+		var queries []*globalsearchv2.SearchOptions
+		searchServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			body := &globalsearchv2.SearchOptions{}
+			_ = json.NewDecoder(req.Body).Decode(body)
+			queries = append(queries, body)
+			res.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(res, `{"limit":10,"items":[{"crn":%q,"tags":["env:dev","team:data"]},{"crn":%q,"tags":["env:prod"],"service_tags":["billing:shared"]},{"crn":%q,"access_tags":["project:old"]}]}`, crn1, crn2, crn3)
+		}))
+		defer searchServer.Close()
+		globalSearchService, err := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+			URL:           searchServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		reconcileOptions.Search = globalSearchService
+
+		plan, err := globalTaggingService.PlanTagReconciliation(context.Background(), desired, reconcileOptions)
+		Expect(err).To(BeNil())
+		Expect(plan.Changes).To(HaveLen(4))
+		Expect(queries).To(HaveLen(1))
+		Expect(*queries[0].Query).To(Equal(fmt.Sprintf(`crn:("%s" OR "%s" OR "%s")`, crn1, crn2, crn3)))
+		Expect(queries[0].Fields).To(Equal([]string{"crn", "tags", "service_tags", "access_tags"}))
+		Expect(fake.Requests()).To(BeEmpty())
+	})
+	It(`Require the account ID to reconcile service tags`, func() {
+		_, err := globalTaggingService.PlanTagReconciliation(context.Background(), desired, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,320 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+)
+
+// The rules checked by a TagPolicy.
+const (
+	TagRuleCharacters     = "characters"
+	TagRuleMaxLength      = "max_length"
+	TagRuleLowercase      = "lowercase"
+	TagRuleReservedPrefix = "reserved_prefix"
+	TagRuleKeyValue       = "key_value"
+	TagRuleAllowedKey     = "allowed_key"
+	TagRuleValuePattern   = "value_pattern"
+)
+
+// DefaultTagMaxLength is the maximum length of a tag name accepted by the Global Tagging service.
+const DefaultTagMaxLength = 128
+
+// tagCharactersPattern matches the characters accepted in tag names by the Global Tagging service.
+var tagCharactersPattern = regexp.MustCompile(`^[A-Za-z0-9 _.:\-]*$`)
+
+// TagPolicy : The conventions that tag names must follow.
+type TagPolicy struct {
+	// The maximum length of a tag name. Defaults to DefaultTagMaxLength.
+	MaxLength int
+
+	// If true, tag names must not contain uppercase letters.
+	Lowercase bool
+
+	// Prefixes (e.g. "ibm-") that tag names must not start with. The comparison ignores case.
+	ReservedPrefixes []string
+
+	// If true, every tag must be in the "key:value" format. Access tags must always be.
+	RequireKeyValue bool
+
+	// The keys that "key:value" tags may use. If empty, any key is allowed.
+	AllowedKeys []string
+
+	// The patterns that the values of "key:value" tags must match, keyed by tag key.
+	ValuePatterns map[string]*regexp.Regexp
+}
+
+// TagViolation : A rule of a TagPolicy that a tag name does not follow.
+type TagViolation struct {
+	TagName string
+
+	// The rule that is violated (e.g. TagRuleAllowedKey).
+	Rule string
+
+	Message string
+
+	// A conforming tag name that is likely to have been intended, if one is known.
+	Suggestion string
+}
+
+// String returns a description of the violation.
+func (violation TagViolation) String() string {
+	description := fmt.Sprintf("tag '%s': %s", violation.TagName, violation.Message)
+	if violation.Suggestion != "" {
+		description += fmt.Sprintf(" (did you mean '%s'?)", violation.Suggestion)
+	}
+	return description
+}
+
+// TagPolicyError : The error returned when tag names do not follow a TagPolicy.
+type TagPolicyError struct {
+	Violations []TagViolation
+}
+
+// Error implements the error interface.
+func (e *TagPolicyError) Error() string {
+	descriptions := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		descriptions[i] = violation.String()
+	}
+	return "the tag policy is violated: " + strings.Join(descriptions, "; ")
+}
+
+// Validate returns the rules of the policy that the name of a tag of type "tagType" does not follow.
+func (policy *TagPolicy) Validate(tagType string, tagName string) (violations []TagViolation) {
+	violate := func(rule string, message string, suggestion string) {
+		violations = append(violations, TagViolation{TagName: tagName, Rule: rule, Message: message, Suggestion: suggestion})
+	}
+
+	maxLength := policy.MaxLength
+	if maxLength <= 0 {
+		maxLength = DefaultTagMaxLength
+	}
+	if len(tagName) == 0 || len(tagName) > maxLength {
+		violate(TagRuleMaxLength, fmt.Sprintf("the name must be between 1 and %d characters long", maxLength), "")
+	}
+	if !tagCharactersPattern.MatchString(tagName) {
+		violate(TagRuleCharacters, "the name may only contain letters, digits, spaces and the characters '_', '.', ':' and '-'", "")
+	}
+	if policy.Lowercase && strings.ToLower(tagName) != tagName {
+		violate(TagRuleLowercase, "the name must be lowercase", strings.ToLower(tagName))
+	}
+	for _, prefix := range policy.ReservedPrefixes {
+		if strings.HasPrefix(strings.ToLower(tagName), strings.ToLower(prefix)) {
+			violate(TagRuleReservedPrefix, fmt.Sprintf("the prefix '%s' is reserved", prefix), "")
+		}
+	}
+
+	key, value, isKeyValue := strings.Cut(tagName, ":")
+	if !isKeyValue {
+		if policy.RequireKeyValue || tagType == AttachTagOptionsTagTypeAccessConst {
+			violate(TagRuleKeyValue, "the name must be in the 'key:value' format", "")
+		}
+		return
+	}
+	if len(policy.AllowedKeys) > 0 && !slices.Contains(policy.AllowedKeys, key) {
+		suggestion := ""
+		if allowedKey := policy.similarKey(key); allowedKey != "" {
+			suggestion = allowedKey + ":" + value
+		}
+		violate(TagRuleAllowedKey, fmt.Sprintf("the key '%s' is not allowed", key), suggestion)
+		return
+	}
+	if pattern, found := policy.ValuePatterns[key]; found && !pattern.MatchString(value) {
+		violate(TagRuleValuePattern, fmt.Sprintf("the value '%s' does not match the pattern '%s'", value, pattern.String()), "")
+	}
+	return
+}
+
+// similarKey returns the allowed key that "key" matches once case and separators are ignored
+// (e.g. "costcenter" for "Cost-Center"), or "" if there is none.
+func (policy *TagPolicy) similarKey(key string) string {
+	normalize := func(s string) string {
+		return strings.NewReplacer("-", "", "_", "", ".", "", " ", "").Replace(strings.ToLower(s))
+	}
+	for _, allowedKey := range policy.AllowedKeys {
+		if normalize(allowedKey) == normalize(key) {
+			return allowedKey
+		}
+	}
+	return ""
+}
+
+// validateAll returns an error wrapping a *TagPolicyError if any of "tagNames" violate the policy.
+func (policy *TagPolicy) validateAll(tagType string, tagNames []string) error {
+	if tagType == "" {
+		tagType = AttachTagOptionsTagTypeUserConst
+	}
+	var violations []TagViolation
+	for _, tagName := range tagNames {
+		violations = append(violations, policy.Validate(tagType, tagName)...)
+	}
+	if len(violations) > 0 {
+		return core.SDKErrorf(&TagPolicyError{Violations: violations}, "", "tag-policy-violation", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ValidateAttachTagOptions checks the tags named in "options" before they are attached.
+func (policy *TagPolicy) ValidateAttachTagOptions(options *AttachTagOptions) error {
+	return policy.validateAll(core.StringNilMapper(options.TagType), requestedTagNames(options.TagName, options.TagNames))
+}
+
+// ValidateCreateTagOptions checks the tags named in "options" before they are created.
+func (policy *TagPolicy) ValidateCreateTagOptions(options *CreateTagOptions) error {
+	return policy.validateAll(core.StringNilMapper(options.TagType), options.TagNames)
+}
+
+func requestedTagNames(tagName *string, tagNames []string) []string {
+	if tagName != nil {
+		return append([]string{*tagName}, tagNames...)
+	}
+	return tagNames
+}
+
+// TagAuditOptions : The options for the AuditTags method.
+type TagAuditOptions struct {
+	// The tag types to audit. Defaults to user and access tags, and service tags as well if
+	// AccountID is set.
+	TagTypes []string
+
+	// The ID of the account, which is required for service tags.
+	AccountID string
+
+	// An optional Global Search client, which is used to find the resources to which the
+	// non-conforming tags are attached.
+	Search *globalsearchv2.GlobalSearchV2
+}
+
+// TagAuditFinding : A tag that does not follow the policy.
+type TagAuditFinding struct {
+	TagType    string
+	TagName    string
+	Violations []TagViolation
+
+	// The CRNs of the resources to which the tag is attached, if a Global Search client was provided.
+	Resources []string
+}
+
+// TagAuditReport : The outcome of AuditTags.
+type TagAuditReport struct {
+	// The number of tags that were checked.
+	Checked int
+
+	Findings []TagAuditFinding
+}
+
+// AuditTags checks the existing tags of the account against "policy" and reports the tags that do
+// not follow it, along with the resources to which they are attached.
+func (globalTagging *GlobalTaggingV1) AuditTags(ctx context.Context, policy *TagPolicy, options *TagAuditOptions) (report *TagAuditReport, err error) {
+	if options == nil {
+		options = &TagAuditOptions{}
+	}
+	tagTypes := options.TagTypes
+	if len(tagTypes) == 0 {
+		tagTypes = []string{AttachTagOptionsTagTypeUserConst, AttachTagOptionsTagTypeAccessConst}
+		if options.AccountID != "" {
+			tagTypes = append(tagTypes, AttachTagOptionsTagTypeServiceConst)
+		}
+	}
+
+	report = &TagAuditReport{}
+	for _, tagType := range tagTypes {
+		var tagNames []string
+		tagNames, err = globalTagging.listAllTagNames(ctx, tagType, options.AccountID, false)
+		if err != nil {
+			return
+		}
+		for _, tagName := range tagNames {
+			report.Checked++
+			violations := policy.Validate(tagType, tagName)
+			if len(violations) == 0 {
+				continue
+			}
+			finding := TagAuditFinding{
+				TagType:    tagType,
+				TagName:    tagName,
+				Violations: violations,
+			}
+			if options.Search != nil {
+				finding.Resources, err = searchTaggedResources(ctx, options.Search, tagType, tagName)
+				if err != nil {
+					return
+				}
+			}
+			report.Findings = append(report.Findings, finding)
+		}
+	}
+	return
+}
+
+// listAllTagNames returns the names of all the tags of type "tagType" in the account, or only
+// those that are attached to at least one resource if "attachedOnly" is set.
+func (globalTagging *GlobalTaggingV1) listAllTagNames(ctx context.Context, tagType string, accountID string, attachedOnly bool) (tagNames []string, err error) {
+	const limit = 1000
+	for offset := int64(0); ; offset += limit {
+		listOptions := globalTagging.NewListTagsOptions().SetTagType(tagType).SetOffset(offset).SetLimit(limit)
+		if accountID != "" {
+			listOptions.SetAccountID(accountID)
+		}
+		if attachedOnly {
+			listOptions.SetAttachedOnly(true)
+		}
+		var tagList *TagList
+		tagList, _, err = globalTagging.ListTagsWithContext(ctx, listOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-tags-error")
+			return
+		}
+		for _, tag := range tagList.Items {
+			tagNames = append(tagNames, core.StringNilMapper(tag.Name))
+		}
+		if len(tagList.Items) < limit || (tagList.TotalCount != nil && offset+limit >= *tagList.TotalCount) {
+			return
+		}
+	}
+}
+
+// searchTaggedResources returns the CRNs of the resources to which the tag named "tagName" of
+// type "tagType" is attached.
+func searchTaggedResources(ctx context.Context, globalSearch *globalsearchv2.GlobalSearchV2, tagType string, tagName string) (crns []string, err error) {
+	pager, err := globalSearch.NewSearchPager(&globalsearchv2.SearchOptions{
+		Query:  core.StringPtr(globalsearchv2.Q().Field(searchTagFields[tagType]).Eq(tagName).String()),
+		Fields: []string{"crn"},
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "search-error")
+		return
+	}
+	items, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "search-error")
+		return
+	}
+	for _, item := range items {
+		crns = append(crns, core.StringNilMapper(item.CRN))
+	}
+	return
+}
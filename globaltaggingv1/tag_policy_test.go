@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1 tag policy`, func() {
+	var policy *globaltaggingv1.TagPolicy
+
+	BeforeEach(func() {
+		policy = &globaltaggingv1.TagPolicy{
+			MaxLength:        32,
+			Lowercase:        true,
+			ReservedPrefixes: []string{"ibm-"},
+			RequireKeyValue:  true,
+			AllowedKeys:      []string{"env", "costcenter"},
+			ValuePatterns: map[string]*regexp.Regexp{
+				"env":        regexp.MustCompile(`^(dev|test|prod)$`),
+				"costcenter": regexp.MustCompile(`^[0-9]{4}$`),
+			},
+		}
+	})
+
+	rules := func(violations []globaltaggingv1.TagViolation) (rules []string) {
+		for _, violation := range violations {
+			rules = append(rules, violation.Rule)
+		}
+		return
+	}
+
+	Context(`Validate`, func() {
+		It(`Accept the conforming tags`, func() {
+			Expect(policy.Validate("user", "env:prod")).To(BeEmpty())
+			Expect(policy.Validate("access", "costcenter:1234")).To(BeEmpty())
+		})
+		It(`Reject the tags that break the policy`, func() {
+			Expect(rules(policy.Validate("user", "env:staging"))).To(Equal([]string{globaltaggingv1.TagRuleValuePattern}))
+			Expect(rules(policy.Validate("user", "team:data"))).To(Equal([]string{globaltaggingv1.TagRuleAllowedKey}))
+			Expect(rules(policy.Validate("user", "ibm-env:prod"))).To(Equal([]string{globaltaggingv1.TagRuleReservedPrefix, globaltaggingv1.TagRuleAllowedKey}))
+			Expect(rules(policy.Validate("user", "production"))).To(Equal([]string{globaltaggingv1.TagRuleKeyValue}))
+			Expect(rules(policy.Validate("user", "env:prod/1"))).To(Equal([]string{globaltaggingv1.TagRuleCharacters, globaltaggingv1.TagRuleValuePattern}))
+			Expect(rules(policy.Validate("user", "env:"+strings.Repeat("a", 40)))).To(Equal([]string{globaltaggingv1.TagRuleMaxLength, globaltaggingv1.TagRuleValuePattern}))
+			Expect(rules(policy.Validate("user", ""))).To(Equal([]string{globaltaggingv1.TagRuleMaxLength, globaltaggingv1.TagRuleKeyValue}))
+		})
+		It(`Suggest the intended tag`, func() {
+			violations := policy.Validate("user", "Cost-Center:1234")
+			Expect(rules(violations)).To(Equal([]string{globaltaggingv1.TagRuleLowercase, globaltaggingv1.TagRuleAllowedKey}))
+			Expect(violations[0].Suggestion).To(Equal("cost-center:1234"))
+			Expect(violations[1].Suggestion).To(Equal("costcenter:1234"))
+			Expect(violations[1].String()).To(Equal("tag 'Cost-Center:1234': the key 'Cost-Center' is not allowed (did you mean 'costcenter:1234'?)"))
+		})
+		It(`Always require access tags to be key:value`, func() {
+			policy = &globaltaggingv1.TagPolicy{}
+			Expect(policy.Validate("user", "Production")).To(BeEmpty())
+			Expect(rules(policy.Validate("access", "production"))).To(Equal([]string{globaltaggingv1.TagRuleKeyValue}))
+		})
+		It(`Check the tags of attach and create requests`, func() {
+			globalTaggingService, err := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			attachOptions := globalTaggingService.NewAttachTagOptions(nil).SetTagName("env:prod").SetTagNames([]string{"Cost-Center:1234"})
+			err = policy.ValidateAttachTagOptions(attachOptions)
+			Expect(err).ToNot(BeNil())
+			var policyErr *globaltaggingv1.TagPolicyError
+			Expect(errors.As(err, &policyErr)).To(BeTrue())
+			Expect(policyErr.Violations).To(HaveLen(2))
+			Expect(policyErr.Violations[0].TagName).To(Equal("Cost-Center:1234"))
+
+			Expect(policy.ValidateAttachTagOptions(attachOptions.SetTagNames(nil))).To(BeNil())
+			Expect(policy.ValidateCreateTagOptions(globalTaggingService.NewCreateTagOptions([]string{"env:test"}).SetTagType("access"))).To(BeNil())
+		})
+	})
+
+	Context(`AuditTags`, func() {
+		const crn1 = "crn:v1:bluemix:public:service:us-south:a/account-1:instance-1::"
+		const crn2 = "crn:v1:bluemix:public:service:us-south:a/account-1:instance-2::"
+
+		var fake *mockservers.GlobalTaggingV1Server
+		var globalTaggingService *globaltaggingv1.GlobalTaggingV1
+
+		BeforeEach(func() {
+			fake = mockservers.NewGlobalTaggingV1Server()
+			var err error
+			globalTaggingService, err = fake.NewClient()
+			Expect(err).To(BeNil())
+
+			fake.AttachTag("user", crn1, "env:prod", "Cost-Center:1234")
+			fake.AttachTag("user", crn2, "Cost-Center:1234")
+			fake.AddTag("user", "env:staging")
+			fake.AddTag("access", "costcenter:1234")
+		})
+		AfterEach(func() {
+			fake.Close()
+		})
+
+		It(`Report the non-conforming tags`, func() {
+			report, err := globalTaggingService.AuditTags(context.Background(), policy, nil)
+			Expect(err).To(BeNil())
+			Expect(report.Checked).To(Equal(4))
+			Expect(report.Findings).To(HaveLen(2))
+			Expect(report.Findings[0].TagType).To(Equal("user"))
+			Expect(report.Findings[0].TagName).To(Equal("Cost-Center:1234"))
+			Expect(report.Findings[0].Resources).To(BeEmpty())
+			Expect(report.Findings[1].TagName).To(Equal("env:staging"))
+			Expect(rules(report.Findings[1].Violations)).To(Equal([]string{globaltaggingv1.TagRuleValuePattern}))
+		})
+		It(`Find the resources with Global Search`, func() {
+			var queries []string
+			searchServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				body := &globalsearchv2.SearchOptions{}
+				_ = json.NewDecoder(req.Body).Decode(body)
+				queries = append(queries, *body.Query)
+				res.Header().Set("Content-Type", "application/json")
+				if strings.Contains(*body.Query, "Cost-Center") {
+					fmt.Fprintf(res, `{"limit":10,"items":[{"crn":%q},{"crn":%q}]}`, crn1, crn2)
+				} else {
+					fmt.Fprint(res, `{"limit":10,"items":[]}`)
+				}
+			}))
+			defer searchServer.Close()
+			globalSearchService, err := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+				URL:           searchServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(err).To(BeNil())
+
+			report, err := globalTaggingService.AuditTags(context.Background(), policy, &globaltaggingv1.TagAuditOptions{
+				TagTypes: []string{"user"},
+				Search:   globalSearchService,
+			})
+			Expect(err).To(BeNil())
+			Expect(report.Checked).To(Equal(3))
+			Expect(report.Findings).To(HaveLen(2))
+			Expect(report.Findings[0].Resources).To(Equal([]string{crn1, crn2}))
+			Expect(report.Findings[1].Resources).To(BeEmpty())
+			Expect(queries).To(Equal([]string{`tags:"Cost-Center:1234"`, `tags:"env:staging"`}))
+		})
+		It(`Return the errors from the tagging service`, func() {
+			fake.AddFault(mockservers.Fault{
+				Method:     http.MethodGet,
+				Path:       "/v3/tags",
+				StatusCode: 500,
+			})
+			_, err := globalTaggingService.AuditTags(context.Background(), policy, nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
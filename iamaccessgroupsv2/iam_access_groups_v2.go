@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -6853,6 +6854,19 @@ func (iamAccessGroups *IamAccessGroupsV2) NewAccessGroupsPager(options *ListAcce
 	return
 }
 
+// NewAccessGroupsPagerFromCheckpoint returns a new AccessGroupsPager instance that resumes
+// from the position recorded by AccessGroupsPager.Checkpoint().
+func (iamAccessGroups *IamAccessGroupsV2) NewAccessGroupsPagerFromCheckpoint(checkpoint []byte) (pager *AccessGroupsPager, err error) {
+	pager = &AccessGroupsPager{
+		client: iamAccessGroups,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListAccessGroupsOptions, int64]("AccessGroupsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *AccessGroupsPager) HasNext() bool {
 	return pager.hasNext
@@ -6912,6 +6926,19 @@ func (pager *AccessGroupsPager) GetAll() (allItems []Group, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *AccessGroupsPager) All(ctx context.Context) iter.Seq2[Group, error] {
+	return common.All[Group](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewAccessGroupsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *AccessGroupsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("AccessGroupsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 //
 // AccessGroupMembersPager can be used to simplify the use of the "ListAccessGroupMembers" method.
 //
@@ -6940,6 +6967,19 @@ func (iamAccessGroups *IamAccessGroupsV2) NewAccessGroupMembersPager(options *Li
 	return
 }
 
+// NewAccessGroupMembersPagerFromCheckpoint returns a new AccessGroupMembersPager instance that resumes
+// from the position recorded by AccessGroupMembersPager.Checkpoint().
+func (iamAccessGroups *IamAccessGroupsV2) NewAccessGroupMembersPagerFromCheckpoint(checkpoint []byte) (pager *AccessGroupMembersPager, err error) {
+	pager = &AccessGroupMembersPager{
+		client: iamAccessGroups,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListAccessGroupMembersOptions, int64]("AccessGroupMembersPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *AccessGroupMembersPager) HasNext() bool {
 	return pager.hasNext
@@ -6999,6 +7039,50 @@ func (pager *AccessGroupMembersPager) GetAll() (allItems []ListGroupMembersRespo
 	return pager.GetAllWithContext(context.Background())
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *AccessGroupMembersPager) All(ctx context.Context) iter.Seq2[ListGroupMembersResponseMember, error] {
+	return common.All[ListGroupMembersResponseMember](ctx, pager)
+}
+
+// AllWithPrefetch returns an iterator over all results, like All(). Once the first page of
+// results reveals the total count, the remaining pages are retrieved by up to "workers"
+// concurrent requests while items are still yielded in order.
+// The pager must not have been used before, otherwise an error is yielded. The position of
+// the pager advances each time all of the items of a page have been yielded, so a pager whose
+// iteration stopped early can be resumed with All() or from its Checkpoint().
+func (pager *AccessGroupMembersPager) AllWithPrefetch(ctx context.Context, workers int) iter.Seq2[ListGroupMembersResponseMember, error] {
+	return func(yield func(ListGroupMembersResponseMember, error) bool) {
+		if !pager.hasNext || pager.pageContext.next != nil {
+			yield(ListGroupMembersResponseMember{}, fmt.Errorf("the pager has already been used"))
+			return
+		}
+		fetch := func(ctx context.Context, offset int64) ([]ListGroupMembersResponseMember, *int64, error) {
+			var options ListAccessGroupMembersOptions = *pager.options
+			if offset > 0 {
+				options.Offset = core.Int64Ptr(offset)
+			}
+			result, _, err := pager.client.ListAccessGroupMembersWithContext(ctx, &options)
+			if err != nil {
+				return nil, nil, err
+			}
+			return result.Members, result.TotalCount, nil
+		}
+		progress := func(next *int64) {
+			pager.pageContext.next = next
+			pager.hasNext = (next != nil)
+		}
+		common.AllWithPrefetchProgress[ListGroupMembersResponseMember](ctx, workers, fetch, progress)(yield)
+	}
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewAccessGroupMembersPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *AccessGroupMembersPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("AccessGroupMembersPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 //
 // TemplatesPager can be used to simplify the use of the "ListTemplates" method.
 //
@@ -7027,6 +7111,19 @@ func (iamAccessGroups *IamAccessGroupsV2) NewTemplatesPager(options *ListTemplat
 	return
 }
 
+// NewTemplatesPagerFromCheckpoint returns a new TemplatesPager instance that resumes
+// from the position recorded by TemplatesPager.Checkpoint().
+func (iamAccessGroups *IamAccessGroupsV2) NewTemplatesPagerFromCheckpoint(checkpoint []byte) (pager *TemplatesPager, err error) {
+	pager = &TemplatesPager{
+		client: iamAccessGroups,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListTemplatesOptions, int64]("TemplatesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *TemplatesPager) HasNext() bool {
 	return pager.hasNext
@@ -7086,6 +7183,19 @@ func (pager *TemplatesPager) GetAll() (allItems []GroupTemplate, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *TemplatesPager) All(ctx context.Context) iter.Seq2[GroupTemplate, error] {
+	return common.All[GroupTemplate](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewTemplatesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *TemplatesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("TemplatesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 //
 // TemplateVersionsPager can be used to simplify the use of the "ListTemplateVersions" method.
 //
@@ -7114,6 +7224,19 @@ func (iamAccessGroups *IamAccessGroupsV2) NewTemplateVersionsPager(options *List
 	return
 }
 
+// NewTemplateVersionsPagerFromCheckpoint returns a new TemplateVersionsPager instance that resumes
+// from the position recorded by TemplateVersionsPager.Checkpoint().
+func (iamAccessGroups *IamAccessGroupsV2) NewTemplateVersionsPagerFromCheckpoint(checkpoint []byte) (pager *TemplateVersionsPager, err error) {
+	pager = &TemplateVersionsPager{
+		client: iamAccessGroups,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListTemplateVersionsOptions, int64]("TemplateVersionsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *TemplateVersionsPager) HasNext() bool {
 	return pager.hasNext
@@ -7172,3 +7295,16 @@ func (pager *TemplateVersionsPager) GetNext() (page []ListTemplateVersionRespons
 func (pager *TemplateVersionsPager) GetAll() (allItems []ListTemplateVersionResponse, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *TemplateVersionsPager) All(ctx context.Context) iter.Seq2[ListTemplateVersionResponse, error] {
+	return common.All[ListTemplateVersionResponse](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewTemplateVersionsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *TemplateVersionsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("TemplateVersionsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
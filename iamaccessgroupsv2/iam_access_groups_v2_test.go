@@ -815,6 +815,51 @@ var _ = Describe(`IamAccessGroupsV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccessGroupsPager.Checkpoint successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				listAccessGroupsOptionsModel := &iamaccessgroupsv2.ListAccessGroupsOptions{
+					AccountID: core.StringPtr("testString"),
+					TransactionID: core.StringPtr("testString"),
+					IamID: core.StringPtr("testString"),
+					Search: core.StringPtr("testString"),
+					MembershipType: core.StringPtr("static"),
+					Limit: core.Int64Ptr(int64(10)),
+					Sort: core.StringPtr("name"),
+					ShowFederated: core.BoolPtr(false),
+					HidePublicAccess: core.BoolPtr(false),
+				}
+
+				pager, err := iamAccessGroupsService.NewAccessGroupsPager(listAccessGroupsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamaccessgroupsv2.Group
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamAccessGroupsService.NewAccessGroupsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use AccessGroupsPager.GetAll successfully`, func() {
 				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
 					URL:           testServer.URL,
@@ -844,6 +889,37 @@ var _ = Describe(`IamAccessGroupsV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccessGroupsPager.All successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				listAccessGroupsOptionsModel := &iamaccessgroupsv2.ListAccessGroupsOptions{
+					AccountID: core.StringPtr("testString"),
+					TransactionID: core.StringPtr("testString"),
+					IamID: core.StringPtr("testString"),
+					Search: core.StringPtr("testString"),
+					MembershipType: core.StringPtr("static"),
+					Limit: core.Int64Ptr(int64(10)),
+					Sort: core.StringPtr("name"),
+					ShowFederated: core.BoolPtr(false),
+					HidePublicAccess: core.BoolPtr(false),
+				}
+
+				pager, err := iamAccessGroupsService.NewAccessGroupsPager(listAccessGroupsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamaccessgroupsv2.Group
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetAccessGroup(getAccessGroupOptions *GetAccessGroupOptions) - Operation response error`, func() {
@@ -2150,6 +2226,49 @@ var _ = Describe(`IamAccessGroupsV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccessGroupMembersPager.Checkpoint successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				listAccessGroupMembersOptionsModel := &iamaccessgroupsv2.ListAccessGroupMembersOptions{
+					AccessGroupID: core.StringPtr("testString"),
+					TransactionID: core.StringPtr("testString"),
+					MembershipType: core.StringPtr("static"),
+					Limit: core.Int64Ptr(int64(10)),
+					Type: core.StringPtr("testString"),
+					Verbose: core.BoolPtr(false),
+					Sort: core.StringPtr("testString"),
+				}
+
+				pager, err := iamAccessGroupsService.NewAccessGroupMembersPager(listAccessGroupMembersOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamaccessgroupsv2.ListGroupMembersResponseMember
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamAccessGroupsService.NewAccessGroupMembersPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use AccessGroupMembersPager.GetAll successfully`, func() {
 				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
 					URL:           testServer.URL,
@@ -2177,6 +2296,131 @@ var _ = Describe(`IamAccessGroupsV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use AccessGroupMembersPager.All successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				listAccessGroupMembersOptionsModel := &iamaccessgroupsv2.ListAccessGroupMembersOptions{
+					AccessGroupID: core.StringPtr("testString"),
+					TransactionID: core.StringPtr("testString"),
+					MembershipType: core.StringPtr("static"),
+					Limit: core.Int64Ptr(int64(10)),
+					Type: core.StringPtr("testString"),
+					Verbose: core.BoolPtr(false),
+					Sort: core.StringPtr("testString"),
+				}
+
+				pager, err := iamAccessGroupsService.NewAccessGroupMembersPager(listAccessGroupMembersOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamaccessgroupsv2.ListGroupMembersResponseMember
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
+		Context(`Using mock server endpoint - paginated response with total count`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listAccessGroupMembersPath))
+					Expect(req.Method).To(Equal("GET"))
+					Expect(req.URL.Query()["limit"]).To(Equal([]string{"1"}))
+
+					// Set mock response based on the requested offset
+					offset := req.URL.Query().Get("offset")
+					if offset == "" {
+						offset = "0"
+					}
+					res.Header().Set("Content-type", "application/json")
+					if offset != "0" && offset != "1" && offset != "2" {
+						res.WriteHeader(400)
+						return
+					}
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"limit":1,"offset":%s,"total_count":3,"members":[{"iam_id":"%s"}]}`, offset, offset)
+				}))
+			})
+			It(`Use AccessGroupMembersPager.AllWithPrefetch successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				listAccessGroupMembersOptionsModel := &iamaccessgroupsv2.ListAccessGroupMembersOptions{
+					AccessGroupID: core.StringPtr("testString"),
+					Limit:         core.Int64Ptr(int64(1)),
+				}
+
+				pager, err := iamAccessGroupsService.NewAccessGroupMembersPager(listAccessGroupMembersOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []string
+				for item, err := range pager.AllWithPrefetch(context.Background(), 2) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, *item.IamID)
+				}
+				Expect(allResults).To(Equal([]string{"0", "1", "2"}))
+				Expect(pager.HasNext()).To(BeFalse())
+			})
+			It(`Use AccessGroupMembersPager.AllWithPrefetch and stop early`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+
+				listAccessGroupMembersOptionsModel := &iamaccessgroupsv2.ListAccessGroupMembersOptions{
+					AccessGroupID: core.StringPtr("testString"),
+					Limit:         core.Int64Ptr(int64(1)),
+				}
+
+				pager, err := iamAccessGroupsService.NewAccessGroupMembersPager(listAccessGroupMembersOptionsModel)
+				Expect(err).To(BeNil())
+				var results []string
+				for item, err := range pager.AllWithPrefetch(context.Background(), 2) {
+					Expect(err).To(BeNil())
+					results = append(results, *item.IamID)
+					if len(results) == 2 {
+						break
+					}
+				}
+				Expect(results).To(Equal([]string{"0", "1"}))
+				Expect(pager.HasNext()).To(BeTrue())
+
+				// The pager cannot be iterated with AllWithPrefetch again.
+				var errs []error
+				for _, err := range pager.AllWithPrefetch(context.Background(), 2) {
+					errs = append(errs, err)
+				}
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0]).ToNot(BeNil())
+
+				// The page whose items were not all consumed is retrieved again after resuming.
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				resumed, err := iamAccessGroupsService.NewAccessGroupMembersPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				page, err := resumed.GetNext()
+				Expect(err).To(BeNil())
+				Expect(page).To(HaveLen(1))
+				Expect(*page[0].IamID).To(Equal("1"))
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
 		})
 	})
 	Describe(`RemoveMemberFromAccessGroup(removeMemberFromAccessGroupOptions *RemoveMemberFromAccessGroupOptions)`, func() {
@@ -5571,6 +5815,46 @@ var _ = Describe(`IamAccessGroupsV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use TemplatesPager.Checkpoint successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				listTemplatesOptionsModel := &iamaccessgroupsv2.ListTemplatesOptions{
+					AccountID: core.StringPtr("accountID-123"),
+					TransactionID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(50)),
+					Verbose: core.BoolPtr(true),
+				}
+
+				pager, err := iamAccessGroupsService.NewTemplatesPager(listTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamaccessgroupsv2.GroupTemplate
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamAccessGroupsService.NewTemplatesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use TemplatesPager.GetAll successfully`, func() {
 				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
 					URL:           testServer.URL,
@@ -5595,6 +5879,32 @@ var _ = Describe(`IamAccessGroupsV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use TemplatesPager.All successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				listTemplatesOptionsModel := &iamaccessgroupsv2.ListTemplatesOptions{
+					AccountID: core.StringPtr("accountID-123"),
+					TransactionID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(50)),
+					Verbose: core.BoolPtr(true),
+				}
+
+				pager, err := iamAccessGroupsService.NewTemplatesPager(listTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamaccessgroupsv2.GroupTemplate
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateTemplateVersion(createTemplateVersionOptions *CreateTemplateVersionOptions) - Operation response error`, func() {
@@ -6487,6 +6797,44 @@ var _ = Describe(`IamAccessGroupsV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use TemplateVersionsPager.Checkpoint successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				listTemplateVersionsOptionsModel := &iamaccessgroupsv2.ListTemplateVersionsOptions{
+					TemplateID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(100)),
+				}
+
+				pager, err := iamAccessGroupsService.NewTemplateVersionsPager(listTemplateVersionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamaccessgroupsv2.ListTemplateVersionResponse
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamAccessGroupsService.NewTemplateVersionsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use TemplateVersionsPager.GetAll successfully`, func() {
 				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
 					URL:           testServer.URL,
@@ -6509,6 +6857,30 @@ var _ = Describe(`IamAccessGroupsV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use TemplateVersionsPager.All successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				listTemplateVersionsOptionsModel := &iamaccessgroupsv2.ListTemplateVersionsOptions{
+					TemplateID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(100)),
+				}
+
+				pager, err := iamAccessGroupsService.NewTemplateVersionsPager(listTemplateVersionsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamaccessgroupsv2.ListTemplateVersionResponse
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetTemplateVersion(getTemplateVersionOptions *GetTemplateVersionOptions) - Operation response error`, func() {
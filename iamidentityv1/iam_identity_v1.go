@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -6815,6 +6816,21 @@ func UnmarshalAccountSettingsTemplateList(m map[string]json.RawMessage, result i
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *AccountSettingsTemplateList) GetNextPagetoken() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	pagetoken, err := core.GetQueryParam(resp.Next, "pagetoken")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-query-param-error", common.GetComponentInfo())
+		return nil, err
+	} else if pagetoken == nil {
+		return nil, nil
+	}
+	return pagetoken, nil
+}
+
 // AccountSettingsTemplateResponse : Response body format for account settings template REST requests.
 type AccountSettingsTemplateResponse struct {
 	// ID of the the template.
@@ -7472,6 +7488,21 @@ func UnmarshalAPIKeyList(m map[string]json.RawMessage, result interface{}) (err
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *APIKeyList) GetNextPagetoken() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	pagetoken, err := core.GetQueryParam(resp.Next, "pagetoken")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-query-param-error", common.GetComponentInfo())
+		return nil, err
+	} else if pagetoken == nil {
+		return nil, nil
+	}
+	return pagetoken, nil
+}
+
 // ApikeyActivity : Apikeys activity details.
 type ApikeyActivity struct {
 	// Unique id of the apikey.
@@ -12292,6 +12323,21 @@ func UnmarshalServiceIDList(m map[string]json.RawMessage, result interface{}) (e
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *ServiceIDList) GetNextPagetoken() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	pagetoken, err := core.GetQueryParam(resp.Next, "pagetoken")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-query-param-error", common.GetComponentInfo())
+		return nil, err
+	} else if pagetoken == nil {
+		return nil, nil
+	}
+	return pagetoken, nil
+}
+
 // SetProfileIdentitiesOptions : The SetProfileIdentities options.
 type SetProfileIdentitiesOptions struct {
 	// ID of the trusted profile.
@@ -12505,6 +12551,21 @@ func UnmarshalTemplateAssignmentListResponse(m map[string]json.RawMessage, resul
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *TemplateAssignmentListResponse) GetNextPagetoken() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	pagetoken, err := core.GetQueryParam(resp.Next, "pagetoken")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-query-param-error", common.GetComponentInfo())
+		return nil, err
+	} else if pagetoken == nil {
+		return nil, nil
+	}
+	return pagetoken, nil
+}
+
 // TemplateAssignmentResource : Body parameters for created resource.
 type TemplateAssignmentResource struct {
 	// Id of the created resource.
@@ -13172,6 +13233,21 @@ func UnmarshalTrustedProfileTemplateList(m map[string]json.RawMessage, result in
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *TrustedProfileTemplateList) GetNextPagetoken() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	pagetoken, err := core.GetQueryParam(resp.Next, "pagetoken")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-query-param-error", common.GetComponentInfo())
+		return nil, err
+	} else if pagetoken == nil {
+		return nil, nil
+	}
+	return pagetoken, nil
+}
+
 // TrustedProfileTemplateResponse : Response body format for Trusted Profile Template REST requests.
 type TrustedProfileTemplateResponse struct {
 	// ID of the the template.
@@ -13376,6 +13452,21 @@ func UnmarshalTrustedProfilesList(m map[string]json.RawMessage, result interface
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *TrustedProfilesList) GetNextPagetoken() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	pagetoken, err := core.GetQueryParam(resp.Next, "pagetoken")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-query-param-error", common.GetComponentInfo())
+		return nil, err
+	} else if pagetoken == nil {
+		return nil, nil
+	}
+	return pagetoken, nil
+}
+
 // UnlockAPIKeyOptions : The UnlockAPIKey options.
 type UnlockAPIKeyOptions struct {
 	// Unique ID of the API key.
@@ -14387,3 +14478,1065 @@ func UnmarshalUserReportMfaEnrollmentStatus(m map[string]json.RawMessage, result
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// APIKeysPager can be used to simplify the use of the "ListAPIKeys" method.
+type APIKeysPager struct {
+	hasNext     bool
+	options     *ListAPIKeysOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewAPIKeysPager returns a new APIKeysPager instance.
+func (iamIdentity *IamIdentityV1) NewAPIKeysPager(options *ListAPIKeysOptions) (pager *APIKeysPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListAPIKeysOptions = *options
+	pager = &APIKeysPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// NewAPIKeysPagerFromCheckpoint returns a new APIKeysPager instance that resumes
+// from the position recorded by APIKeysPager.Checkpoint().
+func (iamIdentity *IamIdentityV1) NewAPIKeysPagerFromCheckpoint(checkpoint []byte) (pager *APIKeysPager, err error) {
+	pager = &APIKeysPager{
+		client: iamIdentity,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListAPIKeysOptions, string]("APIKeysPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *APIKeysPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *APIKeysPager) GetNextWithContext(ctx context.Context) (page []APIKey, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListAPIKeysWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Apikeys
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *APIKeysPager) GetAllWithContext(ctx context.Context) (allItems []APIKey, err error) {
+	for pager.HasNext() {
+		var nextPage []APIKey
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *APIKeysPager) GetNext() (page []APIKey, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *APIKeysPager) GetAll() (allItems []APIKey, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *APIKeysPager) All(ctx context.Context) iter.Seq2[APIKey, error] {
+	return common.All[APIKey](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewAPIKeysPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *APIKeysPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("APIKeysPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
+// ServiceIdsPager can be used to simplify the use of the "ListServiceIds" method.
+type ServiceIdsPager struct {
+	hasNext     bool
+	options     *ListServiceIdsOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewServiceIdsPager returns a new ServiceIdsPager instance.
+func (iamIdentity *IamIdentityV1) NewServiceIdsPager(options *ListServiceIdsOptions) (pager *ServiceIdsPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListServiceIdsOptions = *options
+	pager = &ServiceIdsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// NewServiceIdsPagerFromCheckpoint returns a new ServiceIdsPager instance that resumes
+// from the position recorded by ServiceIdsPager.Checkpoint().
+func (iamIdentity *IamIdentityV1) NewServiceIdsPagerFromCheckpoint(checkpoint []byte) (pager *ServiceIdsPager, err error) {
+	pager = &ServiceIdsPager{
+		client: iamIdentity,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListServiceIdsOptions, string]("ServiceIdsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ServiceIdsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ServiceIdsPager) GetNextWithContext(ctx context.Context) (page []ServiceID, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListServiceIdsWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Serviceids
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ServiceIdsPager) GetAllWithContext(ctx context.Context) (allItems []ServiceID, err error) {
+	for pager.HasNext() {
+		var nextPage []ServiceID
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ServiceIdsPager) GetNext() (page []ServiceID, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ServiceIdsPager) GetAll() (allItems []ServiceID, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ServiceIdsPager) All(ctx context.Context) iter.Seq2[ServiceID, error] {
+	return common.All[ServiceID](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewServiceIdsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ServiceIdsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ServiceIdsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
+// ProfilesPager can be used to simplify the use of the "ListProfiles" method.
+type ProfilesPager struct {
+	hasNext     bool
+	options     *ListProfilesOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewProfilesPager returns a new ProfilesPager instance.
+func (iamIdentity *IamIdentityV1) NewProfilesPager(options *ListProfilesOptions) (pager *ProfilesPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListProfilesOptions = *options
+	pager = &ProfilesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// NewProfilesPagerFromCheckpoint returns a new ProfilesPager instance that resumes
+// from the position recorded by ProfilesPager.Checkpoint().
+func (iamIdentity *IamIdentityV1) NewProfilesPagerFromCheckpoint(checkpoint []byte) (pager *ProfilesPager, err error) {
+	pager = &ProfilesPager{
+		client: iamIdentity,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListProfilesOptions, string]("ProfilesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ProfilesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ProfilesPager) GetNextWithContext(ctx context.Context) (page []TrustedProfile, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListProfilesWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Profiles
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ProfilesPager) GetAllWithContext(ctx context.Context) (allItems []TrustedProfile, err error) {
+	for pager.HasNext() {
+		var nextPage []TrustedProfile
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ProfilesPager) GetNext() (page []TrustedProfile, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ProfilesPager) GetAll() (allItems []TrustedProfile, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ProfilesPager) All(ctx context.Context) iter.Seq2[TrustedProfile, error] {
+	return common.All[TrustedProfile](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewProfilesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ProfilesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ProfilesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
+// AccountSettingsAssignmentsPager can be used to simplify the use of the "ListAccountSettingsAssignments" method.
+type AccountSettingsAssignmentsPager struct {
+	hasNext     bool
+	options     *ListAccountSettingsAssignmentsOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewAccountSettingsAssignmentsPager returns a new AccountSettingsAssignmentsPager instance.
+func (iamIdentity *IamIdentityV1) NewAccountSettingsAssignmentsPager(options *ListAccountSettingsAssignmentsOptions) (pager *AccountSettingsAssignmentsPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListAccountSettingsAssignmentsOptions = *options
+	pager = &AccountSettingsAssignmentsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// NewAccountSettingsAssignmentsPagerFromCheckpoint returns a new AccountSettingsAssignmentsPager instance that resumes
+// from the position recorded by AccountSettingsAssignmentsPager.Checkpoint().
+func (iamIdentity *IamIdentityV1) NewAccountSettingsAssignmentsPagerFromCheckpoint(checkpoint []byte) (pager *AccountSettingsAssignmentsPager, err error) {
+	pager = &AccountSettingsAssignmentsPager{
+		client: iamIdentity,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListAccountSettingsAssignmentsOptions, string]("AccountSettingsAssignmentsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *AccountSettingsAssignmentsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *AccountSettingsAssignmentsPager) GetNextWithContext(ctx context.Context) (page []TemplateAssignmentResponse, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListAccountSettingsAssignmentsWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Assignments
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *AccountSettingsAssignmentsPager) GetAllWithContext(ctx context.Context) (allItems []TemplateAssignmentResponse, err error) {
+	for pager.HasNext() {
+		var nextPage []TemplateAssignmentResponse
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *AccountSettingsAssignmentsPager) GetNext() (page []TemplateAssignmentResponse, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *AccountSettingsAssignmentsPager) GetAll() (allItems []TemplateAssignmentResponse, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *AccountSettingsAssignmentsPager) All(ctx context.Context) iter.Seq2[TemplateAssignmentResponse, error] {
+	return common.All[TemplateAssignmentResponse](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewAccountSettingsAssignmentsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *AccountSettingsAssignmentsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("AccountSettingsAssignmentsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
+// AccountSettingsTemplatesPager can be used to simplify the use of the "ListAccountSettingsTemplates" method.
+type AccountSettingsTemplatesPager struct {
+	hasNext     bool
+	options     *ListAccountSettingsTemplatesOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewAccountSettingsTemplatesPager returns a new AccountSettingsTemplatesPager instance.
+func (iamIdentity *IamIdentityV1) NewAccountSettingsTemplatesPager(options *ListAccountSettingsTemplatesOptions) (pager *AccountSettingsTemplatesPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListAccountSettingsTemplatesOptions = *options
+	pager = &AccountSettingsTemplatesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// NewAccountSettingsTemplatesPagerFromCheckpoint returns a new AccountSettingsTemplatesPager instance that resumes
+// from the position recorded by AccountSettingsTemplatesPager.Checkpoint().
+func (iamIdentity *IamIdentityV1) NewAccountSettingsTemplatesPagerFromCheckpoint(checkpoint []byte) (pager *AccountSettingsTemplatesPager, err error) {
+	pager = &AccountSettingsTemplatesPager{
+		client: iamIdentity,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListAccountSettingsTemplatesOptions, string]("AccountSettingsTemplatesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *AccountSettingsTemplatesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *AccountSettingsTemplatesPager) GetNextWithContext(ctx context.Context) (page []AccountSettingsTemplateResponse, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListAccountSettingsTemplatesWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.AccountSettingsTemplates
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *AccountSettingsTemplatesPager) GetAllWithContext(ctx context.Context) (allItems []AccountSettingsTemplateResponse, err error) {
+	for pager.HasNext() {
+		var nextPage []AccountSettingsTemplateResponse
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *AccountSettingsTemplatesPager) GetNext() (page []AccountSettingsTemplateResponse, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *AccountSettingsTemplatesPager) GetAll() (allItems []AccountSettingsTemplateResponse, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *AccountSettingsTemplatesPager) All(ctx context.Context) iter.Seq2[AccountSettingsTemplateResponse, error] {
+	return common.All[AccountSettingsTemplateResponse](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewAccountSettingsTemplatesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *AccountSettingsTemplatesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("AccountSettingsTemplatesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
+// VersionsOfAccountSettingsTemplatePager can be used to simplify the use of the "ListVersionsOfAccountSettingsTemplate" method.
+type VersionsOfAccountSettingsTemplatePager struct {
+	hasNext     bool
+	options     *ListVersionsOfAccountSettingsTemplateOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewVersionsOfAccountSettingsTemplatePager returns a new VersionsOfAccountSettingsTemplatePager instance.
+func (iamIdentity *IamIdentityV1) NewVersionsOfAccountSettingsTemplatePager(options *ListVersionsOfAccountSettingsTemplateOptions) (pager *VersionsOfAccountSettingsTemplatePager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListVersionsOfAccountSettingsTemplateOptions = *options
+	pager = &VersionsOfAccountSettingsTemplatePager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// NewVersionsOfAccountSettingsTemplatePagerFromCheckpoint returns a new VersionsOfAccountSettingsTemplatePager instance that resumes
+// from the position recorded by VersionsOfAccountSettingsTemplatePager.Checkpoint().
+func (iamIdentity *IamIdentityV1) NewVersionsOfAccountSettingsTemplatePagerFromCheckpoint(checkpoint []byte) (pager *VersionsOfAccountSettingsTemplatePager, err error) {
+	pager = &VersionsOfAccountSettingsTemplatePager{
+		client: iamIdentity,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListVersionsOfAccountSettingsTemplateOptions, string]("VersionsOfAccountSettingsTemplatePager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *VersionsOfAccountSettingsTemplatePager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *VersionsOfAccountSettingsTemplatePager) GetNextWithContext(ctx context.Context) (page []AccountSettingsTemplateResponse, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListVersionsOfAccountSettingsTemplateWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.AccountSettingsTemplates
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *VersionsOfAccountSettingsTemplatePager) GetAllWithContext(ctx context.Context) (allItems []AccountSettingsTemplateResponse, err error) {
+	for pager.HasNext() {
+		var nextPage []AccountSettingsTemplateResponse
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *VersionsOfAccountSettingsTemplatePager) GetNext() (page []AccountSettingsTemplateResponse, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *VersionsOfAccountSettingsTemplatePager) GetAll() (allItems []AccountSettingsTemplateResponse, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *VersionsOfAccountSettingsTemplatePager) All(ctx context.Context) iter.Seq2[AccountSettingsTemplateResponse, error] {
+	return common.All[AccountSettingsTemplateResponse](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewVersionsOfAccountSettingsTemplatePagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *VersionsOfAccountSettingsTemplatePager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("VersionsOfAccountSettingsTemplatePager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
+// TrustedProfileAssignmentsPager can be used to simplify the use of the "ListTrustedProfileAssignments" method.
+type TrustedProfileAssignmentsPager struct {
+	hasNext     bool
+	options     *ListTrustedProfileAssignmentsOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewTrustedProfileAssignmentsPager returns a new TrustedProfileAssignmentsPager instance.
+func (iamIdentity *IamIdentityV1) NewTrustedProfileAssignmentsPager(options *ListTrustedProfileAssignmentsOptions) (pager *TrustedProfileAssignmentsPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListTrustedProfileAssignmentsOptions = *options
+	pager = &TrustedProfileAssignmentsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// NewTrustedProfileAssignmentsPagerFromCheckpoint returns a new TrustedProfileAssignmentsPager instance that resumes
+// from the position recorded by TrustedProfileAssignmentsPager.Checkpoint().
+func (iamIdentity *IamIdentityV1) NewTrustedProfileAssignmentsPagerFromCheckpoint(checkpoint []byte) (pager *TrustedProfileAssignmentsPager, err error) {
+	pager = &TrustedProfileAssignmentsPager{
+		client: iamIdentity,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListTrustedProfileAssignmentsOptions, string]("TrustedProfileAssignmentsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *TrustedProfileAssignmentsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *TrustedProfileAssignmentsPager) GetNextWithContext(ctx context.Context) (page []TemplateAssignmentResponse, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListTrustedProfileAssignmentsWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Assignments
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *TrustedProfileAssignmentsPager) GetAllWithContext(ctx context.Context) (allItems []TemplateAssignmentResponse, err error) {
+	for pager.HasNext() {
+		var nextPage []TemplateAssignmentResponse
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *TrustedProfileAssignmentsPager) GetNext() (page []TemplateAssignmentResponse, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *TrustedProfileAssignmentsPager) GetAll() (allItems []TemplateAssignmentResponse, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *TrustedProfileAssignmentsPager) All(ctx context.Context) iter.Seq2[TemplateAssignmentResponse, error] {
+	return common.All[TemplateAssignmentResponse](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewTrustedProfileAssignmentsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *TrustedProfileAssignmentsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("TrustedProfileAssignmentsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
+// ProfileTemplatesPager can be used to simplify the use of the "ListProfileTemplates" method.
+type ProfileTemplatesPager struct {
+	hasNext     bool
+	options     *ListProfileTemplatesOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewProfileTemplatesPager returns a new ProfileTemplatesPager instance.
+func (iamIdentity *IamIdentityV1) NewProfileTemplatesPager(options *ListProfileTemplatesOptions) (pager *ProfileTemplatesPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListProfileTemplatesOptions = *options
+	pager = &ProfileTemplatesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// NewProfileTemplatesPagerFromCheckpoint returns a new ProfileTemplatesPager instance that resumes
+// from the position recorded by ProfileTemplatesPager.Checkpoint().
+func (iamIdentity *IamIdentityV1) NewProfileTemplatesPagerFromCheckpoint(checkpoint []byte) (pager *ProfileTemplatesPager, err error) {
+	pager = &ProfileTemplatesPager{
+		client: iamIdentity,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListProfileTemplatesOptions, string]("ProfileTemplatesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ProfileTemplatesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ProfileTemplatesPager) GetNextWithContext(ctx context.Context) (page []TrustedProfileTemplateResponse, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListProfileTemplatesWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.ProfileTemplates
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ProfileTemplatesPager) GetAllWithContext(ctx context.Context) (allItems []TrustedProfileTemplateResponse, err error) {
+	for pager.HasNext() {
+		var nextPage []TrustedProfileTemplateResponse
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ProfileTemplatesPager) GetNext() (page []TrustedProfileTemplateResponse, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ProfileTemplatesPager) GetAll() (allItems []TrustedProfileTemplateResponse, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ProfileTemplatesPager) All(ctx context.Context) iter.Seq2[TrustedProfileTemplateResponse, error] {
+	return common.All[TrustedProfileTemplateResponse](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewProfileTemplatesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ProfileTemplatesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ProfileTemplatesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
+// VersionsOfProfileTemplatePager can be used to simplify the use of the "ListVersionsOfProfileTemplate" method.
+type VersionsOfProfileTemplatePager struct {
+	hasNext     bool
+	options     *ListVersionsOfProfileTemplateOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewVersionsOfProfileTemplatePager returns a new VersionsOfProfileTemplatePager instance.
+func (iamIdentity *IamIdentityV1) NewVersionsOfProfileTemplatePager(options *ListVersionsOfProfileTemplateOptions) (pager *VersionsOfProfileTemplatePager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListVersionsOfProfileTemplateOptions = *options
+	pager = &VersionsOfProfileTemplatePager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// NewVersionsOfProfileTemplatePagerFromCheckpoint returns a new VersionsOfProfileTemplatePager instance that resumes
+// from the position recorded by VersionsOfProfileTemplatePager.Checkpoint().
+func (iamIdentity *IamIdentityV1) NewVersionsOfProfileTemplatePagerFromCheckpoint(checkpoint []byte) (pager *VersionsOfProfileTemplatePager, err error) {
+	pager = &VersionsOfProfileTemplatePager{
+		client: iamIdentity,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListVersionsOfProfileTemplateOptions, string]("VersionsOfProfileTemplatePager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *VersionsOfProfileTemplatePager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *VersionsOfProfileTemplatePager) GetNextWithContext(ctx context.Context) (page []TrustedProfileTemplateResponse, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListVersionsOfProfileTemplateWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.ProfileTemplates
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *VersionsOfProfileTemplatePager) GetAllWithContext(ctx context.Context) (allItems []TrustedProfileTemplateResponse, err error) {
+	for pager.HasNext() {
+		var nextPage []TrustedProfileTemplateResponse
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *VersionsOfProfileTemplatePager) GetNext() (page []TrustedProfileTemplateResponse, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *VersionsOfProfileTemplatePager) GetAll() (allItems []TrustedProfileTemplateResponse, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *VersionsOfProfileTemplatePager) All(ctx context.Context) iter.Seq2[TrustedProfileTemplateResponse, error] {
+	return common.All[TrustedProfileTemplateResponse](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewVersionsOfProfileTemplatePagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *VersionsOfProfileTemplatePager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("VersionsOfProfileTemplatePager", pager.options, pager.hasNext, pager.pageContext.next)
+}
@@ -433,6 +433,189 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextPagetoken successfully`, func() {
+				responseObject := new(iamidentityv1.APIKeyList)
+				responseObject.Next = core.StringPtr("ibm.com?pagetoken=abc-123")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.StringPtr("abc-123")))
+			})
+			It(`Invoke GetNextPagetoken without a "Next" property in the response`, func() {
+				responseObject := new(iamidentityv1.APIKeyList)
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextPagetoken without any query params in the "Next" URL`, func() {
+				responseObject := new(iamidentityv1.APIKeyList)
+				responseObject.Next = core.StringPtr("ibm.com")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listAPIKeysPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","apikeys":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","entity_tag":"EntityTag","crn":"CRN","locked":true,"disabled":true,"created_at":"2019-01-01T12:00:00.000Z","created_by":"CreatedBy","modified_at":"2019-01-01T12:00:00.000Z","name":"Name","support_sessions":false,"action_when_leaked":"ActionWhenLeaked","description":"Description","iam_id":"IamID","account_id":"AccountID","apikey":"Apikey","history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"activity":{"last_authn":"LastAuthn","authn_count":10}}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"apikeys":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","entity_tag":"EntityTag","crn":"CRN","locked":true,"disabled":true,"created_at":"2019-01-01T12:00:00.000Z","created_by":"CreatedBy","modified_at":"2019-01-01T12:00:00.000Z","name":"Name","support_sessions":false,"action_when_leaked":"ActionWhenLeaked","description":"Description","iam_id":"IamID","account_id":"AccountID","apikey":"Apikey","history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"activity":{"last_authn":"LastAuthn","authn_count":10}}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use APIKeysPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAPIKeysOptionsModel := &iamidentityv1.ListAPIKeysOptions{
+					AccountID:      core.StringPtr("testString"),
+					IamID:          core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Scope:          core.StringPtr("entity"),
+					Type:           core.StringPtr("user"),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewAPIKeysPager(listAPIKeysOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.APIKey
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use APIKeysPager.Checkpoint successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAPIKeysOptionsModel := &iamidentityv1.ListAPIKeysOptions{
+					AccountID:      core.StringPtr("testString"),
+					IamID:          core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Scope:          core.StringPtr("entity"),
+					Type:           core.StringPtr("user"),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewAPIKeysPager(listAPIKeysOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.APIKey
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamIdentityService.NewAPIKeysPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use APIKeysPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAPIKeysOptionsModel := &iamidentityv1.ListAPIKeysOptions{
+					AccountID:      core.StringPtr("testString"),
+					IamID:          core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Scope:          core.StringPtr("entity"),
+					Type:           core.StringPtr("user"),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewAPIKeysPager(listAPIKeysOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use APIKeysPager.All successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAPIKeysOptionsModel := &iamidentityv1.ListAPIKeysOptions{
+					AccountID:      core.StringPtr("testString"),
+					IamID:          core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Scope:          core.StringPtr("entity"),
+					Type:           core.StringPtr("user"),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewAPIKeysPager(listAPIKeysOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.APIKey
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateAPIKey(createAPIKeyOptions *CreateAPIKeyOptions) - Operation response error`, func() {
 		createAPIKeyPath := "/v1/apikeys"
@@ -2052,6 +2235,181 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextPagetoken successfully`, func() {
+				responseObject := new(iamidentityv1.ServiceIDList)
+				responseObject.Next = core.StringPtr("ibm.com?pagetoken=abc-123")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.StringPtr("abc-123")))
+			})
+			It(`Invoke GetNextPagetoken without a "Next" property in the response`, func() {
+				responseObject := new(iamidentityv1.ServiceIDList)
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextPagetoken without any query params in the "Next" URL`, func() {
+				responseObject := new(iamidentityv1.ServiceIDList)
+				responseObject.Next = core.StringPtr("ibm.com")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listServiceIdsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","serviceids":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","iam_id":"IamID","entity_tag":"EntityTag","crn":"CRN","locked":true,"created_at":"2019-01-01T12:00:00.000Z","modified_at":"2019-01-01T12:00:00.000Z","account_id":"AccountID","name":"Name","description":"Description","unique_instance_crns":["UniqueInstanceCrns"],"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"apikey":{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","entity_tag":"EntityTag","crn":"CRN","locked":true,"disabled":true,"created_at":"2019-01-01T12:00:00.000Z","created_by":"CreatedBy","modified_at":"2019-01-01T12:00:00.000Z","name":"Name","support_sessions":false,"action_when_leaked":"ActionWhenLeaked","description":"Description","iam_id":"IamID","account_id":"AccountID","apikey":"Apikey","history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"activity":{"last_authn":"LastAuthn","authn_count":10}},"activity":{"last_authn":"LastAuthn","authn_count":10}}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"serviceids":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","iam_id":"IamID","entity_tag":"EntityTag","crn":"CRN","locked":true,"created_at":"2019-01-01T12:00:00.000Z","modified_at":"2019-01-01T12:00:00.000Z","account_id":"AccountID","name":"Name","description":"Description","unique_instance_crns":["UniqueInstanceCrns"],"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"apikey":{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","entity_tag":"EntityTag","crn":"CRN","locked":true,"disabled":true,"created_at":"2019-01-01T12:00:00.000Z","created_by":"CreatedBy","modified_at":"2019-01-01T12:00:00.000Z","name":"Name","support_sessions":false,"action_when_leaked":"ActionWhenLeaked","description":"Description","iam_id":"IamID","account_id":"AccountID","apikey":"Apikey","history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"activity":{"last_authn":"LastAuthn","authn_count":10}},"activity":{"last_authn":"LastAuthn","authn_count":10}}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ServiceIdsPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listServiceIdsOptionsModel := &iamidentityv1.ListServiceIdsOptions{
+					AccountID:      core.StringPtr("testString"),
+					Name:           core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewServiceIdsPager(listServiceIdsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.ServiceID
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ServiceIdsPager.Checkpoint successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listServiceIdsOptionsModel := &iamidentityv1.ListServiceIdsOptions{
+					AccountID:      core.StringPtr("testString"),
+					Name:           core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewServiceIdsPager(listServiceIdsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.ServiceID
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamIdentityService.NewServiceIdsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ServiceIdsPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listServiceIdsOptionsModel := &iamidentityv1.ListServiceIdsOptions{
+					AccountID:      core.StringPtr("testString"),
+					Name:           core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewServiceIdsPager(listServiceIdsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ServiceIdsPager.All successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listServiceIdsOptionsModel := &iamidentityv1.ListServiceIdsOptions{
+					AccountID:      core.StringPtr("testString"),
+					Name:           core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewServiceIdsPager(listServiceIdsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.ServiceID
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateServiceID(createServiceIDOptions *CreateServiceIDOptions) - Operation response error`, func() {
 		createServiceIDPath := "/v1/serviceids/"
@@ -3581,6 +3939,181 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextPagetoken successfully`, func() {
+				responseObject := new(iamidentityv1.TrustedProfilesList)
+				responseObject.Next = core.StringPtr("ibm.com?pagetoken=abc-123")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.StringPtr("abc-123")))
+			})
+			It(`Invoke GetNextPagetoken without a "Next" property in the response`, func() {
+				responseObject := new(iamidentityv1.TrustedProfilesList)
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextPagetoken without any query params in the "Next" URL`, func() {
+				responseObject := new(iamidentityv1.TrustedProfilesList)
+				responseObject.Next = core.StringPtr("ibm.com")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listProfilesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","profiles":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","entity_tag":"EntityTag","crn":"CRN","name":"Name","description":"Description","created_at":"2019-01-01T12:00:00.000Z","modified_at":"2019-01-01T12:00:00.000Z","iam_id":"IamID","account_id":"AccountID","template_id":"TemplateID","assignment_id":"AssignmentID","ims_account_id":12,"ims_user_id":9,"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"activity":{"last_authn":"LastAuthn","authn_count":10}}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"profiles":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","entity_tag":"EntityTag","crn":"CRN","name":"Name","description":"Description","created_at":"2019-01-01T12:00:00.000Z","modified_at":"2019-01-01T12:00:00.000Z","iam_id":"IamID","account_id":"AccountID","template_id":"TemplateID","assignment_id":"AssignmentID","ims_account_id":12,"ims_user_id":9,"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"activity":{"last_authn":"LastAuthn","authn_count":10}}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ProfilesPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfilesOptionsModel := &iamidentityv1.ListProfilesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Name:           core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewProfilesPager(listProfilesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfile
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ProfilesPager.Checkpoint successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfilesOptionsModel := &iamidentityv1.ListProfilesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Name:           core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewProfilesPager(listProfilesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfile
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamIdentityService.NewProfilesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ProfilesPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfilesOptionsModel := &iamidentityv1.ListProfilesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Name:           core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewProfilesPager(listProfilesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ProfilesPager.All successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfilesOptionsModel := &iamidentityv1.ListProfilesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Name:           core.StringPtr("testString"),
+					Pagesize:       core.Int64Ptr(int64(38)),
+					Sort:           core.StringPtr("testString"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewProfilesPager(listProfilesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfile
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`GetProfile(getProfileOptions *GetProfileOptions) - Operation response error`, func() {
 		getProfilePath := "/v1/profiles/testString"
@@ -8752,20 +9285,207 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
-	})
-	Describe(`CreateAccountSettingsAssignment(createAccountSettingsAssignmentOptions *CreateAccountSettingsAssignmentOptions) - Operation response error`, func() {
-		createAccountSettingsAssignmentPath := "/v1/account_settings_assignments/"
-		Context(`Using mock server endpoint with invalid JSON response`, func() {
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextPagetoken successfully`, func() {
+				responseObject := new(iamidentityv1.TemplateAssignmentListResponse)
+				responseObject.Next = core.StringPtr("ibm.com?pagetoken=abc-123")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.StringPtr("abc-123")))
+			})
+			It(`Invoke GetNextPagetoken without a "Next" property in the response`, func() {
+				responseObject := new(iamidentityv1.TemplateAssignmentListResponse)
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextPagetoken without any query params in the "Next" URL`, func() {
+				responseObject := new(iamidentityv1.TemplateAssignmentListResponse)
+				responseObject.Next = core.StringPtr("ibm.com")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
 			BeforeEach(func() {
+				var requestNumber int = 0
 				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
 					defer GinkgoRecover()
 
 					// Verify the contents of the request
-					Expect(req.URL.EscapedPath()).To(Equal(createAccountSettingsAssignmentPath))
-					Expect(req.Method).To(Equal("POST"))
+					Expect(req.URL.EscapedPath()).To(Equal(listAccountSettingsAssignmentsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
 					res.Header().Set("Content-type", "application/json")
-					res.WriteHeader(202)
-					fmt.Fprint(res, `} this is not valid json {`)
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","assignments":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","account_id":"AccountID","template_id":"TemplateID","template_version":15,"target_type":"TargetType","target":"Target","status":"Status","resources":[{"target":"Target","profile":{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"},"account_settings":{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"},"policy_template_refs":[{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"}]}],"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"href":"Href","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID","entity_tag":"EntityTag"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"assignments":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","account_id":"AccountID","template_id":"TemplateID","template_version":15,"target_type":"TargetType","target":"Target","status":"Status","resources":[{"target":"Target","profile":{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"},"account_settings":{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"},"policy_template_refs":[{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"}]}],"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"href":"Href","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID","entity_tag":"EntityTag"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use AccountSettingsAssignmentsPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAccountSettingsAssignmentsOptionsModel := &iamidentityv1.ListAccountSettingsAssignmentsOptions{
+					AccountID:       core.StringPtr("testString"),
+					TemplateID:      core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Target:          core.StringPtr("testString"),
+					TargetType:      core.StringPtr("Account"),
+					Limit:           core.Int64Ptr(int64(20)),
+					Sort:            core.StringPtr("created_at"),
+					Order:           core.StringPtr("asc"),
+					IncludeHistory:  core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewAccountSettingsAssignmentsPager(listAccountSettingsAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TemplateAssignmentResponse
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use AccountSettingsAssignmentsPager.Checkpoint successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAccountSettingsAssignmentsOptionsModel := &iamidentityv1.ListAccountSettingsAssignmentsOptions{
+					AccountID:       core.StringPtr("testString"),
+					TemplateID:      core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Target:          core.StringPtr("testString"),
+					TargetType:      core.StringPtr("Account"),
+					Limit:           core.Int64Ptr(int64(20)),
+					Sort:            core.StringPtr("created_at"),
+					Order:           core.StringPtr("asc"),
+					IncludeHistory:  core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewAccountSettingsAssignmentsPager(listAccountSettingsAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TemplateAssignmentResponse
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamIdentityService.NewAccountSettingsAssignmentsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use AccountSettingsAssignmentsPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAccountSettingsAssignmentsOptionsModel := &iamidentityv1.ListAccountSettingsAssignmentsOptions{
+					AccountID:       core.StringPtr("testString"),
+					TemplateID:      core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Target:          core.StringPtr("testString"),
+					TargetType:      core.StringPtr("Account"),
+					Limit:           core.Int64Ptr(int64(20)),
+					Sort:            core.StringPtr("created_at"),
+					Order:           core.StringPtr("asc"),
+					IncludeHistory:  core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewAccountSettingsAssignmentsPager(listAccountSettingsAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use AccountSettingsAssignmentsPager.All successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAccountSettingsAssignmentsOptionsModel := &iamidentityv1.ListAccountSettingsAssignmentsOptions{
+					AccountID:       core.StringPtr("testString"),
+					TemplateID:      core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Target:          core.StringPtr("testString"),
+					TargetType:      core.StringPtr("Account"),
+					Limit:           core.Int64Ptr(int64(20)),
+					Sort:            core.StringPtr("created_at"),
+					Order:           core.StringPtr("asc"),
+					IncludeHistory:  core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewAccountSettingsAssignmentsPager(listAccountSettingsAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TemplateAssignmentResponse
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
+	})
+	Describe(`CreateAccountSettingsAssignment(createAccountSettingsAssignmentOptions *CreateAccountSettingsAssignmentOptions) - Operation response error`, func() {
+		createAccountSettingsAssignmentPath := "/v1/account_settings_assignments/"
+		Context(`Using mock server endpoint with invalid JSON response`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(createAccountSettingsAssignmentPath))
+					Expect(req.Method).To(Equal("POST"))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(202)
+					fmt.Fprint(res, `} this is not valid json {`)
 				}))
 			})
 			It(`Invoke CreateAccountSettingsAssignment with error: Operation response processing error`, func() {
@@ -9951,6 +10671,177 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextPagetoken successfully`, func() {
+				responseObject := new(iamidentityv1.AccountSettingsTemplateList)
+				responseObject.Next = core.StringPtr("ibm.com?pagetoken=abc-123")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.StringPtr("abc-123")))
+			})
+			It(`Invoke GetNextPagetoken without a "Next" property in the response`, func() {
+				responseObject := new(iamidentityv1.AccountSettingsTemplateList)
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextPagetoken without any query params in the "Next" URL`, func() {
+				responseObject := new(iamidentityv1.AccountSettingsTemplateList)
+				responseObject.Next = core.StringPtr("ibm.com")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listAccountSettingsTemplatesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","account_settings_templates":[{"id":"ID","version":7,"account_id":"AccountID","name":"Name","description":"Description","committed":false,"account_settings":{"restrict_create_service_id":"NOT_SET","restrict_create_platform_apikey":"NOT_SET","allowed_ip_addresses":"AllowedIPAddresses","mfa":"NONE","user_mfa":[{"iam_id":"IamID","mfa":"NONE"}],"session_expiration_in_seconds":"86400","session_invalidation_in_seconds":"7200","max_sessions_per_identity":"MaxSessionsPerIdentity","system_access_token_expiration_in_seconds":"3600","system_refresh_token_expiration_in_seconds":"259200"},"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"entity_tag":"EntityTag","crn":"CRN","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"account_settings_templates":[{"id":"ID","version":7,"account_id":"AccountID","name":"Name","description":"Description","committed":false,"account_settings":{"restrict_create_service_id":"NOT_SET","restrict_create_platform_apikey":"NOT_SET","allowed_ip_addresses":"AllowedIPAddresses","mfa":"NONE","user_mfa":[{"iam_id":"IamID","mfa":"NONE"}],"session_expiration_in_seconds":"86400","session_invalidation_in_seconds":"7200","max_sessions_per_identity":"MaxSessionsPerIdentity","system_access_token_expiration_in_seconds":"3600","system_refresh_token_expiration_in_seconds":"259200"},"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"entity_tag":"EntityTag","crn":"CRN","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use AccountSettingsTemplatesPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAccountSettingsTemplatesOptionsModel := &iamidentityv1.ListAccountSettingsTemplatesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewAccountSettingsTemplatesPager(listAccountSettingsTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.AccountSettingsTemplateResponse
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use AccountSettingsTemplatesPager.Checkpoint successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAccountSettingsTemplatesOptionsModel := &iamidentityv1.ListAccountSettingsTemplatesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewAccountSettingsTemplatesPager(listAccountSettingsTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.AccountSettingsTemplateResponse
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamIdentityService.NewAccountSettingsTemplatesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use AccountSettingsTemplatesPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAccountSettingsTemplatesOptionsModel := &iamidentityv1.ListAccountSettingsTemplatesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewAccountSettingsTemplatesPager(listAccountSettingsTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use AccountSettingsTemplatesPager.All successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAccountSettingsTemplatesOptionsModel := &iamidentityv1.ListAccountSettingsTemplatesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewAccountSettingsTemplatesPager(listAccountSettingsTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.AccountSettingsTemplateResponse
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateAccountSettingsTemplate(createAccountSettingsTemplateOptions *CreateAccountSettingsTemplateOptions) - Operation response error`, func() {
 		createAccountSettingsTemplatePath := "/v1/account_settings_templates"
@@ -10833,6 +11724,177 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextPagetoken successfully`, func() {
+				responseObject := new(iamidentityv1.AccountSettingsTemplateList)
+				responseObject.Next = core.StringPtr("ibm.com?pagetoken=abc-123")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.StringPtr("abc-123")))
+			})
+			It(`Invoke GetNextPagetoken without a "Next" property in the response`, func() {
+				responseObject := new(iamidentityv1.AccountSettingsTemplateList)
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextPagetoken without any query params in the "Next" URL`, func() {
+				responseObject := new(iamidentityv1.AccountSettingsTemplateList)
+				responseObject.Next = core.StringPtr("ibm.com")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listVersionsOfAccountSettingsTemplatePath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","account_settings_templates":[{"id":"ID","version":7,"account_id":"AccountID","name":"Name","description":"Description","committed":false,"account_settings":{"restrict_create_service_id":"NOT_SET","restrict_create_platform_apikey":"NOT_SET","allowed_ip_addresses":"AllowedIPAddresses","mfa":"NONE","user_mfa":[{"iam_id":"IamID","mfa":"NONE"}],"session_expiration_in_seconds":"86400","session_invalidation_in_seconds":"7200","max_sessions_per_identity":"MaxSessionsPerIdentity","system_access_token_expiration_in_seconds":"3600","system_refresh_token_expiration_in_seconds":"259200"},"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"entity_tag":"EntityTag","crn":"CRN","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"account_settings_templates":[{"id":"ID","version":7,"account_id":"AccountID","name":"Name","description":"Description","committed":false,"account_settings":{"restrict_create_service_id":"NOT_SET","restrict_create_platform_apikey":"NOT_SET","allowed_ip_addresses":"AllowedIPAddresses","mfa":"NONE","user_mfa":[{"iam_id":"IamID","mfa":"NONE"}],"session_expiration_in_seconds":"86400","session_invalidation_in_seconds":"7200","max_sessions_per_identity":"MaxSessionsPerIdentity","system_access_token_expiration_in_seconds":"3600","system_refresh_token_expiration_in_seconds":"259200"},"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"entity_tag":"EntityTag","crn":"CRN","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use VersionsOfAccountSettingsTemplatePager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listVersionsOfAccountSettingsTemplateOptionsModel := &iamidentityv1.ListVersionsOfAccountSettingsTemplateOptions{
+					TemplateID:     core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewVersionsOfAccountSettingsTemplatePager(listVersionsOfAccountSettingsTemplateOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.AccountSettingsTemplateResponse
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use VersionsOfAccountSettingsTemplatePager.Checkpoint successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listVersionsOfAccountSettingsTemplateOptionsModel := &iamidentityv1.ListVersionsOfAccountSettingsTemplateOptions{
+					TemplateID:     core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewVersionsOfAccountSettingsTemplatePager(listVersionsOfAccountSettingsTemplateOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.AccountSettingsTemplateResponse
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamIdentityService.NewVersionsOfAccountSettingsTemplatePagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use VersionsOfAccountSettingsTemplatePager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listVersionsOfAccountSettingsTemplateOptionsModel := &iamidentityv1.ListVersionsOfAccountSettingsTemplateOptions{
+					TemplateID:     core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewVersionsOfAccountSettingsTemplatePager(listVersionsOfAccountSettingsTemplateOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use VersionsOfAccountSettingsTemplatePager.All successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listVersionsOfAccountSettingsTemplateOptionsModel := &iamidentityv1.ListVersionsOfAccountSettingsTemplateOptions{
+					TemplateID:     core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewVersionsOfAccountSettingsTemplatePager(listVersionsOfAccountSettingsTemplateOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.AccountSettingsTemplateResponse
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateAccountSettingsTemplateVersion(createAccountSettingsTemplateVersionOptions *CreateAccountSettingsTemplateVersionOptions) - Operation response error`, func() {
 		createAccountSettingsTemplateVersionPath := "/v1/account_settings_templates/testString/versions"
@@ -12875,6 +13937,193 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextPagetoken successfully`, func() {
+				responseObject := new(iamidentityv1.TemplateAssignmentListResponse)
+				responseObject.Next = core.StringPtr("ibm.com?pagetoken=abc-123")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.StringPtr("abc-123")))
+			})
+			It(`Invoke GetNextPagetoken without a "Next" property in the response`, func() {
+				responseObject := new(iamidentityv1.TemplateAssignmentListResponse)
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextPagetoken without any query params in the "Next" URL`, func() {
+				responseObject := new(iamidentityv1.TemplateAssignmentListResponse)
+				responseObject.Next = core.StringPtr("ibm.com")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listTrustedProfileAssignmentsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","assignments":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","account_id":"AccountID","template_id":"TemplateID","template_version":15,"target_type":"TargetType","target":"Target","status":"Status","resources":[{"target":"Target","profile":{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"},"account_settings":{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"},"policy_template_refs":[{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"}]}],"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"href":"Href","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID","entity_tag":"EntityTag"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"assignments":[{"context":{"transaction_id":"TransactionID","operation":"Operation","user_agent":"UserAgent","url":"URL","instance_id":"InstanceID","thread_id":"ThreadID","host":"Host","start_time":"StartTime","end_time":"EndTime","elapsed_time":"ElapsedTime","cluster_name":"ClusterName"},"id":"ID","account_id":"AccountID","template_id":"TemplateID","template_version":15,"target_type":"TargetType","target":"Target","status":"Status","resources":[{"target":"Target","profile":{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"},"account_settings":{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"},"policy_template_refs":[{"id":"ID","version":"Version","resource_created":{"id":"ID"},"error_message":{"name":"Name","errorCode":"ErrorCode","message":"Message","statusCode":"StatusCode"},"status":"Status"}]}],"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"href":"Href","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID","entity_tag":"EntityTag"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use TrustedProfileAssignmentsPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listTrustedProfileAssignmentsOptionsModel := &iamidentityv1.ListTrustedProfileAssignmentsOptions{
+					AccountID:       core.StringPtr("testString"),
+					TemplateID:      core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Target:          core.StringPtr("testString"),
+					TargetType:      core.StringPtr("Account"),
+					Limit:           core.Int64Ptr(int64(20)),
+					Sort:            core.StringPtr("created_at"),
+					Order:           core.StringPtr("asc"),
+					IncludeHistory:  core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewTrustedProfileAssignmentsPager(listTrustedProfileAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TemplateAssignmentResponse
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use TrustedProfileAssignmentsPager.Checkpoint successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listTrustedProfileAssignmentsOptionsModel := &iamidentityv1.ListTrustedProfileAssignmentsOptions{
+					AccountID:       core.StringPtr("testString"),
+					TemplateID:      core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Target:          core.StringPtr("testString"),
+					TargetType:      core.StringPtr("Account"),
+					Limit:           core.Int64Ptr(int64(20)),
+					Sort:            core.StringPtr("created_at"),
+					Order:           core.StringPtr("asc"),
+					IncludeHistory:  core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewTrustedProfileAssignmentsPager(listTrustedProfileAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TemplateAssignmentResponse
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamIdentityService.NewTrustedProfileAssignmentsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use TrustedProfileAssignmentsPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listTrustedProfileAssignmentsOptionsModel := &iamidentityv1.ListTrustedProfileAssignmentsOptions{
+					AccountID:       core.StringPtr("testString"),
+					TemplateID:      core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Target:          core.StringPtr("testString"),
+					TargetType:      core.StringPtr("Account"),
+					Limit:           core.Int64Ptr(int64(20)),
+					Sort:            core.StringPtr("created_at"),
+					Order:           core.StringPtr("asc"),
+					IncludeHistory:  core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewTrustedProfileAssignmentsPager(listTrustedProfileAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use TrustedProfileAssignmentsPager.All successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listTrustedProfileAssignmentsOptionsModel := &iamidentityv1.ListTrustedProfileAssignmentsOptions{
+					AccountID:       core.StringPtr("testString"),
+					TemplateID:      core.StringPtr("testString"),
+					TemplateVersion: core.StringPtr("testString"),
+					Target:          core.StringPtr("testString"),
+					TargetType:      core.StringPtr("Account"),
+					Limit:           core.Int64Ptr(int64(20)),
+					Sort:            core.StringPtr("created_at"),
+					Order:           core.StringPtr("asc"),
+					IncludeHistory:  core.BoolPtr(false),
+				}
+
+				pager, err := iamIdentityService.NewTrustedProfileAssignmentsPager(listTrustedProfileAssignmentsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TemplateAssignmentResponse
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateTrustedProfileAssignment(createTrustedProfileAssignmentOptions *CreateTrustedProfileAssignmentOptions) - Operation response error`, func() {
 		createTrustedProfileAssignmentPath := "/v1/profile_assignments/"
@@ -14074,6 +15323,177 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextPagetoken successfully`, func() {
+				responseObject := new(iamidentityv1.TrustedProfileTemplateList)
+				responseObject.Next = core.StringPtr("ibm.com?pagetoken=abc-123")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.StringPtr("abc-123")))
+			})
+			It(`Invoke GetNextPagetoken without a "Next" property in the response`, func() {
+				responseObject := new(iamidentityv1.TrustedProfileTemplateList)
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextPagetoken without any query params in the "Next" URL`, func() {
+				responseObject := new(iamidentityv1.TrustedProfileTemplateList)
+				responseObject.Next = core.StringPtr("ibm.com")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listProfileTemplatesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","profile_templates":[{"id":"ID","version":7,"account_id":"AccountID","name":"Name","description":"Description","committed":false,"profile":{"name":"Name","description":"Description","rules":[{"name":"Name","type":"Profile-SAML","realm_name":"RealmName","expiration":10,"conditions":[{"claim":"Claim","operator":"Operator","value":"Value"}]}],"identities":[{"iam_id":"IamID","identifier":"Identifier","type":"user","accounts":["Accounts"],"description":"Description"}]},"policy_template_references":[{"id":"ID","version":"Version"}],"action_controls":{"identities":{"add":false,"remove":true},"rules":{"add":false,"remove":true},"policies":{"add":false,"remove":true}},"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"entity_tag":"EntityTag","crn":"CRN","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"profile_templates":[{"id":"ID","version":7,"account_id":"AccountID","name":"Name","description":"Description","committed":false,"profile":{"name":"Name","description":"Description","rules":[{"name":"Name","type":"Profile-SAML","realm_name":"RealmName","expiration":10,"conditions":[{"claim":"Claim","operator":"Operator","value":"Value"}]}],"identities":[{"iam_id":"IamID","identifier":"Identifier","type":"user","accounts":["Accounts"],"description":"Description"}]},"policy_template_references":[{"id":"ID","version":"Version"}],"action_controls":{"identities":{"add":false,"remove":true},"rules":{"add":false,"remove":true},"policies":{"add":false,"remove":true}},"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"entity_tag":"EntityTag","crn":"CRN","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ProfileTemplatesPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfileTemplatesOptionsModel := &iamidentityv1.ListProfileTemplatesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewProfileTemplatesPager(listProfileTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfileTemplateResponse
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ProfileTemplatesPager.Checkpoint successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfileTemplatesOptionsModel := &iamidentityv1.ListProfileTemplatesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewProfileTemplatesPager(listProfileTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfileTemplateResponse
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamIdentityService.NewProfileTemplatesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ProfileTemplatesPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfileTemplatesOptionsModel := &iamidentityv1.ListProfileTemplatesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewProfileTemplatesPager(listProfileTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ProfileTemplatesPager.All successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfileTemplatesOptionsModel := &iamidentityv1.ListProfileTemplatesOptions{
+					AccountID:      core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewProfileTemplatesPager(listProfileTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfileTemplateResponse
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateProfileTemplate(createProfileTemplateOptions *CreateProfileTemplateOptions) - Operation response error`, func() {
 		createProfileTemplatePath := "/v1/profile_templates"
@@ -15146,6 +16566,177 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Test pagination helper method on response`, func() {
+			It(`Invoke GetNextPagetoken successfully`, func() {
+				responseObject := new(iamidentityv1.TrustedProfileTemplateList)
+				responseObject.Next = core.StringPtr("ibm.com?pagetoken=abc-123")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(core.StringPtr("abc-123")))
+			})
+			It(`Invoke GetNextPagetoken without a "Next" property in the response`, func() {
+				responseObject := new(iamidentityv1.TrustedProfileTemplateList)
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+			It(`Invoke GetNextPagetoken without any query params in the "Next" URL`, func() {
+				responseObject := new(iamidentityv1.TrustedProfileTemplateList)
+				responseObject.Next = core.StringPtr("ibm.com")
+
+				value, err := responseObject.GetNextPagetoken()
+				Expect(err).To(BeNil())
+				Expect(value).To(BeNil())
+			})
+		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listVersionsOfProfileTemplatePath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","profile_templates":[{"id":"ID","version":7,"account_id":"AccountID","name":"Name","description":"Description","committed":false,"profile":{"name":"Name","description":"Description","rules":[{"name":"Name","type":"Profile-SAML","realm_name":"RealmName","expiration":10,"conditions":[{"claim":"Claim","operator":"Operator","value":"Value"}]}],"identities":[{"iam_id":"IamID","identifier":"Identifier","type":"user","accounts":["Accounts"],"description":"Description"}]},"policy_template_references":[{"id":"ID","version":"Version"}],"action_controls":{"identities":{"add":false,"remove":true},"rules":{"add":false,"remove":true},"policies":{"add":false,"remove":true}},"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"entity_tag":"EntityTag","crn":"CRN","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"profile_templates":[{"id":"ID","version":7,"account_id":"AccountID","name":"Name","description":"Description","committed":false,"profile":{"name":"Name","description":"Description","rules":[{"name":"Name","type":"Profile-SAML","realm_name":"RealmName","expiration":10,"conditions":[{"claim":"Claim","operator":"Operator","value":"Value"}]}],"identities":[{"iam_id":"IamID","identifier":"Identifier","type":"user","accounts":["Accounts"],"description":"Description"}]},"policy_template_references":[{"id":"ID","version":"Version"}],"action_controls":{"identities":{"add":false,"remove":true},"rules":{"add":false,"remove":true},"policies":{"add":false,"remove":true}},"history":[{"timestamp":"Timestamp","iam_id":"IamID","iam_id_account":"IamIDAccount","action":"Action","params":["Params"],"message":"Message"}],"entity_tag":"EntityTag","crn":"CRN","created_at":"CreatedAt","created_by_id":"CreatedByID","last_modified_at":"LastModifiedAt","last_modified_by_id":"LastModifiedByID"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use VersionsOfProfileTemplatePager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listVersionsOfProfileTemplateOptionsModel := &iamidentityv1.ListVersionsOfProfileTemplateOptions{
+					TemplateID:     core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewVersionsOfProfileTemplatePager(listVersionsOfProfileTemplateOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfileTemplateResponse
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use VersionsOfProfileTemplatePager.Checkpoint successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listVersionsOfProfileTemplateOptionsModel := &iamidentityv1.ListVersionsOfProfileTemplateOptions{
+					TemplateID:     core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewVersionsOfProfileTemplatePager(listVersionsOfProfileTemplateOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfileTemplateResponse
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = iamIdentityService.NewVersionsOfProfileTemplatePagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use VersionsOfProfileTemplatePager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listVersionsOfProfileTemplateOptionsModel := &iamidentityv1.ListVersionsOfProfileTemplateOptions{
+					TemplateID:     core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewVersionsOfProfileTemplatePager(listVersionsOfProfileTemplateOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use VersionsOfProfileTemplatePager.All successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listVersionsOfProfileTemplateOptionsModel := &iamidentityv1.ListVersionsOfProfileTemplateOptions{
+					TemplateID:     core.StringPtr("testString"),
+					Limit:          core.StringPtr("20"),
+					Sort:           core.StringPtr("created_at"),
+					Order:          core.StringPtr("asc"),
+					IncludeHistory: core.StringPtr("false"),
+				}
+
+				pager, err := iamIdentityService.NewVersionsOfProfileTemplatePager(listVersionsOfProfileTemplateOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfileTemplateResponse
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateProfileTemplateVersion(createProfileTemplateVersionOptions *CreateProfileTemplateVersionOptions) - Operation response error`, func() {
 		createProfileTemplateVersionPath := "/v1/profile_templates/testString/versions"
@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.90.1-64fd3296-20240515-180710
+ */
+
+package iampolicymanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// IamPolicyManagementV1API : The operations supported by the IamPolicyManagementV1 service. This interface is satisfied by
+// *IamPolicyManagementV1 and may be used to substitute a fake or a decorator for the service client.
+type IamPolicyManagementV1API interface {
+	// ListPoliciesWithContext : Get policies by attributes
+	ListPoliciesWithContext(ctx context.Context, listPoliciesOptions *ListPoliciesOptions) (result *PolicyCollection, response *core.DetailedResponse, err error)
+
+	// CreatePolicyWithContext : Create a policy
+	CreatePolicyWithContext(ctx context.Context, createPolicyOptions *CreatePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// ReplacePolicyWithContext : Update a policy
+	ReplacePolicyWithContext(ctx context.Context, replacePolicyOptions *ReplacePolicyOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// GetPolicyWithContext : Retrieve a policy by ID
+	GetPolicyWithContext(ctx context.Context, getPolicyOptions *GetPolicyOptions) (result *PolicyTemplateMetaData, response *core.DetailedResponse, err error)
+
+	// DeletePolicyWithContext : Delete a policy by ID
+	DeletePolicyWithContext(ctx context.Context, deletePolicyOptions *DeletePolicyOptions) (response *core.DetailedResponse, err error)
+
+	// UpdatePolicyStateWithContext : Restore a deleted policy by ID
+	UpdatePolicyStateWithContext(ctx context.Context, updatePolicyStateOptions *UpdatePolicyStateOptions) (result *Policy, response *core.DetailedResponse, err error)
+
+	// ListRolesWithContext : Get roles by filters
+	ListRolesWithContext(ctx context.Context, listRolesOptions *ListRolesOptions) (result *RoleCollection, response *core.DetailedResponse, err error)
+
+	// CreateRoleWithContext : Create a role
+	CreateRoleWithContext(ctx context.Context, createRoleOptions *CreateRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// ReplaceRoleWithContext : Update a role
+	ReplaceRoleWithContext(ctx context.Context, replaceRoleOptions *ReplaceRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// GetRoleWithContext : Retrieve a role by ID
+	GetRoleWithContext(ctx context.Context, getRoleOptions *GetRoleOptions) (result *CustomRole, response *core.DetailedResponse, err error)
+
+	// DeleteRoleWithContext : Delete a role by ID
+	DeleteRoleWithContext(ctx context.Context, deleteRoleOptions *DeleteRoleOptions) (response *core.DetailedResponse, err error)
+
+	// ListV2PoliciesWithContext : Get policies by attributes
+	ListV2PoliciesWithContext(ctx context.Context, listV2PoliciesOptions *ListV2PoliciesOptions) (result *V2PolicyCollection, response *core.DetailedResponse, err error)
+
+	// CreateV2PolicyWithContext : Create a policy
+	CreateV2PolicyWithContext(ctx context.Context, createV2PolicyOptions *CreateV2PolicyOptions) (result *V2Policy, response *core.DetailedResponse, err error)
+
+	// ReplaceV2PolicyWithContext : Update a policy
+	ReplaceV2PolicyWithContext(ctx context.Context, replaceV2PolicyOptions *ReplaceV2PolicyOptions) (result *V2Policy, response *core.DetailedResponse, err error)
+
+	// GetV2PolicyWithContext : Retrieve a policy by ID
+	GetV2PolicyWithContext(ctx context.Context, getV2PolicyOptions *GetV2PolicyOptions) (result *V2PolicyTemplateMetaData, response *core.DetailedResponse, err error)
+
+	// DeleteV2PolicyWithContext : Delete a policy by ID
+	DeleteV2PolicyWithContext(ctx context.Context, deleteV2PolicyOptions *DeleteV2PolicyOptions) (response *core.DetailedResponse, err error)
+
+	// ListPolicyTemplatesWithContext : List policy templates by attributes
+	ListPolicyTemplatesWithContext(ctx context.Context, listPolicyTemplatesOptions *ListPolicyTemplatesOptions) (result *PolicyTemplateCollection, response *core.DetailedResponse, err error)
+
+	// CreatePolicyTemplateWithContext : Create a policy template
+	CreatePolicyTemplateWithContext(ctx context.Context, createPolicyTemplateOptions *CreatePolicyTemplateOptions) (result *PolicyTemplateLimitData, response *core.DetailedResponse, err error)
+
+	// GetPolicyTemplateWithContext : Retrieve latest version of a policy template
+	GetPolicyTemplateWithContext(ctx context.Context, getPolicyTemplateOptions *GetPolicyTemplateOptions) (result *PolicyTemplate, response *core.DetailedResponse, err error)
+
+	// DeletePolicyTemplateWithContext : Delete a policy template
+	DeletePolicyTemplateWithContext(ctx context.Context, deletePolicyTemplateOptions *DeletePolicyTemplateOptions) (response *core.DetailedResponse, err error)
+
+	// CreatePolicyTemplateVersionWithContext : Create a new policy template version
+	CreatePolicyTemplateVersionWithContext(ctx context.Context, createPolicyTemplateVersionOptions *CreatePolicyTemplateVersionOptions) (result *PolicyTemplateLimitData, response *core.DetailedResponse, err error)
+
+	// ListPolicyTemplateVersionsWithContext : Retrieve policy template versions
+	ListPolicyTemplateVersionsWithContext(ctx context.Context, listPolicyTemplateVersionsOptions *ListPolicyTemplateVersionsOptions) (result *PolicyTemplateVersionsCollection, response *core.DetailedResponse, err error)
+
+	// ReplacePolicyTemplateWithContext : Update a policy template version
+	ReplacePolicyTemplateWithContext(ctx context.Context, replacePolicyTemplateOptions *ReplacePolicyTemplateOptions) (result *PolicyTemplate, response *core.DetailedResponse, err error)
+
+	// DeletePolicyTemplateVersionWithContext : Delete a policy template version
+	DeletePolicyTemplateVersionWithContext(ctx context.Context, deletePolicyTemplateVersionOptions *DeletePolicyTemplateVersionOptions) (response *core.DetailedResponse, err error)
+
+	// GetPolicyTemplateVersionWithContext : Retrieve a policy template version
+	GetPolicyTemplateVersionWithContext(ctx context.Context, getPolicyTemplateVersionOptions *GetPolicyTemplateVersionOptions) (result *PolicyTemplate, response *core.DetailedResponse, err error)
+
+	// CommitPolicyTemplateWithContext : Commit a policy template version
+	CommitPolicyTemplateWithContext(ctx context.Context, commitPolicyTemplateOptions *CommitPolicyTemplateOptions) (response *core.DetailedResponse, err error)
+
+	// ListPolicyAssignmentsWithContext : Get policy template assignments
+	ListPolicyAssignmentsWithContext(ctx context.Context, listPolicyAssignmentsOptions *ListPolicyAssignmentsOptions) (result *PolicyTemplateAssignmentCollection, response *core.DetailedResponse, err error)
+
+	// CreatePolicyTemplateAssignmentWithContext : Create a policy authorization template assignment
+	CreatePolicyTemplateAssignmentWithContext(ctx context.Context, createPolicyTemplateAssignmentOptions *CreatePolicyTemplateAssignmentOptions) (result *PolicyAssignmentV1Collection, response *core.DetailedResponse, err error)
+
+	// GetPolicyAssignmentWithContext : Retrieve a policy assignment
+	GetPolicyAssignmentWithContext(ctx context.Context, getPolicyAssignmentOptions *GetPolicyAssignmentOptions) (result GetPolicyAssignmentResponseIntf, response *core.DetailedResponse, err error)
+
+	// UpdatePolicyAssignmentWithContext : Update a policy authorization type assignment
+	UpdatePolicyAssignmentWithContext(ctx context.Context, updatePolicyAssignmentOptions *UpdatePolicyAssignmentOptions) (result *PolicyAssignmentV1, response *core.DetailedResponse, err error)
+
+	// DeletePolicyAssignmentWithContext : Remove a policy assignment
+	DeletePolicyAssignmentWithContext(ctx context.Context, deletePolicyAssignmentOptions *DeletePolicyAssignmentOptions) (response *core.DetailedResponse, err error)
+}
+
+// Verify that IamPolicyManagementV1 implements the IamPolicyManagementV1API interface.
+var _ IamPolicyManagementV1API = (*IamPolicyManagementV1)(nil)
@@ -0,0 +1,40 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.33.0-caf29bd0-20210603-225214
+ */
+
+package ibmcloudshellv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// IBMCloudShellV1API : The operations supported by the IBMCloudShellV1 service. This interface is satisfied by
+// *IBMCloudShellV1 and may be used to substitute a fake or a decorator for the service client.
+type IBMCloudShellV1API interface {
+	// GetAccountSettingsWithContext : Get account settings
+	GetAccountSettingsWithContext(ctx context.Context, getAccountSettingsOptions *GetAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+
+	// UpdateAccountSettingsWithContext : Update account settings
+	UpdateAccountSettingsWithContext(ctx context.Context, updateAccountSettingsOptions *UpdateAccountSettingsOptions) (result *AccountSettings, response *core.DetailedResponse, err error)
+}
+
+// Verify that IBMCloudShellV1 implements the IBMCloudShellV1API interface.
+var _ IBMCloudShellV1API = (*IBMCloudShellV1)(nil)
@@ -0,0 +1,70 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.84.1-55f6d880-20240110-194020
+ */
+
+package metricsrouterv3
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MetricsRouterV3API : The operations supported by the MetricsRouterV3 service. This interface is satisfied by
+// *MetricsRouterV3 and may be used to substitute a fake or a decorator for the service client.
+type MetricsRouterV3API interface {
+	// CreateTargetWithContext : Create a target
+	CreateTargetWithContext(ctx context.Context, createTargetOptions *CreateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// ListTargetsWithContext : List targets
+	ListTargetsWithContext(ctx context.Context, listTargetsOptions *ListTargetsOptions) (result *TargetCollection, response *core.DetailedResponse, err error)
+
+	// GetTargetWithContext : Get details of a target
+	GetTargetWithContext(ctx context.Context, getTargetOptions *GetTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// UpdateTargetWithContext : Update a target
+	UpdateTargetWithContext(ctx context.Context, updateTargetOptions *UpdateTargetOptions) (result *Target, response *core.DetailedResponse, err error)
+
+	// DeleteTargetWithContext : Delete a target
+	DeleteTargetWithContext(ctx context.Context, deleteTargetOptions *DeleteTargetOptions) (response *core.DetailedResponse, err error)
+
+	// CreateRouteWithContext : Create a route
+	CreateRouteWithContext(ctx context.Context, createRouteOptions *CreateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// ListRoutesWithContext : List routes
+	ListRoutesWithContext(ctx context.Context, listRoutesOptions *ListRoutesOptions) (result *RouteCollection, response *core.DetailedResponse, err error)
+
+	// GetRouteWithContext : Get details of a route
+	GetRouteWithContext(ctx context.Context, getRouteOptions *GetRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// UpdateRouteWithContext : Update a route
+	UpdateRouteWithContext(ctx context.Context, updateRouteOptions *UpdateRouteOptions) (result *Route, response *core.DetailedResponse, err error)
+
+	// DeleteRouteWithContext : Delete a route
+	DeleteRouteWithContext(ctx context.Context, deleteRouteOptions *DeleteRouteOptions) (response *core.DetailedResponse, err error)
+
+	// GetSettingsWithContext : Get settings
+	GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *Setting, response *core.DetailedResponse, err error)
+
+	// UpdateSettingsWithContext : Modify settings
+	UpdateSettingsWithContext(ctx context.Context, updateSettingsOptions *UpdateSettingsOptions) (result *Setting, response *core.DetailedResponse, err error)
+}
+
+// Verify that MetricsRouterV3 implements the MetricsRouterV3API interface.
+var _ MetricsRouterV3API = (*MetricsRouterV3)(nil)
@@ -0,0 +1,497 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+// zonePreviewSize is the maximum number of addresses included in the preview of a zone.
+const zonePreviewSize = 5
+
+// ContextBasedRestrictionsV1Server is an in-memory fake of the Context Based Restrictions service.
+//
+// It supports the zone and rule operations. Replacing a zone or rule requires an If-Match header
+// matching the ETag returned when it was retrieved, and a zone that is referenced by a rule cannot
+// be deleted.
+type ContextBasedRestrictionsV1Server struct {
+	*Server
+
+	zones []*contextbasedrestrictionsv1.Zone
+	rules []*contextbasedrestrictionsv1.Rule
+}
+
+// zoneBody is the body of a create or replace zone request.
+type zoneBody struct {
+	Name        *string                              `json:"name"`
+	AccountID   *string                              `json:"account_id"`
+	Description *string                              `json:"description"`
+	Addresses   []contextbasedrestrictionsv1.Address `json:"addresses"`
+	Excluded    []contextbasedrestrictionsv1.Address `json:"excluded"`
+}
+
+// ruleBody is the body of a create or replace rule request.
+type ruleBody struct {
+	Description     *string                                       `json:"description"`
+	Contexts        []contextbasedrestrictionsv1.RuleContext      `json:"contexts"`
+	Resources       []contextbasedrestrictionsv1.Resource         `json:"resources"`
+	Operations      *contextbasedrestrictionsv1.NewRuleOperations `json:"operations"`
+	EnforcementMode *string                                       `json:"enforcement_mode"`
+}
+
+// NewContextBasedRestrictionsV1Server returns a new, started ContextBasedRestrictionsV1Server.
+// The caller is responsible for invoking Close() when the server is no longer needed.
+func NewContextBasedRestrictionsV1Server() *ContextBasedRestrictionsV1Server {
+	fake := &ContextBasedRestrictionsV1Server{
+		Server: newServer(),
+	}
+	fake.handle("GET /v1/zones", fake.listZones)
+	fake.handle("POST /v1/zones", fake.createZone)
+	fake.handle("GET /v1/zones/{zone_id}", fake.getZone)
+	fake.handle("PUT /v1/zones/{zone_id}", fake.replaceZone)
+	fake.handle("DELETE /v1/zones/{zone_id}", fake.deleteZone)
+	fake.handle("GET /v1/rules", fake.listRules)
+	fake.handle("POST /v1/rules", fake.createRule)
+	fake.handle("GET /v1/rules/{rule_id}", fake.getRule)
+	fake.handle("PUT /v1/rules/{rule_id}", fake.replaceRule)
+	fake.handle("DELETE /v1/rules/{rule_id}", fake.deleteRule)
+	fake.start()
+	return fake
+}
+
+// NewClient returns a ContextBasedRestrictionsV1 client that sends its requests to the fake.
+func (fake *ContextBasedRestrictionsV1Server) NewClient() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error) {
+	return contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+		URL:           fake.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// validateAddresses writes a 400 error response and returns false if any of "addresses" is
+// not a well-formed address of its type.
+func validateAddresses(res http.ResponseWriter, addresses []contextbasedrestrictionsv1.Address) bool {
+	for _, address := range addresses {
+		var valid bool
+		value := core.StringNilMapper(address.Value)
+		switch core.StringNilMapper(address.Type) {
+		case contextbasedrestrictionsv1.AddressTypeIpaddressConst:
+			valid = net.ParseIP(value) != nil
+		case contextbasedrestrictionsv1.AddressTypeIprangeConst:
+			first, last, found := strings.Cut(value, "-")
+			valid = found && net.ParseIP(first) != nil && net.ParseIP(last) != nil
+		case contextbasedrestrictionsv1.AddressTypeSubnetConst:
+			_, _, err := net.ParseCIDR(value)
+			valid = err == nil
+		case contextbasedrestrictionsv1.AddressTypeVPCConst:
+			valid = value != ""
+		case contextbasedrestrictionsv1.AddressTypeServicerefConst:
+			valid = address.Ref != nil && address.Ref.AccountID != nil
+		}
+		if !valid {
+			writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("invalid %s address: %s", core.StringNilMapper(address.Type), value))
+			return false
+		}
+	}
+	return true
+}
+
+// addressIntfs returns "addresses" as a slice of AddressIntf.
+func addressIntfs(addresses []contextbasedrestrictionsv1.Address) []contextbasedrestrictionsv1.AddressIntf {
+	result := []contextbasedrestrictionsv1.AddressIntf{}
+	for i := range addresses {
+		result = append(result, &addresses[i])
+	}
+	return result
+}
+
+// AddZone adds a copy of "zone" to the fake, filling in the ID, CRN, counts and timestamps if they
+// are not set. It returns the stored zone.
+func (fake *ContextBasedRestrictionsV1Server) AddZone(zone contextbasedrestrictionsv1.Zone) contextbasedrestrictionsv1.Zone {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	return *fake.addZone(&zone)
+}
+
+// AddRule adds a copy of "rule" to the fake, filling in the ID, CRN, enforcement mode and
+// timestamps if they are not set. It returns the stored rule.
+func (fake *ContextBasedRestrictionsV1Server) AddRule(rule contextbasedrestrictionsv1.Rule) contextbasedrestrictionsv1.Rule {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	return *fake.addRule(&rule)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) addZone(zone *contextbasedrestrictionsv1.Zone) *contextbasedrestrictionsv1.Zone {
+	if zone.ID == nil {
+		zone.ID = core.StringPtr(fake.newID())
+	}
+	if zone.AccountID == nil {
+		zone.AccountID = core.StringPtr(DefaultAccountID)
+	}
+	if zone.CRN == nil {
+		zone.CRN = core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:context-based-restrictions::a/%s::zone:%s", *zone.AccountID, *zone.ID))
+	}
+	if zone.Description == nil {
+		zone.Description = core.StringPtr("")
+	}
+	if zone.Addresses == nil {
+		zone.Addresses = []contextbasedrestrictionsv1.AddressIntf{}
+	}
+	if zone.Excluded == nil {
+		zone.Excluded = []contextbasedrestrictionsv1.AddressIntf{}
+	}
+	if zone.Href == nil {
+		zone.Href = core.StringPtr(fmt.Sprintf("%s/v1/zones/%s", fake.URL, *zone.ID))
+	}
+	if zone.CreatedAt == nil {
+		zone.CreatedAt = now()
+		zone.CreatedByID = core.StringPtr(DefaultUserID)
+		zone.LastModifiedAt = zone.CreatedAt
+		zone.LastModifiedByID = zone.CreatedByID
+	}
+	zone.AddressCount = core.Int64Ptr(int64(len(zone.Addresses)))
+	zone.ExcludedCount = core.Int64Ptr(int64(len(zone.Excluded)))
+	fake.zones = append(fake.zones, zone)
+	return zone
+}
+
+func (fake *ContextBasedRestrictionsV1Server) addRule(rule *contextbasedrestrictionsv1.Rule) *contextbasedrestrictionsv1.Rule {
+	if rule.ID == nil {
+		rule.ID = core.StringPtr(fake.newID())
+	}
+	if rule.CRN == nil {
+		accountID := ruleAttribute(rule, "accountId")
+		if accountID == "" {
+			accountID = DefaultAccountID
+		}
+		rule.CRN = core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:context-based-restrictions::a/%s::rule:%s", accountID, *rule.ID))
+	}
+	if rule.Description == nil {
+		rule.Description = core.StringPtr("")
+	}
+	if rule.Contexts == nil {
+		rule.Contexts = []contextbasedrestrictionsv1.RuleContext{}
+	}
+	if rule.EnforcementMode == nil {
+		rule.EnforcementMode = core.StringPtr(contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst)
+	}
+	if rule.Href == nil {
+		rule.Href = core.StringPtr(fmt.Sprintf("%s/v1/rules/%s", fake.URL, *rule.ID))
+	}
+	if rule.CreatedAt == nil {
+		rule.CreatedAt = now()
+		rule.CreatedByID = core.StringPtr(DefaultUserID)
+		rule.LastModifiedAt = rule.CreatedAt
+		rule.LastModifiedByID = rule.CreatedByID
+	}
+	fake.rules = append(fake.rules, rule)
+	return rule
+}
+
+func (fake *ContextBasedRestrictionsV1Server) findZone(id string) (int, *contextbasedrestrictionsv1.Zone) {
+	for i, zone := range fake.zones {
+		if *zone.ID == id {
+			return i, zone
+		}
+	}
+	return -1, nil
+}
+
+func (fake *ContextBasedRestrictionsV1Server) findRule(id string) (int, *contextbasedrestrictionsv1.Rule) {
+	for i, rule := range fake.rules {
+		if *rule.ID == id {
+			return i, rule
+		}
+	}
+	return -1, nil
+}
+
+// ruleAttribute returns the value of the named attribute of the first resource of "rule",
+// or "" if it is not set.
+func ruleAttribute(rule *contextbasedrestrictionsv1.Rule, name string) string {
+	for _, resource := range rule.Resources {
+		for _, attribute := range resource.Attributes {
+			if core.StringNilMapper(attribute.Name) == name {
+				return core.StringNilMapper(attribute.Value)
+			}
+		}
+	}
+	return ""
+}
+
+// ruleZoneIDs returns the IDs of the zones referenced by the contexts of "rule".
+func ruleZoneIDs(rule *contextbasedrestrictionsv1.Rule) (zoneIDs []string) {
+	for _, context := range rule.Contexts {
+		for _, attribute := range context.Attributes {
+			if core.StringNilMapper(attribute.Name) == "networkZoneId" {
+				zoneIDs = append(zoneIDs, strings.Split(core.StringNilMapper(attribute.Value), ",")...)
+			}
+		}
+	}
+	return
+}
+
+// validateZoneBody writes a 400 error response and returns false if "body" is not a valid zone.
+func validateZoneBody(res http.ResponseWriter, body *zoneBody) bool {
+	if body.Name == nil || *body.Name == "" || body.AccountID == nil || *body.AccountID == "" {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'name' and 'account_id' fields are required")
+		return false
+	}
+	return validateAddresses(res, body.Addresses) && validateAddresses(res, body.Excluded)
+}
+
+// validateRuleBody writes a 400 error response and returns false if "body" is not a valid rule
+// or references a zone that does not exist.
+func (fake *ContextBasedRestrictionsV1Server) validateRuleBody(res http.ResponseWriter, body *ruleBody) bool {
+	if len(body.Resources) == 0 {
+		writeError(res, http.StatusBadRequest, "bad_request", "at least one resource is required")
+		return false
+	}
+	rule := &contextbasedrestrictionsv1.Rule{Contexts: body.Contexts}
+	for _, zoneID := range ruleZoneIDs(rule) {
+		if _, zone := fake.findZone(zoneID); zone == nil {
+			writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("zone '%s' was not found", zoneID))
+			return false
+		}
+	}
+	if body.EnforcementMode != nil {
+		switch *body.EnforcementMode {
+		case contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst,
+			contextbasedrestrictionsv1.RuleEnforcementModeDisabledConst,
+			contextbasedrestrictionsv1.RuleEnforcementModeReportConst:
+		default:
+			writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("invalid enforcement_mode: %s", *body.EnforcementMode))
+			return false
+		}
+	}
+	return true
+}
+
+func (fake *ContextBasedRestrictionsV1Server) listZones(res http.ResponseWriter, req *http.Request) {
+	if req.URL.Query().Get("account_id") == "" {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'account_id' query parameter is required")
+		return
+	}
+
+	result := &contextbasedrestrictionsv1.ZoneList{
+		Zones: []contextbasedrestrictionsv1.ZoneSummary{},
+	}
+	for _, zone := range fake.zones {
+		if !queryMatches(req, "account_id", zone.AccountID) || !queryMatches(req, "name", zone.Name) {
+			continue
+		}
+		result.Zones = append(result.Zones, contextbasedrestrictionsv1.ZoneSummary{
+			ID:               zone.ID,
+			CRN:              zone.CRN,
+			Name:             zone.Name,
+			Description:      zone.Description,
+			AddressesPreview: zone.Addresses[:min(len(zone.Addresses), zonePreviewSize)],
+			AddressCount:     zone.AddressCount,
+			ExcludedCount:    zone.ExcludedCount,
+			Href:             zone.Href,
+			CreatedAt:        zone.CreatedAt,
+			CreatedByID:      zone.CreatedByID,
+			LastModifiedAt:   zone.LastModifiedAt,
+			LastModifiedByID: zone.LastModifiedByID,
+		})
+	}
+	result.Count = core.Int64Ptr(int64(len(result.Zones)))
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) createZone(res http.ResponseWriter, req *http.Request) {
+	body := &zoneBody{}
+	if !decodeBody(res, req, body) || !validateZoneBody(res, body) {
+		return
+	}
+
+	zone := fake.addZone(&contextbasedrestrictionsv1.Zone{
+		Name:        body.Name,
+		AccountID:   body.AccountID,
+		Description: body.Description,
+		Addresses:   addressIntfs(body.Addresses),
+		Excluded:    addressIntfs(body.Excluded),
+	})
+	res.Header().Set("ETag", etagOf(zone))
+	writeJSON(res, http.StatusCreated, zone)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) getZone(res http.ResponseWriter, req *http.Request) {
+	_, zone := fake.findZone(req.PathValue("zone_id"))
+	if zone == nil {
+		writeNotFound(res, "zone", req.PathValue("zone_id"))
+		return
+	}
+	res.Header().Set("ETag", etagOf(zone))
+	writeJSON(res, http.StatusOK, zone)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) replaceZone(res http.ResponseWriter, req *http.Request) {
+	_, zone := fake.findZone(req.PathValue("zone_id"))
+	if zone == nil {
+		writeNotFound(res, "zone", req.PathValue("zone_id"))
+		return
+	}
+	if !checkIfMatch(res, req, etagOf(zone), true) {
+		return
+	}
+	body := &zoneBody{}
+	if !decodeBody(res, req, body) || !validateZoneBody(res, body) {
+		return
+	}
+
+	zone.Name = body.Name
+	zone.AccountID = body.AccountID
+	zone.Description = body.Description
+	if zone.Description == nil {
+		zone.Description = core.StringPtr("")
+	}
+	zone.Addresses = addressIntfs(body.Addresses)
+	zone.Excluded = addressIntfs(body.Excluded)
+	zone.AddressCount = core.Int64Ptr(int64(len(zone.Addresses)))
+	zone.ExcludedCount = core.Int64Ptr(int64(len(zone.Excluded)))
+	zone.LastModifiedAt = now()
+	zone.LastModifiedByID = core.StringPtr(DefaultUserID)
+	res.Header().Set("ETag", etagOf(zone))
+	writeJSON(res, http.StatusOK, zone)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) deleteZone(res http.ResponseWriter, req *http.Request) {
+	zoneID := req.PathValue("zone_id")
+	i, zone := fake.findZone(zoneID)
+	if zone == nil {
+		writeNotFound(res, "zone", zoneID)
+		return
+	}
+	for _, rule := range fake.rules {
+		for _, referencedID := range ruleZoneIDs(rule) {
+			if referencedID == zoneID {
+				writeError(res, http.StatusConflict, "zone_in_use", fmt.Sprintf("zone '%s' is referenced by rule '%s'", zoneID, *rule.ID))
+				return
+			}
+		}
+	}
+
+	fake.zones = append(fake.zones[:i], fake.zones[i+1:]...)
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) listRules(res http.ResponseWriter, req *http.Request) {
+	accountID := req.URL.Query().Get("account_id")
+	if accountID == "" {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'account_id' query parameter is required")
+		return
+	}
+
+	zoneID := req.URL.Query().Get("zone_id")
+	result := &contextbasedrestrictionsv1.RuleList{
+		Rules: []contextbasedrestrictionsv1.Rule{},
+	}
+	for _, rule := range fake.rules {
+		if ruleAttribute(rule, "accountId") != accountID {
+			continue
+		}
+		serviceName := ruleAttribute(rule, "serviceName")
+		if !queryMatches(req, "service_name", &serviceName) || !queryMatches(req, "enforcement_mode", rule.EnforcementMode) {
+			continue
+		}
+		if zoneID != "" && !strings.Contains(","+strings.Join(ruleZoneIDs(rule), ",")+",", ","+zoneID+",") {
+			continue
+		}
+		result.Rules = append(result.Rules, *rule)
+	}
+	result.Count = core.Int64Ptr(int64(len(result.Rules)))
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) createRule(res http.ResponseWriter, req *http.Request) {
+	body := &ruleBody{}
+	if !decodeBody(res, req, body) || !fake.validateRuleBody(res, body) {
+		return
+	}
+
+	rule := fake.addRule(&contextbasedrestrictionsv1.Rule{
+		Description:     body.Description,
+		Contexts:        body.Contexts,
+		Resources:       body.Resources,
+		Operations:      body.Operations,
+		EnforcementMode: body.EnforcementMode,
+	})
+	res.Header().Set("ETag", etagOf(rule))
+	writeJSON(res, http.StatusCreated, rule)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) getRule(res http.ResponseWriter, req *http.Request) {
+	_, rule := fake.findRule(req.PathValue("rule_id"))
+	if rule == nil {
+		writeNotFound(res, "rule", req.PathValue("rule_id"))
+		return
+	}
+	res.Header().Set("ETag", etagOf(rule))
+	writeJSON(res, http.StatusOK, rule)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) replaceRule(res http.ResponseWriter, req *http.Request) {
+	_, rule := fake.findRule(req.PathValue("rule_id"))
+	if rule == nil {
+		writeNotFound(res, "rule", req.PathValue("rule_id"))
+		return
+	}
+	if !checkIfMatch(res, req, etagOf(rule), true) {
+		return
+	}
+	body := &ruleBody{}
+	if !decodeBody(res, req, body) || !fake.validateRuleBody(res, body) {
+		return
+	}
+
+	rule.Description = body.Description
+	if rule.Description == nil {
+		rule.Description = core.StringPtr("")
+	}
+	rule.Contexts = body.Contexts
+	if rule.Contexts == nil {
+		rule.Contexts = []contextbasedrestrictionsv1.RuleContext{}
+	}
+	rule.Resources = body.Resources
+	rule.Operations = body.Operations
+	rule.EnforcementMode = body.EnforcementMode
+	if rule.EnforcementMode == nil {
+		rule.EnforcementMode = core.StringPtr(contextbasedrestrictionsv1.RuleEnforcementModeEnabledConst)
+	}
+	rule.LastModifiedAt = now()
+	rule.LastModifiedByID = core.StringPtr(DefaultUserID)
+	res.Header().Set("ETag", etagOf(rule))
+	writeJSON(res, http.StatusOK, rule)
+}
+
+func (fake *ContextBasedRestrictionsV1Server) deleteRule(res http.ResponseWriter, req *http.Request) {
+	i, rule := fake.findRule(req.PathValue("rule_id"))
+	if rule == nil {
+		writeNotFound(res, "rule", req.PathValue("rule_id"))
+		return
+	}
+	fake.rules = append(fake.rules[:i], fake.rules[i+1:]...)
+	res.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ContextBasedRestrictionsV1Server`, func() {
+	var fake *mockservers.ContextBasedRestrictionsV1Server
+	var contextBasedRestrictionsService *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
+	accountID := "account-1"
+
+	BeforeEach(func() {
+		fake = mockservers.NewContextBasedRestrictionsV1Server()
+		var err error
+		contextBasedRestrictionsService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	ipAddress := func(value string) contextbasedrestrictionsv1.AddressIntf {
+		return &contextbasedrestrictionsv1.AddressIPAddress{
+			Type:  core.StringPtr(contextbasedrestrictionsv1.AddressIPAddressTypeIpaddressConst),
+			Value: core.StringPtr(value),
+		}
+	}
+
+	newRuleOptions := func(zoneID string) *contextbasedrestrictionsv1.CreateRuleOptions {
+		createRuleOptions := contextBasedRestrictionsService.NewCreateRuleOptions()
+		createRuleOptions.SetContexts([]contextbasedrestrictionsv1.RuleContext{
+			{
+				Attributes: []contextbasedrestrictionsv1.RuleContextAttribute{
+					{Name: core.StringPtr("networkZoneId"), Value: core.StringPtr(zoneID)},
+				},
+			},
+		})
+		createRuleOptions.SetResources([]contextbasedrestrictionsv1.Resource{
+			{
+				Attributes: []contextbasedrestrictionsv1.ResourceAttribute{
+					{Name: core.StringPtr("accountId"), Value: core.StringPtr(accountID)},
+					{Name: core.StringPtr("serviceName"), Value: core.StringPtr("iam-groups")},
+				},
+			},
+		})
+		return createRuleOptions
+	}
+
+	It(`Create, get, replace and delete a zone`, func() {
+		createZoneOptions := contextBasedRestrictionsService.NewCreateZoneOptions()
+		createZoneOptions.SetName("test-zone")
+		createZoneOptions.SetAccountID(accountID)
+		createZoneOptions.SetAddresses([]contextbasedrestrictionsv1.AddressIntf{ipAddress("169.23.56.234")})
+		zone, response, err := contextBasedRestrictionsService.CreateZone(createZoneOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*zone.AddressCount).To(Equal(int64(1)))
+		Expect(*zone.Addresses[0].(*contextbasedrestrictionsv1.AddressIPAddress).Value).To(Equal("169.23.56.234"))
+
+		zone, response, err = contextBasedRestrictionsService.GetZone(contextBasedRestrictionsService.NewGetZoneOptions(*zone.ID))
+		Expect(err).To(BeNil())
+		etag := response.GetHeaders().Get("ETag")
+
+		replaceZoneOptions := contextBasedRestrictionsService.NewReplaceZoneOptions(*zone.ID, etag)
+		replaceZoneOptions.SetName("test-zone")
+		replaceZoneOptions.SetAccountID(accountID)
+		replaceZoneOptions.SetAddresses([]contextbasedrestrictionsv1.AddressIntf{ipAddress("169.23.56.234"), ipAddress("169.23.56.235")})
+		zone, _, err = contextBasedRestrictionsService.ReplaceZone(replaceZoneOptions)
+		Expect(err).To(BeNil())
+		Expect(*zone.AddressCount).To(Equal(int64(2)))
+
+		// The ETag changed when the zone was replaced.
+		_, response, err = contextBasedRestrictionsService.ReplaceZone(replaceZoneOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(412))
+
+		zoneList, _, err := contextBasedRestrictionsService.ListZones(contextBasedRestrictionsService.NewListZonesOptions(accountID))
+		Expect(err).To(BeNil())
+		Expect(*zoneList.Count).To(Equal(int64(1)))
+		Expect(zoneList.Zones[0].AddressesPreview).To(HaveLen(2))
+
+		response, err = contextBasedRestrictionsService.DeleteZone(contextBasedRestrictionsService.NewDeleteZoneOptions(*zone.ID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+	})
+	It(`Reject malformed addresses`, func() {
+		createZoneOptions := contextBasedRestrictionsService.NewCreateZoneOptions()
+		createZoneOptions.SetName("test-zone")
+		createZoneOptions.SetAccountID(accountID)
+		createZoneOptions.SetAddresses([]contextbasedrestrictionsv1.AddressIntf{ipAddress("not-an-ip")})
+		_, response, err := contextBasedRestrictionsService.CreateZone(createZoneOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+	})
+	It(`Create, list, replace and delete a rule`, func() {
+		zone := fake.AddZone(contextbasedrestrictionsv1.Zone{
+			Name:      core.StringPtr("test-zone"),
+			AccountID: core.StringPtr(accountID),
+		})
+
+		_, response, err := contextBasedRestrictionsService.CreateRule(newRuleOptions("unknown-zone"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+
+		rule, response, err := contextBasedRestrictionsService.CreateRule(newRuleOptions(*zone.ID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*rule.EnforcementMode).To(Equal("enabled"))
+		etag := response.GetHeaders().Get("ETag")
+
+		listRulesOptions := contextBasedRestrictionsService.NewListRulesOptions(accountID)
+		listRulesOptions.SetZoneID(*zone.ID)
+		ruleList, _, err := contextBasedRestrictionsService.ListRules(listRulesOptions)
+		Expect(err).To(BeNil())
+		Expect(*ruleList.Count).To(Equal(int64(1)))
+
+		// A zone that is referenced by a rule cannot be deleted.
+		response, err = contextBasedRestrictionsService.DeleteZone(contextBasedRestrictionsService.NewDeleteZoneOptions(*zone.ID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		replaceRuleOptions := contextBasedRestrictionsService.NewReplaceRuleOptions(*rule.ID, etag)
+		replaceRuleOptions.SetContexts(rule.Contexts)
+		replaceRuleOptions.SetResources(rule.Resources)
+		replaceRuleOptions.SetEnforcementMode("report")
+		rule, _, err = contextBasedRestrictionsService.ReplaceRule(replaceRuleOptions)
+		Expect(err).To(BeNil())
+		Expect(*rule.EnforcementMode).To(Equal("report"))
+
+		response, err = contextBasedRestrictionsService.DeleteRule(contextBasedRestrictionsService.NewDeleteRuleOptions(*rule.ID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+
+		ruleList, _, err = contextBasedRestrictionsService.ListRules(listRulesOptions)
+		Expect(err).To(BeNil())
+		Expect(*ruleList.Count).To(Equal(int64(0)))
+	})
+})
@@ -0,0 +1,418 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+)
+
+// tagNamePattern matches the names accepted by the Global Tagging service.
+var tagNamePattern = regexp.MustCompile(`^[A-Za-z0-9 _.:\-]{1,128}$`)
+
+// GlobalTaggingV1Server is an in-memory fake of the Global Tagging service.
+//
+// Tags of each type ("user", "service" and "access") are kept separately. User and service
+// tags are created implicitly when they are first attached, while access tags must be created
+// with CreateTag() (or AddTag()) before they can be attached. Detaching a tag leaves it in place
+// as an unattached tag until it is deleted.
+type GlobalTaggingV1Server struct {
+	*Server
+
+	// tags maps each tag type to the tags of that type, and each tag to the IDs of the
+	// resources it is attached to.
+	tags map[string]map[string]map[string]bool
+
+	// maxResources is the maximum number of resources in an attach or detach request (0 for no
+	// limit), and resourceFailures the number of times that each resource is yet to fail.
+	maxResources     int
+	resourceFailures map[string]int
+}
+
+// NewGlobalTaggingV1Server returns a new, started GlobalTaggingV1Server.
+// The caller is responsible for invoking Close() when the server is no longer needed.
+func NewGlobalTaggingV1Server() *GlobalTaggingV1Server {
+	fake := &GlobalTaggingV1Server{
+		Server:           newServer(),
+		tags:             make(map[string]map[string]map[string]bool),
+		resourceFailures: make(map[string]int),
+	}
+	fake.handle("GET /v3/tags", fake.listTags)
+	fake.handle("POST /v3/tags", fake.createTag)
+	fake.handle("DELETE /v3/tags", fake.deleteTagAll)
+	fake.handle("DELETE /v3/tags/{tag_name}", fake.deleteTag)
+	fake.handle("POST /v3/tags/attach", fake.attachTag)
+	fake.handle("POST /v3/tags/detach", fake.detachTag)
+	fake.start()
+	return fake
+}
+
+// NewClient returns a GlobalTaggingV1 client that sends its requests to the fake.
+func (fake *GlobalTaggingV1Server) NewClient() (*globaltaggingv1.GlobalTaggingV1, error) {
+	return globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+		URL:           fake.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// AddTag adds the named tags of type "tagType" to the fake without attaching them.
+func (fake *GlobalTaggingV1Server) AddTag(tagType string, tagNames ...string) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	for _, tagName := range tagNames {
+		fake.tag(tagType, tagName)
+	}
+}
+
+// AttachTag attaches the named tags of type "tagType" to the resource identified by "resourceID",
+// adding the tags to the fake if necessary.
+func (fake *GlobalTaggingV1Server) AttachTag(tagType string, resourceID string, tagNames ...string) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	for _, tagName := range tagNames {
+		fake.tag(tagType, tagName)[resourceID] = true
+	}
+}
+
+// AttachedTags returns the sorted names of the tags of type "tagType" that are attached to the
+// resource identified by "resourceID".
+func (fake *GlobalTaggingV1Server) AttachedTags(tagType string, resourceID string) []string {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	return fake.attachedTags(tagType, resourceID)
+}
+
+// SetMaxResourcesPerRequest sets the maximum number of resources that an attach or detach
+// request may specify; requests for more resources are rejected with a 400 error. The default
+// is 0 (no limit).
+func (fake *GlobalTaggingV1Server) SetMaxResourcesPerRequest(maxResources int) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	fake.maxResources = maxResources
+}
+
+// FailResource arranges for the resource identified by "resourceID" to be reported with
+// "is_error" in the results of the next "count" attach or detach requests that specify it.
+func (fake *GlobalTaggingV1Server) FailResource(resourceID string, count int) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	fake.resourceFailures[resourceID] = count
+}
+
+// tag returns the set of resources the named tag is attached to, adding the tag if necessary.
+func (fake *GlobalTaggingV1Server) tag(tagType string, tagName string) map[string]bool {
+	if fake.tags[tagType] == nil {
+		fake.tags[tagType] = make(map[string]map[string]bool)
+	}
+	if fake.tags[tagType][tagName] == nil {
+		fake.tags[tagType][tagName] = make(map[string]bool)
+	}
+	return fake.tags[tagType][tagName]
+}
+
+func (fake *GlobalTaggingV1Server) attachedTags(tagType string, resourceID string) []string {
+	tagNames := []string{}
+	for tagName, resources := range fake.tags[tagType] {
+		if resources[resourceID] {
+			tagNames = append(tagNames, tagName)
+		}
+	}
+	sort.Strings(tagNames)
+	return tagNames
+}
+
+// queryTagType returns the value of the "tag_type" query parameter, which defaults to "user".
+// It writes a 400 error response and returns false if the value is not supported.
+func queryTagType(res http.ResponseWriter, req *http.Request) (string, bool) {
+	tagType := req.URL.Query().Get("tag_type")
+	switch tagType {
+	case "":
+		return globaltaggingv1.AttachTagOptionsTagTypeUserConst, true
+	case globaltaggingv1.AttachTagOptionsTagTypeUserConst,
+		globaltaggingv1.AttachTagOptionsTagTypeServiceConst,
+		globaltaggingv1.AttachTagOptionsTagTypeAccessConst:
+		return tagType, true
+	}
+	writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("invalid tag_type: %s", tagType))
+	return "", false
+}
+
+// validateTagName writes a 400 error response and returns false if "tagName" is not a valid
+// name for a tag of type "tagType".
+func validateTagName(res http.ResponseWriter, tagType string, tagName string) bool {
+	if !tagNamePattern.MatchString(tagName) {
+		writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("invalid tag name: %s", tagName))
+		return false
+	}
+	if tagType == globaltaggingv1.AttachTagOptionsTagTypeAccessConst && !strings.Contains(tagName, ":") {
+		writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("access tags must be in the form key:value: %s", tagName))
+		return false
+	}
+	return true
+}
+
+// requestTagNames returns the names in the "tag_name" and "tag_names" fields of an attach or detach request.
+func requestTagNames(tagName *string, tagNames []string) []string {
+	if tagName != nil {
+		return append([]string{*tagName}, tagNames...)
+	}
+	return tagNames
+}
+
+func (fake *GlobalTaggingV1Server) listTags(res http.ResponseWriter, req *http.Request) {
+	tagType, ok := queryTagType(res, req)
+	if !ok {
+		return
+	}
+	offset, ok := queryInt64(res, req, "offset", 0)
+	if !ok {
+		return
+	}
+	limit, ok := queryInt64(res, req, "limit", 100)
+	if !ok {
+		return
+	}
+
+	attachedTo := req.URL.Query().Get("attached_to")
+	attachedOnly := req.URL.Query().Get("attached_only") == "true"
+	var names []string
+	for tagName, resources := range fake.tags[tagType] {
+		if attachedTo != "" && !resources[attachedTo] {
+			continue
+		}
+		if attachedOnly && len(resources) == 0 {
+			continue
+		}
+		names = append(names, tagName)
+	}
+	sort.Strings(names)
+	if req.URL.Query().Get("order_by_name") == globaltaggingv1.ListTagsOptionsOrderByNameDescConst {
+		slices.Reverse(names)
+	}
+
+	start, end := pageBounds(len(names), offset, limit)
+	result := &globaltaggingv1.TagList{
+		TotalCount: core.Int64Ptr(int64(len(names))),
+		Offset:     core.Int64Ptr(offset),
+		Limit:      core.Int64Ptr(limit),
+		Items:      []globaltaggingv1.Tag{},
+	}
+	for _, tagName := range names[start:end] {
+		result.Items = append(result.Items, globaltaggingv1.Tag{Name: core.StringPtr(tagName)})
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (fake *GlobalTaggingV1Server) createTag(res http.ResponseWriter, req *http.Request) {
+	tagType, ok := queryTagType(res, req)
+	if !ok {
+		return
+	}
+	if tagType != globaltaggingv1.CreateTagOptionsTagTypeAccessConst {
+		writeError(res, http.StatusBadRequest, "bad_request", "only access tags can be created")
+		return
+	}
+	body := &globaltaggingv1.CreateTagOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	for _, tagName := range body.TagNames {
+		if !validateTagName(res, tagType, tagName) {
+			return
+		}
+	}
+
+	result := &globaltaggingv1.CreateTagResults{}
+	for _, tagName := range body.TagNames {
+		fake.tag(tagType, tagName)
+		result.Results = append(result.Results, globaltaggingv1.CreateTagResultsResultsItem{
+			TagName: core.StringPtr(tagName),
+			IsError: core.BoolPtr(false),
+		})
+	}
+	writeJSON(res, http.StatusCreated, result)
+}
+
+func (fake *GlobalTaggingV1Server) deleteTag(res http.ResponseWriter, req *http.Request) {
+	tagType, ok := queryTagType(res, req)
+	if !ok {
+		return
+	}
+	tagName := req.PathValue("tag_name")
+	resources, found := fake.tags[tagType][tagName]
+	if !found {
+		writeNotFound(res, "tag", tagName)
+		return
+	}
+	if len(resources) > 0 {
+		writeError(res, http.StatusBadRequest, "tag_attached", fmt.Sprintf("tag '%s' is attached to %d resources", tagName, len(resources)))
+		return
+	}
+
+	delete(fake.tags[tagType], tagName)
+	writeJSON(res, http.StatusOK, &globaltaggingv1.DeleteTagResults{
+		Results: []globaltaggingv1.DeleteTagResultsItem{
+			{
+				Provider: core.StringPtr(globaltaggingv1.DeleteTagResultsItemProviderGhostConst),
+				IsError:  core.BoolPtr(false),
+			},
+		},
+	})
+}
+
+func (fake *GlobalTaggingV1Server) deleteTagAll(res http.ResponseWriter, req *http.Request) {
+	tagType, ok := queryTagType(res, req)
+	if !ok {
+		return
+	}
+
+	var names []string
+	for tagName, resources := range fake.tags[tagType] {
+		if len(resources) == 0 {
+			names = append(names, tagName)
+		}
+	}
+	sort.Strings(names)
+
+	result := &globaltaggingv1.DeleteTagsResult{
+		TotalCount: core.Int64Ptr(int64(len(names))),
+		Errors:     core.BoolPtr(false),
+		Items:      []globaltaggingv1.DeleteTagsResultItem{},
+	}
+	for _, tagName := range names {
+		delete(fake.tags[tagType], tagName)
+		result.Items = append(result.Items, globaltaggingv1.DeleteTagsResultItem{
+			TagName: core.StringPtr(tagName),
+			IsError: core.BoolPtr(false),
+		})
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+// validateResources writes a 400 error response and returns false if an attach or detach request
+// specifies no tag names, no resources or more resources than are allowed.
+func (fake *GlobalTaggingV1Server) validateResources(res http.ResponseWriter, names []string, resources []globaltaggingv1.Resource) bool {
+	if len(names) == 0 || len(resources) == 0 {
+		writeError(res, http.StatusBadRequest, "bad_request", "at least one tag name and one resource are required")
+		return false
+	}
+	if fake.maxResources > 0 && len(resources) > fake.maxResources {
+		writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("at most %d resources may be specified", fake.maxResources))
+		return false
+	}
+	return true
+}
+
+// failResource adds an error result for "resource" and returns true if it is set to fail.
+func (fake *GlobalTaggingV1Server) failResource(result *globaltaggingv1.TagResults, resource globaltaggingv1.Resource) bool {
+	if fake.resourceFailures[*resource.ResourceID] <= 0 {
+		return false
+	}
+	fake.resourceFailures[*resource.ResourceID]--
+	result.Results = append(result.Results, globaltaggingv1.TagResultsItem{
+		ResourceID: resource.ResourceID,
+		IsError:    core.BoolPtr(true),
+	})
+	return true
+}
+
+func (fake *GlobalTaggingV1Server) attachTag(res http.ResponseWriter, req *http.Request) {
+	tagType, ok := queryTagType(res, req)
+	if !ok {
+		return
+	}
+	body := &globaltaggingv1.AttachTagOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	names := requestTagNames(body.TagName, body.TagNames)
+	if !fake.validateResources(res, names, body.Resources) {
+		return
+	}
+	for _, tagName := range names {
+		if !validateTagName(res, tagType, tagName) {
+			return
+		}
+		if _, found := fake.tags[tagType][tagName]; !found && tagType == globaltaggingv1.AttachTagOptionsTagTypeAccessConst {
+			writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("access tag '%s' must be created before it is attached", tagName))
+			return
+		}
+	}
+
+	replace := req.URL.Query().Get("replace") == "true"
+	result := &globaltaggingv1.TagResults{}
+	for _, resource := range body.Resources {
+		if fake.failResource(result, resource) {
+			continue
+		}
+		if replace {
+			for _, resources := range fake.tags[tagType] {
+				delete(resources, *resource.ResourceID)
+			}
+		}
+		for _, tagName := range names {
+			fake.tag(tagType, tagName)[*resource.ResourceID] = true
+		}
+		result.Results = append(result.Results, globaltaggingv1.TagResultsItem{
+			ResourceID: resource.ResourceID,
+			IsError:    core.BoolPtr(false),
+		})
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (fake *GlobalTaggingV1Server) detachTag(res http.ResponseWriter, req *http.Request) {
+	tagType, ok := queryTagType(res, req)
+	if !ok {
+		return
+	}
+	body := &globaltaggingv1.DetachTagOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	names := requestTagNames(body.TagName, body.TagNames)
+	if !fake.validateResources(res, names, body.Resources) {
+		return
+	}
+
+	result := &globaltaggingv1.TagResults{}
+	for _, resource := range body.Resources {
+		if fake.failResource(result, resource) {
+			continue
+		}
+		for _, tagName := range names {
+			delete(fake.tags[tagType][tagName], *resource.ResourceID)
+		}
+		result.Results = append(result.Results, globaltaggingv1.TagResultsItem{
+			ResourceID: resource.ResourceID,
+			IsError:    core.BoolPtr(false),
+		})
+	}
+	writeJSON(res, http.StatusOK, result)
+}
@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GlobalTaggingV1Server`, func() {
+	var fake *mockservers.GlobalTaggingV1Server
+	var globalTaggingService *globaltaggingv1.GlobalTaggingV1
+	resourceCRN := "crn:v1:bluemix:public:service:global:a/testAccountID:instance-1::"
+
+	BeforeEach(func() {
+		fake = mockservers.NewGlobalTaggingV1Server()
+		var err error
+		globalTaggingService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	tagNamesOf := func(tagList *globaltaggingv1.TagList) (names []string) {
+		for _, tag := range tagList.Items {
+			names = append(names, *tag.Name)
+		}
+		return
+	}
+
+	It(`Attach, list and detach user tags`, func() {
+		attachTagOptions := globalTaggingService.NewAttachTagOptions([]globaltaggingv1.Resource{
+			{ResourceID: core.StringPtr(resourceCRN)},
+		})
+		attachTagOptions.SetTagNames([]string{"env:dev", "team:blue"})
+		tagResults, _, err := globalTaggingService.AttachTag(attachTagOptions)
+		Expect(err).To(BeNil())
+		Expect(tagResults.Results).To(HaveLen(1))
+		Expect(*tagResults.Results[0].IsError).To(BeFalse())
+
+		listTagsOptions := globalTaggingService.NewListTagsOptions()
+		listTagsOptions.SetAttachedTo(resourceCRN)
+		tagList, _, err := globalTaggingService.ListTags(listTagsOptions)
+		Expect(err).To(BeNil())
+		Expect(tagNamesOf(tagList)).To(Equal([]string{"env:dev", "team:blue"}))
+
+		detachTagOptions := globalTaggingService.NewDetachTagOptions([]globaltaggingv1.Resource{
+			{ResourceID: core.StringPtr(resourceCRN)},
+		})
+		detachTagOptions.SetTagName("env:dev")
+		_, _, err = globalTaggingService.DetachTag(detachTagOptions)
+		Expect(err).To(BeNil())
+		Expect(fake.AttachedTags("user", resourceCRN)).To(Equal([]string{"team:blue"}))
+
+		// The detached tag remains until it is deleted.
+		tagList, _, err = globalTaggingService.ListTags(globalTaggingService.NewListTagsOptions())
+		Expect(err).To(BeNil())
+		Expect(*tagList.TotalCount).To(Equal(int64(2)))
+
+		deleteTagsResult, _, err := globalTaggingService.DeleteTagAll(globalTaggingService.NewDeleteTagAllOptions())
+		Expect(err).To(BeNil())
+		Expect(*deleteTagsResult.TotalCount).To(Equal(int64(1)))
+		Expect(*deleteTagsResult.Items[0].TagName).To(Equal("env:dev"))
+	})
+	It(`Replace the tags attached to a resource`, func() {
+		fake.AttachTag("user", resourceCRN, "a", "b")
+
+		attachTagOptions := globalTaggingService.NewAttachTagOptions([]globaltaggingv1.Resource{
+			{ResourceID: core.StringPtr(resourceCRN)},
+		})
+		attachTagOptions.SetTagName("c")
+		attachTagOptions.SetReplace(true)
+		_, _, err := globalTaggingService.AttachTag(attachTagOptions)
+		Expect(err).To(BeNil())
+		Expect(fake.AttachedTags("user", resourceCRN)).To(Equal([]string{"c"}))
+	})
+	It(`Require access tags to be created before they are attached`, func() {
+		attachTagOptions := globalTaggingService.NewAttachTagOptions([]globaltaggingv1.Resource{
+			{ResourceID: core.StringPtr(resourceCRN)},
+		})
+		attachTagOptions.SetTagName("project:alpha")
+		attachTagOptions.SetTagType("access")
+		_, response, err := globalTaggingService.AttachTag(attachTagOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+
+		createTagOptions := globalTaggingService.NewCreateTagOptions([]string{"project:alpha"})
+		createTagOptions.SetTagType("access")
+		createTagResults, response, err := globalTaggingService.CreateTag(createTagOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*createTagResults.Results[0].TagName).To(Equal("project:alpha"))
+
+		_, _, err = globalTaggingService.AttachTag(attachTagOptions)
+		Expect(err).To(BeNil())
+		Expect(fake.AttachedTags("access", resourceCRN)).To(Equal([]string{"project:alpha"}))
+		Expect(fake.AttachedTags("user", resourceCRN)).To(BeEmpty())
+	})
+	It(`Refuse to delete an attached tag`, func() {
+		fake.AttachTag("user", resourceCRN, "in-use")
+		fake.AddTag("user", "unused")
+
+		_, response, err := globalTaggingService.DeleteTag(globalTaggingService.NewDeleteTagOptions("in-use"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+
+		deleteTagResults, _, err := globalTaggingService.DeleteTag(globalTaggingService.NewDeleteTagOptions("unused"))
+		Expect(err).To(BeNil())
+		Expect(*deleteTagResults.Results[0].IsError).To(BeFalse())
+
+		_, response, err = globalTaggingService.DeleteTag(globalTaggingService.NewDeleteTagOptions("unused"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Paginate the list of tags`, func() {
+		fake.AddTag("user", "t1", "t2", "t3", "t4", "t5")
+
+		listTagsOptions := globalTaggingService.NewListTagsOptions()
+		listTagsOptions.SetOffset(2)
+		listTagsOptions.SetLimit(2)
+		tagList, _, err := globalTaggingService.ListTags(listTagsOptions)
+		Expect(err).To(BeNil())
+		Expect(*tagList.TotalCount).To(Equal(int64(5)))
+		Expect(tagNamesOf(tagList)).To(Equal([]string{"t3", "t4"}))
+
+		listTagsOptions.SetOffset(4)
+		listTagsOptions.SetOrderByName("desc")
+		tagList, _, err = globalTaggingService.ListTags(listTagsOptions)
+		Expect(err).To(BeNil())
+		Expect(tagNamesOf(tagList)).To(Equal([]string{"t1"}))
+	})
+})
@@ -0,0 +1,442 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+)
+
+// IamAccessGroupsV2Server is an in-memory fake of the IAM Access Groups service.
+//
+// It supports the access group operations and the operations on the members of an access group.
+// Updates require an If-Match header matching the ETag returned by GetAccessGroup(), and a group
+// that has members can only be deleted with the "force" option.
+type IamAccessGroupsV2Server struct {
+	*Server
+
+	groups  []*iamaccessgroupsv2.Group
+	members map[string][]*iamaccessgroupsv2.ListGroupMembersResponseMember
+}
+
+// NewIamAccessGroupsV2Server returns a new, started IamAccessGroupsV2Server.
+// The caller is responsible for invoking Close() when the server is no longer needed.
+func NewIamAccessGroupsV2Server() *IamAccessGroupsV2Server {
+	fake := &IamAccessGroupsV2Server{
+		Server:  newServer(),
+		members: make(map[string][]*iamaccessgroupsv2.ListGroupMembersResponseMember),
+	}
+	fake.handle("GET /v2/groups", fake.listAccessGroups)
+	fake.handle("POST /v2/groups", fake.createAccessGroup)
+	fake.handle("GET /v2/groups/{access_group_id}", fake.getAccessGroup)
+	fake.handle("PATCH /v2/groups/{access_group_id}", fake.updateAccessGroup)
+	fake.handle("DELETE /v2/groups/{access_group_id}", fake.deleteAccessGroup)
+	fake.handle("GET /v2/groups/{access_group_id}/members", fake.listAccessGroupMembers)
+	fake.handle("PUT /v2/groups/{access_group_id}/members", fake.addMembersToAccessGroup)
+	fake.handle("POST /v2/groups/{access_group_id}/members/delete", fake.removeMembersFromAccessGroup)
+	fake.handle("HEAD /v2/groups/{access_group_id}/members/{iam_id}", fake.isMemberOfAccessGroup)
+	fake.handle("DELETE /v2/groups/{access_group_id}/members/{iam_id}", fake.removeMemberFromAccessGroup)
+	fake.start()
+	return fake
+}
+
+// NewClient returns an IamAccessGroupsV2 client that sends its requests to the fake.
+func (fake *IamAccessGroupsV2Server) NewClient() (*iamaccessgroupsv2.IamAccessGroupsV2, error) {
+	return iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+		URL:           fake.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// AddAccessGroup adds a copy of "group" to the fake, filling in the ID, account ID and timestamps
+// if they are not set. It returns the stored access group.
+func (fake *IamAccessGroupsV2Server) AddAccessGroup(group iamaccessgroupsv2.Group) iamaccessgroupsv2.Group {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	return *fake.addAccessGroup(&group)
+}
+
+// AddMember adds the member identified by "iamID" (of type "memberType") to the access group
+// identified by "accessGroupID". It returns false if the access group does not exist.
+func (fake *IamAccessGroupsV2Server) AddMember(accessGroupID string, iamID string, memberType string) bool {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	if _, group := fake.findAccessGroup(accessGroupID); group == nil {
+		return false
+	}
+	fake.addMember(accessGroupID, iamID, memberType)
+	return true
+}
+
+func (fake *IamAccessGroupsV2Server) addAccessGroup(group *iamaccessgroupsv2.Group) *iamaccessgroupsv2.Group {
+	if group.ID == nil {
+		group.ID = core.StringPtr("AccessGroupId-" + fake.newID())
+	}
+	if group.AccountID == nil {
+		group.AccountID = core.StringPtr(DefaultAccountID)
+	}
+	if group.CreatedAt == nil {
+		group.CreatedAt = now()
+		group.CreatedByID = core.StringPtr(DefaultUserID)
+		group.LastModifiedAt = group.CreatedAt
+		group.LastModifiedByID = group.CreatedByID
+	}
+	if group.Href == nil {
+		group.Href = core.StringPtr(fmt.Sprintf("%s/v2/groups/%s", fake.URL, *group.ID))
+	}
+	if group.IsFederated == nil {
+		group.IsFederated = core.BoolPtr(false)
+	}
+	fake.groups = append(fake.groups, group)
+	return group
+}
+
+func (fake *IamAccessGroupsV2Server) addMember(accessGroupID string, iamID string, memberType string) *iamaccessgroupsv2.ListGroupMembersResponseMember {
+	if member := fake.findMember(accessGroupID, iamID); member != nil {
+		return member
+	}
+	member := &iamaccessgroupsv2.ListGroupMembersResponseMember{
+		IamID:          core.StringPtr(iamID),
+		Type:           core.StringPtr(memberType),
+		MembershipType: core.StringPtr("static"),
+		Href:           core.StringPtr(fmt.Sprintf("%s/v2/groups/%s/members/%s", fake.URL, accessGroupID, iamID)),
+		CreatedAt:      now(),
+		CreatedByID:    core.StringPtr(DefaultUserID),
+	}
+	fake.members[accessGroupID] = append(fake.members[accessGroupID], member)
+	return member
+}
+
+func (fake *IamAccessGroupsV2Server) findAccessGroup(id string) (int, *iamaccessgroupsv2.Group) {
+	for i, group := range fake.groups {
+		if *group.ID == id {
+			return i, group
+		}
+	}
+	return -1, nil
+}
+
+func (fake *IamAccessGroupsV2Server) findMember(accessGroupID string, iamID string) *iamaccessgroupsv2.ListGroupMembersResponseMember {
+	for _, member := range fake.members[accessGroupID] {
+		if *member.IamID == iamID {
+			return member
+		}
+	}
+	return nil
+}
+
+// removeMember removes the member identified by "iamID" from the access group, returning false
+// if it was not a member.
+func (fake *IamAccessGroupsV2Server) removeMember(accessGroupID string, iamID string) bool {
+	members := fake.members[accessGroupID]
+	for i, member := range members {
+		if *member.IamID == iamID {
+			fake.members[accessGroupID] = append(members[:i], members[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// pageLinks returns the "first", "previous", "next" and "last" links of the page of a collection
+// of "total" items that begins at "offset" and contains at most "limit" items.
+func pageLinks(req *http.Request, offset int64, limit int64, total int64) (first, previous, next, last *iamaccessgroupsv2.HrefStruct) {
+	link := func(offset int64) *iamaccessgroupsv2.HrefStruct {
+		query := req.URL.Query()
+		query.Set("offset", strconv.FormatInt(offset, 10))
+		query.Set("limit", strconv.FormatInt(limit, 10))
+		href := (&url.URL{Scheme: "http", Host: req.Host, Path: req.URL.Path, RawQuery: query.Encode()}).String()
+		return &iamaccessgroupsv2.HrefStruct{Href: core.StringPtr(href)}
+	}
+
+	first = link(0)
+	if offset > 0 {
+		previous = link(max(offset-limit, 0))
+	}
+	if limit > 0 && offset+limit < total {
+		next = link(offset + limit)
+	}
+	if limit > 0 && total > 0 {
+		last = link(((total - 1) / limit) * limit)
+	}
+	return
+}
+
+func (fake *IamAccessGroupsV2Server) listAccessGroups(res http.ResponseWriter, req *http.Request) {
+	if req.URL.Query().Get("account_id") == "" {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'account_id' query parameter is required")
+		return
+	}
+	offset, ok := queryInt64(res, req, "offset", 0)
+	if !ok {
+		return
+	}
+	limit, ok := queryInt64(res, req, "limit", 100)
+	if !ok {
+		return
+	}
+
+	search := strings.ToLower(req.URL.Query().Get("search"))
+	iamID := req.URL.Query().Get("iam_id")
+	var groups []iamaccessgroupsv2.Group
+	for _, group := range fake.groups {
+		if !queryMatches(req, "account_id", group.AccountID) {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(*group.Name), search) {
+			continue
+		}
+		if iamID != "" && fake.findMember(*group.ID, iamID) == nil {
+			continue
+		}
+		groups = append(groups, *group)
+	}
+
+	start, end := pageBounds(len(groups), offset, limit)
+	result := &iamaccessgroupsv2.GroupsList{
+		Limit:      core.Int64Ptr(limit),
+		Offset:     core.Int64Ptr(offset),
+		TotalCount: core.Int64Ptr(int64(len(groups))),
+		Groups:     groups[start:end],
+	}
+	result.First, result.Previous, result.Next, result.Last = pageLinks(req, offset, limit, int64(len(groups)))
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (fake *IamAccessGroupsV2Server) createAccessGroup(res http.ResponseWriter, req *http.Request) {
+	accountID := req.URL.Query().Get("account_id")
+	if accountID == "" {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'account_id' query parameter is required")
+		return
+	}
+	body := &iamaccessgroupsv2.CreateAccessGroupOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	if body.Name == nil || *body.Name == "" {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'name' field is required")
+		return
+	}
+	for _, group := range fake.groups {
+		if *group.AccountID == accountID && strings.EqualFold(*group.Name, *body.Name) {
+			writeError(res, http.StatusConflict, "conflict", fmt.Sprintf("an access group named '%s' already exists", *body.Name))
+			return
+		}
+	}
+
+	group := fake.addAccessGroup(&iamaccessgroupsv2.Group{
+		Name:        body.Name,
+		Description: body.Description,
+		AccountID:   core.StringPtr(accountID),
+	})
+	res.Header().Set("ETag", etagOf(group))
+	writeJSON(res, http.StatusCreated, group)
+}
+
+func (fake *IamAccessGroupsV2Server) getAccessGroup(res http.ResponseWriter, req *http.Request) {
+	_, group := fake.findAccessGroup(req.PathValue("access_group_id"))
+	if group == nil {
+		writeNotFound(res, "access group", req.PathValue("access_group_id"))
+		return
+	}
+	res.Header().Set("ETag", etagOf(group))
+	writeJSON(res, http.StatusOK, group)
+}
+
+func (fake *IamAccessGroupsV2Server) updateAccessGroup(res http.ResponseWriter, req *http.Request) {
+	_, group := fake.findAccessGroup(req.PathValue("access_group_id"))
+	if group == nil {
+		writeNotFound(res, "access group", req.PathValue("access_group_id"))
+		return
+	}
+	if !checkIfMatch(res, req, etagOf(group), true) {
+		return
+	}
+	body := &iamaccessgroupsv2.UpdateAccessGroupOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+
+	if body.Name != nil {
+		group.Name = body.Name
+	}
+	if body.Description != nil {
+		group.Description = body.Description
+	}
+	group.LastModifiedAt = now()
+	group.LastModifiedByID = core.StringPtr(DefaultUserID)
+	res.Header().Set("ETag", etagOf(group))
+	writeJSON(res, http.StatusOK, group)
+}
+
+func (fake *IamAccessGroupsV2Server) deleteAccessGroup(res http.ResponseWriter, req *http.Request) {
+	accessGroupID := req.PathValue("access_group_id")
+	i, group := fake.findAccessGroup(accessGroupID)
+	if group == nil {
+		writeNotFound(res, "access group", accessGroupID)
+		return
+	}
+	if len(fake.members[accessGroupID]) > 0 && req.URL.Query().Get("force") != "true" {
+		writeError(res, http.StatusBadRequest, "bad_request", "the access group has members; use force=true to delete it")
+		return
+	}
+
+	fake.groups = append(fake.groups[:i], fake.groups[i+1:]...)
+	delete(fake.members, accessGroupID)
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (fake *IamAccessGroupsV2Server) listAccessGroupMembers(res http.ResponseWriter, req *http.Request) {
+	accessGroupID := req.PathValue("access_group_id")
+	if _, group := fake.findAccessGroup(accessGroupID); group == nil {
+		writeNotFound(res, "access group", accessGroupID)
+		return
+	}
+	offset, ok := queryInt64(res, req, "offset", 0)
+	if !ok {
+		return
+	}
+	limit, ok := queryInt64(res, req, "limit", 50)
+	if !ok {
+		return
+	}
+
+	var members []iamaccessgroupsv2.ListGroupMembersResponseMember
+	for _, member := range fake.members[accessGroupID] {
+		if queryMatches(req, "type", member.Type) {
+			members = append(members, *member)
+		}
+	}
+
+	start, end := pageBounds(len(members), offset, limit)
+	result := &iamaccessgroupsv2.GroupMembersList{
+		Limit:      core.Int64Ptr(limit),
+		Offset:     core.Int64Ptr(offset),
+		TotalCount: core.Int64Ptr(int64(len(members))),
+		Members:    members[start:end],
+	}
+	result.First, result.Previous, result.Next, result.Last = pageLinks(req, offset, limit, int64(len(members)))
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (fake *IamAccessGroupsV2Server) addMembersToAccessGroup(res http.ResponseWriter, req *http.Request) {
+	accessGroupID := req.PathValue("access_group_id")
+	if _, group := fake.findAccessGroup(accessGroupID); group == nil {
+		writeNotFound(res, "access group", accessGroupID)
+		return
+	}
+	body := &iamaccessgroupsv2.AddMembersToAccessGroupOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+
+	result := &iamaccessgroupsv2.AddGroupMembersResponse{
+		Members: []iamaccessgroupsv2.AddGroupMembersResponseMembersItem{},
+	}
+	for _, item := range body.Members {
+		if item.IamID == nil || item.Type == nil {
+			result.Members = append(result.Members, iamaccessgroupsv2.AddGroupMembersResponseMembersItem{
+				IamID:      item.IamID,
+				StatusCode: core.Int64Ptr(http.StatusBadRequest),
+				Errors: []iamaccessgroupsv2.Error{
+					{
+						Code:    core.StringPtr("bad_request"),
+						Message: core.StringPtr("the 'iam_id' and 'type' fields are required"),
+					},
+				},
+			})
+			continue
+		}
+		member := fake.addMember(accessGroupID, *item.IamID, *item.Type)
+		result.Members = append(result.Members, iamaccessgroupsv2.AddGroupMembersResponseMembersItem{
+			IamID:       member.IamID,
+			Type:        member.Type,
+			CreatedAt:   member.CreatedAt,
+			CreatedByID: member.CreatedByID,
+			StatusCode:  core.Int64Ptr(http.StatusOK),
+		})
+	}
+	writeJSON(res, http.StatusMultiStatus, result)
+}
+
+func (fake *IamAccessGroupsV2Server) removeMembersFromAccessGroup(res http.ResponseWriter, req *http.Request) {
+	accessGroupID := req.PathValue("access_group_id")
+	if _, group := fake.findAccessGroup(accessGroupID); group == nil {
+		writeNotFound(res, "access group", accessGroupID)
+		return
+	}
+	body := &iamaccessgroupsv2.RemoveMembersFromAccessGroupOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+
+	result := &iamaccessgroupsv2.DeleteGroupBulkMembersResponse{
+		AccessGroupID: core.StringPtr(accessGroupID),
+		Members:       []iamaccessgroupsv2.DeleteGroupBulkMembersResponseMembersItem{},
+	}
+	for _, iamID := range body.Members {
+		item := iamaccessgroupsv2.DeleteGroupBulkMembersResponseMembersItem{
+			IamID:      core.StringPtr(iamID),
+			StatusCode: core.Int64Ptr(http.StatusNoContent),
+		}
+		if !fake.removeMember(accessGroupID, iamID) {
+			item.StatusCode = core.Int64Ptr(http.StatusNotFound)
+			item.Errors = []iamaccessgroupsv2.Error{
+				{
+					Code:    core.StringPtr("not_found"),
+					Message: core.StringPtr(fmt.Sprintf("'%s' is not a member of the access group", iamID)),
+				},
+			}
+		}
+		result.Members = append(result.Members, item)
+	}
+	writeJSON(res, http.StatusMultiStatus, result)
+}
+
+func (fake *IamAccessGroupsV2Server) isMemberOfAccessGroup(res http.ResponseWriter, req *http.Request) {
+	accessGroupID := req.PathValue("access_group_id")
+	if _, group := fake.findAccessGroup(accessGroupID); group == nil {
+		res.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if fake.findMember(accessGroupID, req.PathValue("iam_id")) == nil {
+		res.WriteHeader(http.StatusNotFound)
+		return
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (fake *IamAccessGroupsV2Server) removeMemberFromAccessGroup(res http.ResponseWriter, req *http.Request) {
+	accessGroupID := req.PathValue("access_group_id")
+	if _, group := fake.findAccessGroup(accessGroupID); group == nil {
+		writeNotFound(res, "access group", accessGroupID)
+		return
+	}
+	if !fake.removeMember(accessGroupID, req.PathValue("iam_id")) {
+		writeNotFound(res, "access group member", req.PathValue("iam_id"))
+		return
+	}
+	res.WriteHeader(http.StatusNoContent)
+}
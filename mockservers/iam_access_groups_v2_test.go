@@ -0,0 +1,151 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers_test
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`IamAccessGroupsV2Server`, func() {
+	var fake *mockservers.IamAccessGroupsV2Server
+	var iamAccessGroupsService *iamaccessgroupsv2.IamAccessGroupsV2
+	accountID := "account-1"
+
+	BeforeEach(func() {
+		fake = mockservers.NewIamAccessGroupsV2Server()
+		var err error
+		iamAccessGroupsService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It(`Create, get, update and delete an access group`, func() {
+		createOptions := iamAccessGroupsService.NewCreateAccessGroupOptions(accountID, "test-group")
+		createOptions.SetDescription("a test group")
+		group, response, err := iamAccessGroupsService.CreateAccessGroup(createOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*group.ID).To(HavePrefix("AccessGroupId-"))
+
+		_, response, err = iamAccessGroupsService.CreateAccessGroup(createOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(409))
+
+		group, response, err = iamAccessGroupsService.GetAccessGroup(iamAccessGroupsService.NewGetAccessGroupOptions(*group.ID))
+		Expect(err).To(BeNil())
+		etag := response.GetHeaders().Get("ETag")
+		Expect(etag).ToNot(BeEmpty())
+
+		updateOptions := iamAccessGroupsService.NewUpdateAccessGroupOptions(*group.ID, `"stale"`)
+		updateOptions.SetName("renamed-group")
+		_, response, err = iamAccessGroupsService.UpdateAccessGroup(updateOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(412))
+
+		updateOptions.SetIfMatch(etag)
+		group, response, err = iamAccessGroupsService.UpdateAccessGroup(updateOptions)
+		Expect(err).To(BeNil())
+		Expect(*group.Name).To(Equal("renamed-group"))
+		Expect(response.GetHeaders().Get("ETag")).ToNot(Equal(etag))
+
+		response, err = iamAccessGroupsService.DeleteAccessGroup(iamAccessGroupsService.NewDeleteAccessGroupOptions(*group.ID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+	})
+	It(`Manage the members of an access group`, func() {
+		group := fake.AddAccessGroup(iamaccessgroupsv2.Group{
+			Name:      core.StringPtr("test-group"),
+			AccountID: core.StringPtr(accountID),
+		})
+
+		addOptions := iamAccessGroupsService.NewAddMembersToAccessGroupOptions(*group.ID)
+		addOptions.SetMembers([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem{
+			{IamID: core.StringPtr("IBMid-1"), Type: core.StringPtr("user")},
+			{IamID: core.StringPtr("iam-ServiceId-1"), Type: core.StringPtr("service")},
+		})
+		addResult, response, err := iamAccessGroupsService.AddMembersToAccessGroup(addOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(207))
+		Expect(addResult.Members).To(HaveLen(2))
+
+		response, err = iamAccessGroupsService.IsMemberOfAccessGroup(iamAccessGroupsService.NewIsMemberOfAccessGroupOptions(*group.ID, "IBMid-1"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+
+		listOptions := iamAccessGroupsService.NewListAccessGroupMembersOptions(*group.ID)
+		listOptions.SetType("service")
+		members, _, err := iamAccessGroupsService.ListAccessGroupMembers(listOptions)
+		Expect(err).To(BeNil())
+		Expect(members.Members).To(HaveLen(1))
+		Expect(*members.Members[0].IamID).To(Equal("iam-ServiceId-1"))
+
+		// A group with members can only be deleted with the "force" option.
+		deleteOptions := iamAccessGroupsService.NewDeleteAccessGroupOptions(*group.ID)
+		response, err = iamAccessGroupsService.DeleteAccessGroup(deleteOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+
+		removeOptions := iamAccessGroupsService.NewRemoveMembersFromAccessGroupOptions(*group.ID)
+		removeOptions.SetMembers([]string{"IBMid-1", "IBMid-unknown"})
+		removeResult, _, err := iamAccessGroupsService.RemoveMembersFromAccessGroup(removeOptions)
+		Expect(err).To(BeNil())
+		Expect(*removeResult.Members[0].StatusCode).To(Equal(int64(204)))
+		Expect(*removeResult.Members[1].StatusCode).To(Equal(int64(404)))
+
+		deleteOptions.SetForce(true)
+		response, err = iamAccessGroupsService.DeleteAccessGroup(deleteOptions)
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+	})
+	It(`Paginate the list of access groups`, func() {
+		for i := 0; i < 5; i++ {
+			fake.AddAccessGroup(iamaccessgroupsv2.Group{
+				Name:      core.StringPtr(fmt.Sprintf("group-%d", i)),
+				AccountID: core.StringPtr(accountID),
+			})
+		}
+		fake.AddAccessGroup(iamaccessgroupsv2.Group{
+			Name:      core.StringPtr("other-account"),
+			AccountID: core.StringPtr("account-2"),
+		})
+
+		listOptions := iamAccessGroupsService.NewListAccessGroupsOptions(accountID)
+		listOptions.SetLimit(2)
+		pager, err := iamAccessGroupsService.NewAccessGroupsPager(listOptions)
+		Expect(err).To(BeNil())
+		groups, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(groups).To(HaveLen(5))
+		Expect(*groups[4].Name).To(Equal("group-4"))
+
+		pageCount := 0
+		for _, request := range fake.Requests() {
+			if request.Path == "/v2/groups" {
+				pageCount++
+			}
+		}
+		Expect(pageCount).To(Equal(3))
+	})
+})
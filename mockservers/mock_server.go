@@ -0,0 +1,284 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mockservers : In-memory fakes of IBM Cloud platform services for use in tests.
+//
+// Each fake is an httptest.Server that implements a subset of the operations of a service
+// using the request and response models of the corresponding SDK package. Resources created
+// through a fake are kept in memory for the life of the server, so a sequence of calls made
+// with a real SDK client observes the same state it would observe against the live service.
+package mockservers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultAccountID is the account ID assigned to resources when a request does not specify one.
+const DefaultAccountID = "testAccountID"
+
+// DefaultUserID is the user ID recorded as the creator of resources.
+const DefaultUserID = "IBMid-testUser"
+
+// Fault describes an error response to be returned in place of the normal response to
+// requests that match "Method" and "Path".
+type Fault struct {
+	// The HTTP method of the requests to fail, or "" to match any method.
+	Method string
+
+	// The URL path of the requests to fail (e.g. "/v2/resource_groups"), or "" to match any path.
+	Path string
+
+	// The status code of the error response.
+	StatusCode int
+
+	// The error message included in the response body.
+	Message string
+
+	// Headers to be included in the error response (e.g. "Retry-After").
+	Headers map[string]string
+
+	// The number of matching requests to fail. A value of 0 is treated as 1.
+	Count int
+}
+
+// Request is a summary of a request received by a fake.
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+}
+
+// Server holds the state shared by all of the fakes: the underlying httptest.Server, the
+// requests received so far and any faults waiting to be injected.
+//
+// All requests are served while holding a single lock, so handlers observe and update the
+// state of a fake atomically.
+type Server struct {
+	*httptest.Server
+
+	mux      *http.ServeMux
+	mutex    sync.Mutex
+	faults   []*Fault
+	requests []Request
+	lastID   int64
+}
+
+// newServer returns a new Server with no routes. The caller registers its routes with
+// handle() and then invokes start().
+func newServer() *Server {
+	server := &Server{
+		mux: http.NewServeMux(),
+	}
+	server.mux.HandleFunc("/", func(res http.ResponseWriter, req *http.Request) {
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("%s %s is not supported", req.Method, req.URL.Path))
+	})
+	return server
+}
+
+// start starts the underlying httptest.Server.
+func (server *Server) start() {
+	server.Server = httptest.NewServer(server.mux)
+}
+
+// handle registers "handler" for requests matching "pattern" (e.g. "GET /v2/resource_groups/{id}").
+// Each request is recorded and checked against the pending faults before "handler" is invoked.
+func (server *Server) handle(pattern string, handler http.HandlerFunc) {
+	server.mux.HandleFunc(pattern, func(res http.ResponseWriter, req *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		server.requests = append(server.requests, Request{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  req.URL.RawQuery,
+			Header: req.Header.Clone(),
+		})
+		if server.injectFault(res, req) {
+			return
+		}
+		handler(res, req)
+	})
+}
+
+// AddFault arranges for the next "fault.Count" requests matching the fault to fail.
+func (server *Server) AddFault(fault Fault) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if fault.Count <= 0 {
+		fault.Count = 1
+	}
+	server.faults = append(server.faults, &fault)
+}
+
+// Requests returns the requests received so far, in order.
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]Request(nil), server.requests...)
+}
+
+// injectFault writes an error response and returns true if "req" matches a pending fault.
+func (server *Server) injectFault(res http.ResponseWriter, req *http.Request) bool {
+	for i, fault := range server.faults {
+		if fault.Method != "" && fault.Method != req.Method {
+			continue
+		}
+		if fault.Path != "" && fault.Path != req.URL.Path {
+			continue
+		}
+
+		fault.Count--
+		if fault.Count <= 0 {
+			server.faults = append(server.faults[:i], server.faults[i+1:]...)
+		}
+		for name, value := range fault.Headers {
+			res.Header().Set(name, value)
+		}
+		message := fault.Message
+		if message == "" {
+			message = http.StatusText(fault.StatusCode)
+		}
+		writeError(res, fault.StatusCode, "injected_fault", message)
+		return true
+	}
+	return false
+}
+
+// newID returns a new identifier that is unique within the server.
+func (server *Server) newID() string {
+	server.lastID++
+	return fmt.Sprintf("%032x", server.lastID)
+}
+
+// now returns the current time in the form used by the models.
+func now() *strfmt.DateTime {
+	dateTime := strfmt.DateTime(time.Now().UTC())
+	return &dateTime
+}
+
+// writeJSON writes "result" as the JSON body of a response with the specified status code.
+func writeJSON(res http.ResponseWriter, statusCode int, result interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	_ = json.NewEncoder(res).Encode(result)
+}
+
+// writeError writes an error response in the format used by the platform services.
+func writeError(res http.ResponseWriter, statusCode int, code string, message string) {
+	writeJSON(res, statusCode, map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{
+				"code":    code,
+				"message": message,
+			},
+		},
+		"trace":       "mockservers",
+		"status_code": statusCode,
+	})
+}
+
+// writeNotFound writes a 404 error response for the resource described by "kind" and "id".
+func writeNotFound(res http.ResponseWriter, kind string, id string) {
+	writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("%s '%s' was not found", kind, id))
+}
+
+// decodeBody unmarshals the request body into "body". It writes a 400 error response and
+// returns false if the body is not valid JSON.
+func decodeBody(res http.ResponseWriter, req *http.Request, body interface{}) bool {
+	err := json.NewDecoder(req.Body).Decode(body)
+	if err != nil {
+		writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("invalid request body: %s", err.Error()))
+		return false
+	}
+	return true
+}
+
+// queryInt64 returns the value of the "name" query parameter, or "defaultValue" if it is not
+// present. It writes a 400 error response and returns false if the value is not an integer.
+func queryInt64(res http.ResponseWriter, req *http.Request, name string, defaultValue int64) (int64, bool) {
+	value := req.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue, true
+	}
+	result, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || result < 0 {
+		writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("invalid value for '%s': %s", name, value))
+		return 0, false
+	}
+	return result, true
+}
+
+// queryMatches returns true if the "name" query parameter is absent or equal to "value".
+func queryMatches(req *http.Request, name string, value *string) bool {
+	expected := req.URL.Query().Get(name)
+	if expected == "" {
+		return true
+	}
+	return value != nil && *value == expected
+}
+
+// pageBounds returns the bounds of the page of a collection of "total" items that begins
+// at "offset" and contains at most "limit" items.
+func pageBounds(total int, offset int64, limit int64) (start int, end int) {
+	start = int(min(offset, int64(total)))
+	end = int(min(int64(start)+limit, int64(total)))
+	return
+}
+
+// etagOf returns an entity tag computed from the JSON representation of "entity".
+func etagOf(entity interface{}) string {
+	data, _ := json.Marshal(entity)
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// checkIfMatch returns true if the request has no If-Match header or if the header matches
+// "etag". Otherwise it writes a 412 error response and returns false. If "required" is true, a
+// request without an If-Match header is rejected with a 428 error response.
+func checkIfMatch(res http.ResponseWriter, req *http.Request, etag string, required bool) bool {
+	ifMatch := req.Header.Get("If-Match")
+	if ifMatch == "" {
+		if required {
+			writeError(res, http.StatusPreconditionRequired, "precondition_required", "the If-Match header is required")
+			return false
+		}
+		return true
+	}
+	if ifMatch == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		if strings.TrimSpace(candidate) == etag {
+			return true
+		}
+	}
+	writeError(res, http.StatusPreconditionFailed, "precondition_failed", "the If-Match header does not match the current ETag of the resource")
+	return false
+}
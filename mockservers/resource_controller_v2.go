@@ -0,0 +1,1219 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/go-openapi/strfmt"
+)
+
+// Resource instance states used by the fake.
+const (
+	resourceInstanceStateActive             = "active"
+	resourceInstanceStateProvisioning       = "provisioning"
+	resourceInstanceStateFailed             = "failed"
+	resourceInstanceStatePendingReclamation = "pending_reclamation"
+	resourceInstanceStateRemoved            = "removed"
+)
+
+// reclamationRetention is the time after which a deleted resource instance would be reclaimed.
+const reclamationRetention = 7 * 24 * time.Hour
+
+// resourcePlan describes the service that a resource plan belongs to.
+type resourcePlan struct {
+	resourceID  string
+	serviceName string
+}
+
+// ResourceControllerV2Server is an in-memory fake of the Resource Controller service.
+//
+// It supports the operations on resource instances, keys, bindings, aliases and reclamations.
+// Deleting a resource instance moves it to the "pending_reclamation" state and creates a
+// reclamation, which can then be restored or reclaimed with RunReclamationAction(). A resource
+// instance that has keys or aliases can only be deleted with the "recursive" option, and a locked
+// resource instance can be neither updated nor deleted.
+//
+// By default resource instances become active as soon as they are created. SetProvisioningPolls()
+// makes them remain in the "provisioning" state for a number of retrievals instead, so that
+// callers that poll for the state of an instance can be exercised.
+type ResourceControllerV2Server struct {
+	*Server
+
+	plans             map[string]resourcePlan
+	instances         []*resourcecontrollerv2.ResourceInstance
+	keys              []*resourcecontrollerv2.ResourceKey
+	bindings          []*resourcecontrollerv2.ResourceBinding
+	aliases           []*resourcecontrollerv2.ResourceAlias
+	reclamations      []*resourcecontrollerv2.Reclamation
+	provisioningPolls int
+	pendingPolls      map[string]int
+	instanceTags      map[string][]string
+	redactCredentials bool
+	keepReclamations  bool
+}
+
+// NewResourceControllerV2Server returns a new, started ResourceControllerV2Server.
+// The caller is responsible for invoking Close() when the server is no longer needed.
+func NewResourceControllerV2Server() *ResourceControllerV2Server {
+	fake := &ResourceControllerV2Server{
+		Server:       newServer(),
+		plans:        make(map[string]resourcePlan),
+		pendingPolls: make(map[string]int),
+		instanceTags: make(map[string][]string),
+	}
+	fake.handle("GET /v2/resource_instances", fake.listResourceInstances)
+	fake.handle("POST /v2/resource_instances", fake.createResourceInstance)
+	fake.handle("GET /v2/resource_instances/{id}", fake.getResourceInstance)
+	fake.handle("PATCH /v2/resource_instances/{id}", fake.updateResourceInstance)
+	fake.handle("DELETE /v2/resource_instances/{id}", fake.deleteResourceInstance)
+	fake.handle("GET /v2/resource_instances/{id}/resource_aliases", fake.listResourceAliasesForInstance)
+	fake.handle("GET /v2/resource_instances/{id}/resource_keys", fake.listResourceKeysForInstance)
+	fake.handle("POST /v2/resource_instances/{id}/lock", fake.lockResourceInstance)
+	fake.handle("DELETE /v2/resource_instances/{id}/lock", fake.unlockResourceInstance)
+	fake.handle("DELETE /v2/resource_instances/{id}/last_operation", fake.cancelLastopResourceInstance)
+	fake.handle("GET /v2/resource_keys", fake.listResourceKeys)
+	fake.handle("POST /v2/resource_keys", fake.createResourceKey)
+	fake.handle("GET /v2/resource_keys/{id}", fake.getResourceKey)
+	fake.handle("PATCH /v2/resource_keys/{id}", fake.updateResourceKey)
+	fake.handle("DELETE /v2/resource_keys/{id}", fake.deleteResourceKey)
+	fake.handle("GET /v2/resource_bindings", fake.listResourceBindings)
+	fake.handle("POST /v2/resource_bindings", fake.createResourceBinding)
+	fake.handle("GET /v2/resource_bindings/{id}", fake.getResourceBinding)
+	fake.handle("PATCH /v2/resource_bindings/{id}", fake.updateResourceBinding)
+	fake.handle("DELETE /v2/resource_bindings/{id}", fake.deleteResourceBinding)
+	fake.handle("GET /v2/resource_aliases", fake.listResourceAliases)
+	fake.handle("POST /v2/resource_aliases", fake.createResourceAlias)
+	fake.handle("GET /v2/resource_aliases/{id}", fake.getResourceAlias)
+	fake.handle("PATCH /v2/resource_aliases/{id}", fake.updateResourceAlias)
+	fake.handle("DELETE /v2/resource_aliases/{id}", fake.deleteResourceAlias)
+	fake.handle("GET /v2/resource_aliases/{id}/resource_bindings", fake.listResourceBindingsForAlias)
+	fake.handle("GET /v1/reclamations", fake.listReclamations)
+	fake.handle("POST /v1/reclamations/{id}/actions/{action_name}", fake.runReclamationAction)
+	fake.start()
+	return fake
+}
+
+// NewClient returns a ResourceControllerV2 client that sends its requests to the fake.
+func (fake *ResourceControllerV2Server) NewClient() (*resourcecontrollerv2.ResourceControllerV2, error) {
+	return resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           fake.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// AddResourcePlan associates the resource plan identified by "resourcePlanID" with the catalog
+// resource identified by "resourceID" and the service named "serviceName". Resource instances
+// created with the plan are given the resource ID, and their CRNs contain the service name.
+func (fake *ResourceControllerV2Server) AddResourcePlan(resourcePlanID string, resourceID string, serviceName string) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	fake.plans[resourcePlanID] = resourcePlan{resourceID: resourceID, serviceName: serviceName}
+}
+
+// SetProvisioningPolls sets the number of times that a newly created resource instance can be
+// retrieved before it becomes active. The default is 0 (resource instances are created active).
+func (fake *ResourceControllerV2Server) SetProvisioningPolls(polls int) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	fake.provisioningPolls = polls
+}
+
+// SetRedactCredentials determines whether the credentials of resource keys and bindings are
+// returned redacted, as they are to users who lack the permission to view them.
+func (fake *ResourceControllerV2Server) SetRedactCredentials(redact bool) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	fake.redactCredentials = redact
+}
+
+// SetKeepReclamations determines whether the reclamations on which an action was run remain
+// listed, in the "RESTORING" or "RECLAIMING" state, as they do for a while with the real service.
+// Running an action on such a reclamation fails with a 409 status code. By default the
+// reclamations are removed as soon as an action is run on them.
+func (fake *ResourceControllerV2Server) SetKeepReclamations(keep bool) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	fake.keepReclamations = keep
+}
+
+// AddResourceInstance adds a copy of "instance" to the fake, filling in the identifiers, state
+// and timestamps if they are not set. It returns the stored resource instance.
+func (fake *ResourceControllerV2Server) AddResourceInstance(instance resourcecontrollerv2.ResourceInstance) resourcecontrollerv2.ResourceInstance {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	return *fake.addResourceInstance(&instance)
+}
+
+// AddResourceKey adds a copy of "key" to the fake, filling in the identifiers, state, credentials
+// and timestamps if they are not set. It returns the stored resource key.
+func (fake *ResourceControllerV2Server) AddResourceKey(key resourcecontrollerv2.ResourceKey) resourcecontrollerv2.ResourceKey {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	return *fake.addResourceKey(&key)
+}
+
+// AddResourceAlias adds a copy of "alias" to the fake, filling in the identifiers, state and
+// timestamps if they are not set. It returns the stored resource alias.
+func (fake *ResourceControllerV2Server) AddResourceAlias(alias resourcecontrollerv2.ResourceAlias) resourcecontrollerv2.ResourceAlias {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	return *fake.addResourceAlias(&alias)
+}
+
+// AddResourceBinding adds a copy of "binding" to the fake, filling in the identifiers, state,
+// credentials and timestamps if they are not set. It returns the stored resource binding.
+func (fake *ResourceControllerV2Server) AddResourceBinding(binding resourcecontrollerv2.ResourceBinding) resourcecontrollerv2.ResourceBinding {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	return *fake.addResourceBinding(&binding)
+}
+
+// SetResourceInstanceState sets the state and, if it is not nil, the last operation of the
+// resource instance identified by "id". It returns false if the resource instance does not exist.
+func (fake *ResourceControllerV2Server) SetResourceInstanceState(id string, state string, lastOperation *resourcecontrollerv2.ResourceInstanceLastOperation) bool {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	instance := fake.findResourceInstance(id)
+	if instance == nil {
+		return false
+	}
+	delete(fake.pendingPolls, *instance.ID)
+	instance.State = core.StringPtr(state)
+	if lastOperation != nil {
+		instance.LastOperation = lastOperation
+	}
+	instance.UpdatedAt = now()
+	return true
+}
+
+// ResourceInstance returns the current state of the resource instance identified by "id".
+func (fake *ResourceControllerV2Server) ResourceInstance(id string) (resourcecontrollerv2.ResourceInstance, bool) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	instance := fake.findResourceInstance(id)
+	if instance == nil {
+		return resourcecontrollerv2.ResourceInstance{}, false
+	}
+	return *instance, true
+}
+
+// ResourceInstanceTags returns the tags with which the resource instance identified by "id" was
+// created. The fake does not attach the tags; see GlobalTaggingV1Server.
+func (fake *ResourceControllerV2Server) ResourceInstanceTags(id string) []string {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	instance := fake.findResourceInstance(id)
+	if instance == nil {
+		return nil
+	}
+	return slices.Clone(fake.instanceTags[*instance.ID])
+}
+
+// lastOperation returns a last operation of type "operationType" in the specified state.
+func lastOperation(operationType string, state string, inProgress bool) *resourcecontrollerv2.ResourceInstanceLastOperation {
+	return &resourcecontrollerv2.ResourceInstanceLastOperation{
+		Type:        core.StringPtr(operationType),
+		State:       core.StringPtr(state),
+		Async:       core.BoolPtr(inProgress),
+		Description: core.StringPtr(fmt.Sprintf("%s %s", operationType, state)),
+		Cancelable:  core.BoolPtr(inProgress),
+		Poll:        core.BoolPtr(inProgress),
+	}
+}
+
+func (fake *ResourceControllerV2Server) resourceGroupCRN(accountID string, resourceGroupID string) *string {
+	return core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:resource-controller::a/%s::resource-group:%s", accountID, resourceGroupID))
+}
+
+func (fake *ResourceControllerV2Server) addResourceInstance(instance *resourcecontrollerv2.ResourceInstance) *resourcecontrollerv2.ResourceInstance {
+	guid := fake.newID()
+	if instance.GUID != nil {
+		guid = *instance.GUID
+	}
+	plan := fake.plans[core.StringNilMapper(instance.ResourcePlanID)]
+	if instance.ResourceID == nil && plan.resourceID != "" {
+		instance.ResourceID = core.StringPtr(plan.resourceID)
+	}
+	serviceName := plan.serviceName
+	if serviceName == "" {
+		serviceName = "service"
+	}
+	if instance.AccountID == nil {
+		instance.AccountID = core.StringPtr(DefaultAccountID)
+	}
+	if instance.RegionID == nil {
+		instance.RegionID = core.StringPtr("global")
+	}
+	if instance.CRN == nil {
+		instance.CRN = core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s:%s::", serviceName, *instance.RegionID, *instance.AccountID, guid))
+	}
+	if instance.ID == nil {
+		instance.ID = instance.CRN
+	}
+	instance.GUID = core.StringPtr(guid)
+	if instance.URL == nil {
+		instance.URL = core.StringPtr("/v2/resource_instances/" + guid)
+		instance.ResourceAliasesURL = core.StringPtr(*instance.URL + "/resource_aliases")
+		instance.ResourceBindingsURL = core.StringPtr(*instance.URL + "/resource_bindings")
+		instance.ResourceKeysURL = core.StringPtr(*instance.URL + "/resource_keys")
+	}
+	if instance.ResourceGroupID != nil && instance.ResourceGroupCRN == nil {
+		instance.ResourceGroupCRN = fake.resourceGroupCRN(*instance.AccountID, *instance.ResourceGroupID)
+	}
+	if instance.State == nil {
+		instance.State = core.StringPtr(resourceInstanceStateActive)
+	}
+	if instance.LastOperation == nil {
+		instance.LastOperation = lastOperation("create", "succeeded", false)
+	}
+	if instance.Type == nil {
+		instance.Type = core.StringPtr("service_instance")
+	}
+	if instance.Locked == nil {
+		instance.Locked = core.BoolPtr(false)
+	}
+	if instance.CreatedAt == nil {
+		instance.CreatedAt = now()
+		instance.CreatedBy = core.StringPtr(DefaultUserID)
+		instance.UpdatedAt = instance.CreatedAt
+		instance.UpdatedBy = instance.CreatedBy
+	}
+	if instance.PlanHistory == nil && instance.ResourcePlanID != nil {
+		instance.PlanHistory = []resourcecontrollerv2.PlanHistoryItem{
+			{
+				ResourcePlanID: instance.ResourcePlanID,
+				StartDate:      instance.CreatedAt,
+				RequestorID:    instance.CreatedBy,
+			},
+		}
+	}
+	fake.instances = append(fake.instances, instance)
+	return instance
+}
+
+func (fake *ResourceControllerV2Server) addResourceKey(key *resourcecontrollerv2.ResourceKey) *resourcecontrollerv2.ResourceKey {
+	guid := fake.newID()
+	if key.GUID != nil {
+		guid = *key.GUID
+	}
+	if key.AccountID == nil {
+		key.AccountID = core.StringPtr(DefaultAccountID)
+	}
+	if key.CRN == nil {
+		key.CRN = core.StringPtr(fmt.Sprintf("%sresource-key:%s", core.StringNilMapper(key.SourceCRN), guid))
+	}
+	if key.ID == nil {
+		key.ID = key.CRN
+	}
+	key.GUID = core.StringPtr(guid)
+	if key.URL == nil {
+		key.URL = core.StringPtr("/v2/resource_keys/" + guid)
+	}
+	if key.State == nil {
+		key.State = core.StringPtr(resourceInstanceStateActive)
+	}
+	if key.Credentials == nil {
+		key.Credentials = &resourcecontrollerv2.Credentials{
+			Apikey:          core.StringPtr("apikey-" + guid),
+			IamApikeyName:   key.Name,
+			IamRoleCRN:      core.StringPtr("crn:v1:bluemix:public:iam::::serviceRole:Writer"),
+			IamServiceidCRN: core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:iam-identity::a/%s::serviceid:ServiceId-%s", *key.AccountID, guid)),
+		}
+	}
+	if key.IamCompatible == nil {
+		key.IamCompatible = core.BoolPtr(true)
+	}
+	if key.CreatedAt == nil {
+		key.CreatedAt = now()
+		key.CreatedBy = core.StringPtr(DefaultUserID)
+		key.UpdatedAt = key.CreatedAt
+		key.UpdatedBy = key.CreatedBy
+	}
+	fake.keys = append(fake.keys, key)
+	return key
+}
+
+func (fake *ResourceControllerV2Server) addResourceAlias(alias *resourcecontrollerv2.ResourceAlias) *resourcecontrollerv2.ResourceAlias {
+	guid := fake.newID()
+	if alias.GUID != nil {
+		guid = *alias.GUID
+	}
+	if alias.AccountID == nil {
+		alias.AccountID = core.StringPtr(DefaultAccountID)
+	}
+	if alias.CRN == nil {
+		alias.CRN = core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:service:global:a/%s::resource-alias:%s", *alias.AccountID, guid))
+	}
+	if alias.ID == nil {
+		alias.ID = alias.CRN
+	}
+	alias.GUID = core.StringPtr(guid)
+	if alias.URL == nil {
+		alias.URL = core.StringPtr("/v2/resource_aliases/" + guid)
+		alias.ResourceBindingsURL = core.StringPtr(*alias.URL + "/resource_bindings")
+		alias.ResourceKeysURL = core.StringPtr(*alias.URL + "/resource_keys")
+	}
+	if alias.State == nil {
+		alias.State = core.StringPtr(resourceInstanceStateActive)
+	}
+	if alias.CreatedAt == nil {
+		alias.CreatedAt = now()
+		alias.CreatedBy = core.StringPtr(DefaultUserID)
+		alias.UpdatedAt = alias.CreatedAt
+		alias.UpdatedBy = alias.CreatedBy
+	}
+	fake.aliases = append(fake.aliases, alias)
+	return alias
+}
+
+func (fake *ResourceControllerV2Server) addResourceBinding(binding *resourcecontrollerv2.ResourceBinding) *resourcecontrollerv2.ResourceBinding {
+	guid := fake.newID()
+	if binding.GUID != nil {
+		guid = *binding.GUID
+	}
+	if binding.AccountID == nil {
+		binding.AccountID = core.StringPtr(DefaultAccountID)
+	}
+	if binding.CRN == nil {
+		binding.CRN = core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:service:global:a/%s::resource-binding:%s", *binding.AccountID, guid))
+	}
+	if binding.ID == nil {
+		binding.ID = binding.CRN
+	}
+	binding.GUID = core.StringPtr(guid)
+	if binding.URL == nil {
+		binding.URL = core.StringPtr("/v2/resource_bindings/" + guid)
+	}
+	if binding.State == nil {
+		binding.State = core.StringPtr(resourceInstanceStateActive)
+	}
+	if binding.Credentials == nil {
+		binding.Credentials = &resourcecontrollerv2.Credentials{
+			Apikey:        core.StringPtr("apikey-" + guid),
+			IamApikeyName: binding.Name,
+			IamRoleCRN:    core.StringPtr("crn:v1:bluemix:public:iam::::serviceRole:Writer"),
+		}
+	}
+	if binding.IamCompatible == nil {
+		binding.IamCompatible = core.BoolPtr(true)
+	}
+	if binding.CreatedAt == nil {
+		binding.CreatedAt = now()
+		binding.CreatedBy = core.StringPtr(DefaultUserID)
+		binding.UpdatedAt = binding.CreatedAt
+		binding.UpdatedBy = binding.CreatedBy
+	}
+	fake.bindings = append(fake.bindings, binding)
+	return binding
+}
+
+// findResourceInstance returns the resource instance whose ID, GUID or CRN is "id".
+func (fake *ResourceControllerV2Server) findResourceInstance(id string) *resourcecontrollerv2.ResourceInstance {
+	for _, instance := range fake.instances {
+		if *instance.ID == id || *instance.GUID == id || *instance.CRN == id {
+			return instance
+		}
+	}
+	return nil
+}
+
+// findResourceKey returns the index and value of the resource key whose ID, GUID or CRN is "id".
+func (fake *ResourceControllerV2Server) findResourceKey(id string) (int, *resourcecontrollerv2.ResourceKey) {
+	for i, key := range fake.keys {
+		if *key.ID == id || *key.GUID == id || *key.CRN == id {
+			return i, key
+		}
+	}
+	return -1, nil
+}
+
+// findResourceAlias returns the index and value of the resource alias whose ID, GUID or CRN is "id".
+func (fake *ResourceControllerV2Server) findResourceAlias(id string) (int, *resourcecontrollerv2.ResourceAlias) {
+	for i, alias := range fake.aliases {
+		if *alias.ID == id || *alias.GUID == id || *alias.CRN == id {
+			return i, alias
+		}
+	}
+	return -1, nil
+}
+
+// findResourceBinding returns the index and value of the resource binding whose ID, GUID or CRN is "id".
+func (fake *ResourceControllerV2Server) findResourceBinding(id string) (int, *resourcecontrollerv2.ResourceBinding) {
+	for i, binding := range fake.bindings {
+		if *binding.ID == id || *binding.GUID == id || *binding.CRN == id {
+			return i, binding
+		}
+	}
+	return -1, nil
+}
+
+// findLiveResourceInstance returns the resource instance identified by "id" if it has not been
+// deleted. Otherwise it writes a 404 error response and returns nil.
+func (fake *ResourceControllerV2Server) findLiveResourceInstance(res http.ResponseWriter, id string) *resourcecontrollerv2.ResourceInstance {
+	instance := fake.findResourceInstance(id)
+	if instance == nil || *instance.State == resourceInstanceStateRemoved || *instance.State == resourceInstanceStatePendingReclamation {
+		writeNotFound(res, "resource instance", id)
+		return nil
+	}
+	return instance
+}
+
+// pollResourceInstance advances a provisioning resource instance towards the active state.
+func (fake *ResourceControllerV2Server) pollResourceInstance(instance *resourcecontrollerv2.ResourceInstance) {
+	polls, found := fake.pendingPolls[*instance.ID]
+	if !found {
+		return
+	}
+	if polls > 1 {
+		fake.pendingPolls[*instance.ID] = polls - 1
+		return
+	}
+	delete(fake.pendingPolls, *instance.ID)
+	instance.State = core.StringPtr(resourceInstanceStateActive)
+	instance.LastOperation = lastOperation("create", "succeeded", false)
+	instance.UpdatedAt = now()
+}
+
+// withCredentials returns "credentials", or a redacted copy if credentials are to be redacted.
+func (fake *ResourceControllerV2Server) withCredentials(credentials *resourcecontrollerv2.Credentials) *resourcecontrollerv2.Credentials {
+	if !fake.redactCredentials || credentials == nil {
+		return credentials
+	}
+	return &resourcecontrollerv2.Credentials{
+		Redacted: core.StringPtr("REDACTED"),
+	}
+}
+
+func (fake *ResourceControllerV2Server) resourceKeyView(key *resourcecontrollerv2.ResourceKey) resourcecontrollerv2.ResourceKey {
+	view := *key
+	view.Credentials = fake.withCredentials(key.Credentials)
+	return view
+}
+
+func (fake *ResourceControllerV2Server) resourceBindingView(binding *resourcecontrollerv2.ResourceBinding) resourcecontrollerv2.ResourceBinding {
+	view := *binding
+	view.Credentials = fake.withCredentials(binding.Credentials)
+	return view
+}
+
+// startPage returns the page of "items" selected by the "start" and "limit" query parameters of
+// "req", along with the URL of the next page (or nil if it is the last page). The "start" token
+// is the offset of the first item of the page. It writes a 400 error response and returns false
+// if either parameter is invalid.
+func startPage[T any](res http.ResponseWriter, req *http.Request, items []T) (page []T, nextURL *string, ok bool) {
+	offset, ok := queryInt64(res, req, "start", 0)
+	if !ok {
+		return
+	}
+	limit, ok := queryInt64(res, req, "limit", 100)
+	if !ok {
+		return
+	}
+
+	start, end := pageBounds(len(items), offset, limit)
+	page = append([]T{}, items[start:end]...)
+	if end < len(items) {
+		query := req.URL.Query()
+		query.Set("start", strconv.Itoa(end))
+		query.Set("limit", strconv.FormatInt(limit, 10))
+		nextURL = core.StringPtr(req.URL.Path + "?" + query.Encode())
+	}
+	return
+}
+
+func (fake *ResourceControllerV2Server) listResourceInstances(res http.ResponseWriter, req *http.Request) {
+	stateFilter := req.URL.Query().Get("state")
+	var instances []resourcecontrollerv2.ResourceInstance
+	for _, instance := range fake.instances {
+		if stateFilter == "" && (*instance.State == resourceInstanceStateRemoved || *instance.State == resourceInstanceStatePendingReclamation) {
+			continue
+		}
+		if !queryMatches(req, "state", instance.State) ||
+			!queryMatches(req, "guid", instance.GUID) ||
+			!queryMatches(req, "name", instance.Name) ||
+			!queryMatches(req, "resource_group_id", instance.ResourceGroupID) ||
+			!queryMatches(req, "resource_id", instance.ResourceID) ||
+			!queryMatches(req, "resource_plan_id", instance.ResourcePlanID) ||
+			!queryMatches(req, "type", instance.Type) ||
+			!queryMatches(req, "sub_type", instance.SubType) {
+			continue
+		}
+		instances = append(instances, *instance)
+	}
+
+	page, nextURL, ok := startPage(res, req, instances)
+	if !ok {
+		return
+	}
+	writeJSON(res, http.StatusOK, &resourcecontrollerv2.ResourceInstancesList{
+		RowsCount: core.Int64Ptr(int64(len(page))),
+		NextURL:   nextURL,
+		Resources: page,
+	})
+}
+
+func (fake *ResourceControllerV2Server) createResourceInstance(res http.ResponseWriter, req *http.Request) {
+	body := &resourcecontrollerv2.CreateResourceInstanceOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	if body.Name == nil || body.Target == nil || body.ResourceGroup == nil || body.ResourcePlanID == nil {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'name', 'target', 'resource_group' and 'resource_plan_id' fields are required")
+		return
+	}
+
+	instance := fake.addResourceInstance(&resourcecontrollerv2.ResourceInstance{
+		Name:            body.Name,
+		RegionID:        body.Target,
+		ResourceGroupID: body.ResourceGroup,
+		ResourcePlanID:  body.ResourcePlanID,
+		AllowCleanup:    body.AllowCleanup,
+		Parameters:      body.Parameters,
+		Locked:          body.EntityLock,
+	})
+	if len(body.Tags) > 0 {
+		fake.instanceTags[*instance.ID] = body.Tags
+	}
+	statusCode := http.StatusCreated
+	if fake.provisioningPolls > 0 {
+		fake.pendingPolls[*instance.ID] = fake.provisioningPolls
+		instance.State = core.StringPtr(resourceInstanceStateProvisioning)
+		instance.LastOperation = lastOperation("create", "in progress", true)
+		statusCode = http.StatusAccepted
+	}
+	writeJSON(res, statusCode, instance)
+}
+
+func (fake *ResourceControllerV2Server) getResourceInstance(res http.ResponseWriter, req *http.Request) {
+	instance := fake.findResourceInstance(req.PathValue("id"))
+	if instance == nil {
+		writeNotFound(res, "resource instance", req.PathValue("id"))
+		return
+	}
+	fake.pollResourceInstance(instance)
+	res.Header().Set("ETag", etagOf(instance))
+	writeJSON(res, http.StatusOK, instance)
+}
+
+func (fake *ResourceControllerV2Server) updateResourceInstance(res http.ResponseWriter, req *http.Request) {
+	instance := fake.findLiveResourceInstance(res, req.PathValue("id"))
+	if instance == nil {
+		return
+	}
+	if *instance.Locked {
+		writeError(res, http.StatusUnprocessableEntity, "locked", "the resource instance is locked")
+		return
+	}
+	body := &resourcecontrollerv2.UpdateResourceInstanceOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+
+	if body.Name != nil {
+		instance.Name = body.Name
+	}
+	if body.Parameters != nil {
+		instance.Parameters = body.Parameters
+	}
+	if body.AllowCleanup != nil {
+		instance.AllowCleanup = body.AllowCleanup
+	}
+	instance.UpdatedAt = now()
+	instance.UpdatedBy = core.StringPtr(DefaultUserID)
+	if body.ResourcePlanID != nil && *body.ResourcePlanID != core.StringNilMapper(instance.ResourcePlanID) {
+		instance.ResourcePlanID = body.ResourcePlanID
+		instance.PlanHistory = append(instance.PlanHistory, resourcecontrollerv2.PlanHistoryItem{
+			ResourcePlanID: body.ResourcePlanID,
+			StartDate:      instance.UpdatedAt,
+			RequestorID:    instance.UpdatedBy,
+		})
+	}
+	instance.LastOperation = lastOperation("update", "succeeded", false)
+	writeJSON(res, http.StatusOK, instance)
+}
+
+func (fake *ResourceControllerV2Server) deleteResourceInstance(res http.ResponseWriter, req *http.Request) {
+	instance := fake.findLiveResourceInstance(res, req.PathValue("id"))
+	if instance == nil {
+		return
+	}
+	if *instance.Locked {
+		writeError(res, http.StatusUnprocessableEntity, "locked", "the resource instance is locked")
+		return
+	}
+	recursive := req.URL.Query().Get("recursive") == "true"
+	var keys []string
+	for _, key := range fake.keys {
+		if core.StringNilMapper(key.SourceCRN) == *instance.CRN {
+			keys = append(keys, *key.ID)
+		}
+	}
+	var aliases []string
+	for _, alias := range fake.aliases {
+		if core.StringNilMapper(alias.ResourceInstanceID) == *instance.ID {
+			aliases = append(aliases, *alias.ID)
+		}
+	}
+	if len(keys)+len(aliases) > 0 && !recursive {
+		writeError(res, http.StatusBadRequest, "bad_request", "the resource instance has resource keys or aliases; use recursive=true to delete it")
+		return
+	}
+	for _, id := range keys {
+		i, _ := fake.findResourceKey(id)
+		fake.keys = append(fake.keys[:i], fake.keys[i+1:]...)
+	}
+	for _, id := range aliases {
+		fake.removeResourceAlias(id)
+	}
+
+	delete(fake.pendingPolls, *instance.ID)
+	instance.State = core.StringPtr(resourceInstanceStatePendingReclamation)
+	instance.LastOperation = lastOperation("delete", "succeeded", false)
+	instance.DeletedAt = now()
+	instance.DeletedBy = core.StringPtr(DefaultUserID)
+	scheduledReclaimAt := strfmt.DateTime(time.Time(*instance.DeletedAt).Add(reclamationRetention))
+	instance.ScheduledReclaimAt = &scheduledReclaimAt
+	instance.ScheduledReclaimBy = instance.DeletedBy
+	fake.reclamations = append(fake.reclamations, &resourcecontrollerv2.Reclamation{
+		ID:                 core.StringPtr(fake.newID()),
+		EntityID:           instance.GUID,
+		EntityTypeID:       core.StringPtr("resource-instance"),
+		EntityCRN:          instance.CRN,
+		ResourceInstanceID: instance.GUID,
+		ResourceGroupID:    instance.ResourceGroupID,
+		AccountID:          instance.AccountID,
+		State:              core.StringPtr("SCHEDULED"),
+		TargetTime:         core.StringPtr(scheduledReclaimAt.String()),
+		CreatedAt:          instance.DeletedAt,
+		CreatedBy:          instance.DeletedBy,
+		UpdatedAt:          instance.DeletedAt,
+		UpdatedBy:          instance.DeletedBy,
+	})
+	res.WriteHeader(http.StatusAccepted)
+}
+
+func (fake *ResourceControllerV2Server) listResourceAliasesForInstance(res http.ResponseWriter, req *http.Request) {
+	instance := fake.findLiveResourceInstance(res, req.PathValue("id"))
+	if instance == nil {
+		return
+	}
+	var aliases []resourcecontrollerv2.ResourceAlias
+	for _, alias := range fake.aliases {
+		if core.StringNilMapper(alias.ResourceInstanceID) == *instance.ID {
+			aliases = append(aliases, *alias)
+		}
+	}
+
+	page, nextURL, ok := startPage(res, req, aliases)
+	if !ok {
+		return
+	}
+	writeJSON(res, http.StatusOK, &resourcecontrollerv2.ResourceAliasesList{
+		RowsCount: core.Int64Ptr(int64(len(page))),
+		NextURL:   nextURL,
+		Resources: page,
+	})
+}
+
+func (fake *ResourceControllerV2Server) listResourceKeysForInstance(res http.ResponseWriter, req *http.Request) {
+	instance := fake.findLiveResourceInstance(res, req.PathValue("id"))
+	if instance == nil {
+		return
+	}
+	var keys []resourcecontrollerv2.ResourceKey
+	for _, key := range fake.keys {
+		if core.StringNilMapper(key.SourceCRN) == *instance.CRN {
+			keys = append(keys, fake.resourceKeyView(key))
+		}
+	}
+
+	page, nextURL, ok := startPage(res, req, keys)
+	if !ok {
+		return
+	}
+	writeJSON(res, http.StatusOK, &resourcecontrollerv2.ResourceKeysList{
+		RowsCount: core.Int64Ptr(int64(len(page))),
+		NextURL:   nextURL,
+		Resources: page,
+	})
+}
+
+func (fake *ResourceControllerV2Server) lockResourceInstance(res http.ResponseWriter, req *http.Request) {
+	fake.setLocked(res, req, true)
+}
+
+func (fake *ResourceControllerV2Server) unlockResourceInstance(res http.ResponseWriter, req *http.Request) {
+	fake.setLocked(res, req, false)
+}
+
+func (fake *ResourceControllerV2Server) setLocked(res http.ResponseWriter, req *http.Request, locked bool) {
+	instance := fake.findLiveResourceInstance(res, req.PathValue("id"))
+	if instance == nil {
+		return
+	}
+	instance.Locked = core.BoolPtr(locked)
+	instance.UpdatedAt = now()
+	writeJSON(res, http.StatusOK, instance)
+}
+
+func (fake *ResourceControllerV2Server) cancelLastopResourceInstance(res http.ResponseWriter, req *http.Request) {
+	instance := fake.findLiveResourceInstance(res, req.PathValue("id"))
+	if instance == nil {
+		return
+	}
+	if instance.LastOperation == nil || instance.LastOperation.Cancelable == nil || !*instance.LastOperation.Cancelable {
+		writeError(res, http.StatusUnprocessableEntity, "not_cancelable", "the last operation of the resource instance cannot be cancelled")
+		return
+	}
+
+	delete(fake.pendingPolls, *instance.ID)
+	instance.State = core.StringPtr(resourceInstanceStateFailed)
+	instance.LastOperation = lastOperation(*instance.LastOperation.Type, "failed", false)
+	instance.LastOperation.Description = core.StringPtr("the operation was cancelled")
+	instance.UpdatedAt = now()
+	writeJSON(res, http.StatusOK, instance)
+}
+
+func (fake *ResourceControllerV2Server) listResourceKeys(res http.ResponseWriter, req *http.Request) {
+	var keys []resourcecontrollerv2.ResourceKey
+	for _, key := range fake.keys {
+		if !queryMatches(req, "guid", key.GUID) ||
+			!queryMatches(req, "name", key.Name) ||
+			!queryMatches(req, "resource_group_id", key.ResourceGroupID) ||
+			!queryMatches(req, "resource_id", key.ResourceID) {
+			continue
+		}
+		keys = append(keys, fake.resourceKeyView(key))
+	}
+
+	page, nextURL, ok := startPage(res, req, keys)
+	if !ok {
+		return
+	}
+	writeJSON(res, http.StatusOK, &resourcecontrollerv2.ResourceKeysList{
+		RowsCount: core.Int64Ptr(int64(len(page))),
+		NextURL:   nextURL,
+		Resources: page,
+	})
+}
+
+func (fake *ResourceControllerV2Server) createResourceKey(res http.ResponseWriter, req *http.Request) {
+	body := &resourcecontrollerv2.CreateResourceKeyOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	if body.Name == nil || body.Source == nil {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'name' and 'source' fields are required")
+		return
+	}
+	instance := fake.findResourceInstance(*body.Source)
+	if instance == nil || *instance.State != resourceInstanceStateActive {
+		writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("source '%s' is not an active resource instance", *body.Source))
+		return
+	}
+
+	key := fake.addResourceKey(&resourcecontrollerv2.ResourceKey{
+		Name:            body.Name,
+		SourceCRN:       instance.CRN,
+		AccountID:       instance.AccountID,
+		ResourceGroupID: instance.ResourceGroupID,
+		ResourceID:      instance.ResourceID,
+	})
+	if body.Role != nil && !strings.HasPrefix(*body.Role, "crn:") {
+		key.Credentials.IamRoleCRN = core.StringPtr("crn:v1:bluemix:public:iam::::serviceRole:" + *body.Role)
+	} else if body.Role != nil {
+		key.Credentials.IamRoleCRN = body.Role
+	}
+	writeJSON(res, http.StatusCreated, fake.resourceKeyView(key))
+}
+
+func (fake *ResourceControllerV2Server) getResourceKey(res http.ResponseWriter, req *http.Request) {
+	_, key := fake.findResourceKey(req.PathValue("id"))
+	if key == nil {
+		writeNotFound(res, "resource key", req.PathValue("id"))
+		return
+	}
+	res.Header().Set("ETag", etagOf(key))
+	writeJSON(res, http.StatusOK, fake.resourceKeyView(key))
+}
+
+func (fake *ResourceControllerV2Server) updateResourceKey(res http.ResponseWriter, req *http.Request) {
+	_, key := fake.findResourceKey(req.PathValue("id"))
+	if key == nil {
+		writeNotFound(res, "resource key", req.PathValue("id"))
+		return
+	}
+	body := &resourcecontrollerv2.UpdateResourceKeyOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	if body.Name == nil {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'name' field is required")
+		return
+	}
+
+	key.Name = body.Name
+	key.UpdatedAt = now()
+	key.UpdatedBy = core.StringPtr(DefaultUserID)
+	writeJSON(res, http.StatusOK, fake.resourceKeyView(key))
+}
+
+func (fake *ResourceControllerV2Server) deleteResourceKey(res http.ResponseWriter, req *http.Request) {
+	i, key := fake.findResourceKey(req.PathValue("id"))
+	if key == nil {
+		writeNotFound(res, "resource key", req.PathValue("id"))
+		return
+	}
+	fake.keys = append(fake.keys[:i], fake.keys[i+1:]...)
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (fake *ResourceControllerV2Server) listResourceBindings(res http.ResponseWriter, req *http.Request) {
+	var bindings []resourcecontrollerv2.ResourceBinding
+	for _, binding := range fake.bindings {
+		if !queryMatches(req, "guid", binding.GUID) ||
+			!queryMatches(req, "name", binding.Name) ||
+			!queryMatches(req, "resource_group_id", binding.ResourceGroupID) ||
+			!queryMatches(req, "resource_id", binding.ResourceID) ||
+			!queryMatches(req, "region_binding_id", binding.RegionBindingID) {
+			continue
+		}
+		bindings = append(bindings, fake.resourceBindingView(binding))
+	}
+
+	page, nextURL, ok := startPage(res, req, bindings)
+	if !ok {
+		return
+	}
+	writeJSON(res, http.StatusOK, &resourcecontrollerv2.ResourceBindingsList{
+		RowsCount: core.Int64Ptr(int64(len(page))),
+		NextURL:   nextURL,
+		Resources: page,
+	})
+}
+
+func (fake *ResourceControllerV2Server) createResourceBinding(res http.ResponseWriter, req *http.Request) {
+	body := &resourcecontrollerv2.CreateResourceBindingOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	if body.Source == nil || body.Target == nil {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'source' and 'target' fields are required")
+		return
+	}
+	_, alias := fake.findResourceAlias(*body.Source)
+	if alias == nil {
+		writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("source '%s' is not a resource alias", *body.Source))
+		return
+	}
+
+	binding := fake.addResourceBinding(&resourcecontrollerv2.ResourceBinding{
+		Name:             body.Name,
+		SourceCRN:        alias.CRN,
+		TargetCRN:        body.Target,
+		AccountID:        alias.AccountID,
+		ResourceGroupID:  alias.ResourceGroupID,
+		ResourceID:       alias.ResourceID,
+		ResourceAliasURL: alias.URL,
+	})
+	writeJSON(res, http.StatusCreated, fake.resourceBindingView(binding))
+}
+
+func (fake *ResourceControllerV2Server) getResourceBinding(res http.ResponseWriter, req *http.Request) {
+	_, binding := fake.findResourceBinding(req.PathValue("id"))
+	if binding == nil {
+		writeNotFound(res, "resource binding", req.PathValue("id"))
+		return
+	}
+	res.Header().Set("ETag", etagOf(binding))
+	writeJSON(res, http.StatusOK, fake.resourceBindingView(binding))
+}
+
+func (fake *ResourceControllerV2Server) updateResourceBinding(res http.ResponseWriter, req *http.Request) {
+	_, binding := fake.findResourceBinding(req.PathValue("id"))
+	if binding == nil {
+		writeNotFound(res, "resource binding", req.PathValue("id"))
+		return
+	}
+	body := &resourcecontrollerv2.UpdateResourceBindingOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	if body.Name == nil {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'name' field is required")
+		return
+	}
+
+	binding.Name = body.Name
+	binding.UpdatedAt = now()
+	binding.UpdatedBy = core.StringPtr(DefaultUserID)
+	writeJSON(res, http.StatusOK, fake.resourceBindingView(binding))
+}
+
+func (fake *ResourceControllerV2Server) deleteResourceBinding(res http.ResponseWriter, req *http.Request) {
+	i, binding := fake.findResourceBinding(req.PathValue("id"))
+	if binding == nil {
+		writeNotFound(res, "resource binding", req.PathValue("id"))
+		return
+	}
+	fake.bindings = append(fake.bindings[:i], fake.bindings[i+1:]...)
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (fake *ResourceControllerV2Server) listResourceAliases(res http.ResponseWriter, req *http.Request) {
+	var aliases []resourcecontrollerv2.ResourceAlias
+	for _, alias := range fake.aliases {
+		if !queryMatches(req, "guid", alias.GUID) ||
+			!queryMatches(req, "name", alias.Name) ||
+			!queryMatches(req, "resource_instance_id", alias.ResourceInstanceID) ||
+			!queryMatches(req, "region_instance_id", alias.RegionInstanceID) ||
+			!queryMatches(req, "resource_id", alias.ResourceID) ||
+			!queryMatches(req, "resource_group_id", alias.ResourceGroupID) {
+			continue
+		}
+		aliases = append(aliases, *alias)
+	}
+
+	page, nextURL, ok := startPage(res, req, aliases)
+	if !ok {
+		return
+	}
+	writeJSON(res, http.StatusOK, &resourcecontrollerv2.ResourceAliasesList{
+		RowsCount: core.Int64Ptr(int64(len(page))),
+		NextURL:   nextURL,
+		Resources: page,
+	})
+}
+
+func (fake *ResourceControllerV2Server) createResourceAlias(res http.ResponseWriter, req *http.Request) {
+	body := &resourcecontrollerv2.CreateResourceAliasOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	if body.Name == nil || body.Source == nil || body.Target == nil {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'name', 'source' and 'target' fields are required")
+		return
+	}
+	instance := fake.findResourceInstance(*body.Source)
+	if instance == nil || *instance.State != resourceInstanceStateActive {
+		writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("source '%s' is not an active resource instance", *body.Source))
+		return
+	}
+
+	alias := fake.addResourceAlias(&resourcecontrollerv2.ResourceAlias{
+		Name:                body.Name,
+		ResourceInstanceID:  instance.ID,
+		TargetCRN:           body.Target,
+		AccountID:           instance.AccountID,
+		ResourceID:          instance.ResourceID,
+		ResourceGroupID:     instance.ResourceGroupID,
+		ResourceInstanceURL: instance.URL,
+	})
+	writeJSON(res, http.StatusCreated, alias)
+}
+
+func (fake *ResourceControllerV2Server) getResourceAlias(res http.ResponseWriter, req *http.Request) {
+	_, alias := fake.findResourceAlias(req.PathValue("id"))
+	if alias == nil {
+		writeNotFound(res, "resource alias", req.PathValue("id"))
+		return
+	}
+	res.Header().Set("ETag", etagOf(alias))
+	writeJSON(res, http.StatusOK, alias)
+}
+
+func (fake *ResourceControllerV2Server) updateResourceAlias(res http.ResponseWriter, req *http.Request) {
+	_, alias := fake.findResourceAlias(req.PathValue("id"))
+	if alias == nil {
+		writeNotFound(res, "resource alias", req.PathValue("id"))
+		return
+	}
+	body := &resourcecontrollerv2.UpdateResourceAliasOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	if body.Name == nil {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'name' field is required")
+		return
+	}
+
+	alias.Name = body.Name
+	alias.UpdatedAt = now()
+	alias.UpdatedBy = core.StringPtr(DefaultUserID)
+	writeJSON(res, http.StatusOK, alias)
+}
+
+func (fake *ResourceControllerV2Server) deleteResourceAlias(res http.ResponseWriter, req *http.Request) {
+	_, alias := fake.findResourceAlias(req.PathValue("id"))
+	if alias == nil {
+		writeNotFound(res, "resource alias", req.PathValue("id"))
+		return
+	}
+	if fake.countBindings(alias) > 0 && req.URL.Query().Get("recursive") != "true" {
+		writeError(res, http.StatusBadRequest, "bad_request", "the resource alias has resource bindings; use recursive=true to delete it")
+		return
+	}
+	fake.removeResourceAlias(*alias.ID)
+	res.WriteHeader(http.StatusNoContent)
+}
+
+// countBindings returns the number of resource bindings whose source is "alias".
+func (fake *ResourceControllerV2Server) countBindings(alias *resourcecontrollerv2.ResourceAlias) (count int) {
+	for _, binding := range fake.bindings {
+		if core.StringNilMapper(binding.SourceCRN) == *alias.CRN {
+			count++
+		}
+	}
+	return
+}
+
+// removeResourceAlias removes the resource alias identified by "id" along with its bindings.
+func (fake *ResourceControllerV2Server) removeResourceAlias(id string) {
+	i, alias := fake.findResourceAlias(id)
+	if alias == nil {
+		return
+	}
+	fake.aliases = append(fake.aliases[:i], fake.aliases[i+1:]...)
+	bindings := fake.bindings[:0]
+	for _, binding := range fake.bindings {
+		if core.StringNilMapper(binding.SourceCRN) != *alias.CRN {
+			bindings = append(bindings, binding)
+		}
+	}
+	fake.bindings = bindings
+}
+
+func (fake *ResourceControllerV2Server) listResourceBindingsForAlias(res http.ResponseWriter, req *http.Request) {
+	_, alias := fake.findResourceAlias(req.PathValue("id"))
+	if alias == nil {
+		writeNotFound(res, "resource alias", req.PathValue("id"))
+		return
+	}
+	var bindings []resourcecontrollerv2.ResourceBinding
+	for _, binding := range fake.bindings {
+		if core.StringNilMapper(binding.SourceCRN) == *alias.CRN {
+			bindings = append(bindings, fake.resourceBindingView(binding))
+		}
+	}
+
+	page, nextURL, ok := startPage(res, req, bindings)
+	if !ok {
+		return
+	}
+	writeJSON(res, http.StatusOK, &resourcecontrollerv2.ResourceBindingsList{
+		RowsCount: core.Int64Ptr(int64(len(page))),
+		NextURL:   nextURL,
+		Resources: page,
+	})
+}
+
+func (fake *ResourceControllerV2Server) listReclamations(res http.ResponseWriter, req *http.Request) {
+	result := &resourcecontrollerv2.ReclamationsList{
+		Resources: []resourcecontrollerv2.Reclamation{},
+	}
+	for _, reclamation := range fake.reclamations {
+		if !queryMatches(req, "account_id", reclamation.AccountID) ||
+			!queryMatches(req, "resource_instance_id", reclamation.ResourceInstanceID) ||
+			!queryMatches(req, "resource_group_id", reclamation.ResourceGroupID) {
+			continue
+		}
+		result.Resources = append(result.Resources, *reclamation)
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (fake *ResourceControllerV2Server) runReclamationAction(res http.ResponseWriter, req *http.Request) {
+	var reclamation *resourcecontrollerv2.Reclamation
+	var index int
+	for i, candidate := range fake.reclamations {
+		if *candidate.ID == req.PathValue("id") {
+			reclamation, index = candidate, i
+		}
+	}
+	if reclamation == nil {
+		writeNotFound(res, "reclamation", req.PathValue("id"))
+		return
+	}
+	if *reclamation.State != "SCHEDULED" {
+		writeError(res, http.StatusConflict, "conflict", fmt.Sprintf("reclamation '%s' is in the %s state", *reclamation.ID, *reclamation.State))
+		return
+	}
+
+	var state, reclamationState string
+	switch req.PathValue("action_name") {
+	case "restore":
+		state, reclamationState = resourceInstanceStateActive, "RESTORING"
+	case "reclaim":
+		state, reclamationState = resourceInstanceStateRemoved, "RECLAIMING"
+	default:
+		writeError(res, http.StatusBadRequest, "bad_request", fmt.Sprintf("invalid action: %s", req.PathValue("action_name")))
+		return
+	}
+	body := &resourcecontrollerv2.RunReclamationActionOptions{}
+	if req.ContentLength != 0 && !decodeBody(res, req, body) {
+		return
+	}
+
+	if instance := fake.findResourceInstance(*reclamation.ResourceInstanceID); instance != nil {
+		instance.State = core.StringPtr(state)
+		instance.UpdatedAt = now()
+		instance.ScheduledReclaimAt = nil
+		instance.ScheduledReclaimBy = nil
+		if state == resourceInstanceStateActive {
+			instance.DeletedAt = nil
+			instance.DeletedBy = nil
+			instance.RestoredAt = instance.UpdatedAt
+			instance.RestoredBy = core.StringPtr(DefaultUserID)
+		}
+	}
+	if fake.keepReclamations {
+		reclamation.State = core.StringPtr(reclamationState)
+	} else {
+		fake.reclamations = append(fake.reclamations[:index], fake.reclamations[index+1:]...)
+	}
+
+	result := *reclamation
+	result.State = core.StringPtr(reclamationState)
+	result.UpdatedAt = now()
+	result.UpdatedBy = body.RequestBy
+	if result.UpdatedBy == nil {
+		result.UpdatedBy = core.StringPtr(DefaultUserID)
+	}
+	if fake.keepReclamations {
+		reclamation.UpdatedAt, reclamation.UpdatedBy = result.UpdatedAt, result.UpdatedBy
+	}
+	writeJSON(res, http.StatusOK, &result)
+}
@@ -0,0 +1,244 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers_test
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2Server`, func() {
+	var fake *mockservers.ResourceControllerV2Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+
+	BeforeEach(func() {
+		fake = mockservers.NewResourceControllerV2Server()
+		fake.AddResourcePlan("plan-1", "resource-1", "cloud-object-storage")
+		var err error
+		resourceControllerService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	createInstance := func(name string) *resourcecontrollerv2.ResourceInstance {
+		instance, _, err := resourceControllerService.CreateResourceInstance(
+			resourceControllerService.NewCreateResourceInstanceOptions(name, "us-south", "group-1", "plan-1"))
+		Expect(err).To(BeNil())
+		return instance
+	}
+
+	It(`Create, get, update and delete a resource instance`, func() {
+		instance := createInstance("test-instance")
+		Expect(*instance.State).To(Equal("active"))
+		Expect(*instance.ResourceID).To(Equal("resource-1"))
+		Expect(*instance.CRN).To(HavePrefix("crn:v1:bluemix:public:cloud-object-storage:us-south:a/"))
+
+		// The instance can be retrieved by ID (CRN) or GUID.
+		result, _, err := resourceControllerService.GetResourceInstance(resourceControllerService.NewGetResourceInstanceOptions(*instance.ID))
+		Expect(err).To(BeNil())
+		Expect(*result.GUID).To(Equal(*instance.GUID))
+		result, _, err = resourceControllerService.GetResourceInstance(resourceControllerService.NewGetResourceInstanceOptions(*instance.GUID))
+		Expect(err).To(BeNil())
+		Expect(*result.ID).To(Equal(*instance.ID))
+
+		updateOptions := resourceControllerService.NewUpdateResourceInstanceOptions(*instance.ID)
+		updateOptions.SetResourcePlanID("plan-2")
+		result, _, err = resourceControllerService.UpdateResourceInstance(updateOptions)
+		Expect(err).To(BeNil())
+		Expect(result.PlanHistory).To(HaveLen(2))
+
+		_, err = resourceControllerService.DeleteResourceInstance(resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID))
+		Expect(err).To(BeNil())
+
+		result, _, err = resourceControllerService.GetResourceInstance(resourceControllerService.NewGetResourceInstanceOptions(*instance.ID))
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal("pending_reclamation"))
+		Expect(result.ScheduledReclaimAt).ToNot(BeNil())
+
+		instanceList, _, err := resourceControllerService.ListResourceInstances(resourceControllerService.NewListResourceInstancesOptions())
+		Expect(err).To(BeNil())
+		Expect(instanceList.Resources).To(BeEmpty())
+	})
+	It(`Restore and reclaim deleted resource instances`, func() {
+		restored := createInstance("restored")
+		reclaimed := createInstance("reclaimed")
+		for _, instance := range []*resourcecontrollerv2.ResourceInstance{restored, reclaimed} {
+			_, err := resourceControllerService.DeleteResourceInstance(resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID))
+			Expect(err).To(BeNil())
+		}
+
+		reclamationsList, _, err := resourceControllerService.ListReclamations(resourceControllerService.NewListReclamationsOptions())
+		Expect(err).To(BeNil())
+		Expect(reclamationsList.Resources).To(HaveLen(2))
+
+		for _, reclamation := range reclamationsList.Resources {
+			actionName := "restore"
+			if *reclamation.ResourceInstanceID == *reclaimed.GUID {
+				actionName = "reclaim"
+			}
+			_, _, err := resourceControllerService.RunReclamationAction(resourceControllerService.NewRunReclamationActionOptions(*reclamation.ID, actionName))
+			Expect(err).To(BeNil())
+		}
+
+		instance, found := fake.ResourceInstance(*restored.ID)
+		Expect(found).To(BeTrue())
+		Expect(*instance.State).To(Equal("active"))
+		instance, found = fake.ResourceInstance(*reclaimed.ID)
+		Expect(found).To(BeTrue())
+		Expect(*instance.State).To(Equal("removed"))
+
+		reclamationsList, _, err = resourceControllerService.ListReclamations(resourceControllerService.NewListReclamationsOptions())
+		Expect(err).To(BeNil())
+		Expect(reclamationsList.Resources).To(BeEmpty())
+	})
+	It(`Require the recursive option to delete an instance with keys`, func() {
+		instance := createInstance("test-instance")
+		key, response, err := resourceControllerService.CreateResourceKey(resourceControllerService.NewCreateResourceKeyOptions("test-key", *instance.GUID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(*key.SourceCRN).To(Equal(*instance.CRN))
+		Expect(*key.Credentials.Apikey).ToNot(BeEmpty())
+
+		keysList, _, err := resourceControllerService.ListResourceKeysForInstance(resourceControllerService.NewListResourceKeysForInstanceOptions(*instance.ID))
+		Expect(err).To(BeNil())
+		Expect(keysList.Resources).To(HaveLen(1))
+
+		deleteOptions := resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID)
+		response, err = resourceControllerService.DeleteResourceInstance(deleteOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+
+		deleteOptions.SetRecursive(true)
+		_, err = resourceControllerService.DeleteResourceInstance(deleteOptions)
+		Expect(err).To(BeNil())
+
+		_, response, err = resourceControllerService.GetResourceKey(resourceControllerService.NewGetResourceKeyOptions(*key.ID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Redact credentials`, func() {
+		instance := createInstance("test-instance")
+		fake.SetRedactCredentials(true)
+
+		key, _, err := resourceControllerService.CreateResourceKey(resourceControllerService.NewCreateResourceKeyOptions("test-key", *instance.ID))
+		Expect(err).To(BeNil())
+		Expect(*key.Credentials.Redacted).To(Equal("REDACTED"))
+		Expect(key.Credentials.Apikey).To(BeNil())
+	})
+	It(`Manage aliases and bindings`, func() {
+		instance := createInstance("test-instance")
+		alias, _, err := resourceControllerService.CreateResourceAlias(
+			resourceControllerService.NewCreateResourceAliasOptions("test-alias", *instance.ID, "crn:v1:bluemix:public:cf:us-south:s/space-1::cf-space:space-1"))
+		Expect(err).To(BeNil())
+		Expect(*alias.ResourceInstanceID).To(Equal(*instance.ID))
+
+		binding, _, err := resourceControllerService.CreateResourceBinding(
+			resourceControllerService.NewCreateResourceBindingOptions(*alias.ID, "crn:v1:bluemix:public:cf:us-south:s/space-1::cf-application:app-1"))
+		Expect(err).To(BeNil())
+
+		bindingsList, _, err := resourceControllerService.ListResourceBindingsForAlias(resourceControllerService.NewListResourceBindingsForAliasOptions(*alias.ID))
+		Expect(err).To(BeNil())
+		Expect(bindingsList.Resources).To(HaveLen(1))
+		Expect(*bindingsList.Resources[0].GUID).To(Equal(*binding.GUID))
+
+		deleteOptions := resourceControllerService.NewDeleteResourceAliasOptions(*alias.ID)
+		response, err := resourceControllerService.DeleteResourceAlias(deleteOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+
+		deleteOptions.SetRecursive(true)
+		_, err = resourceControllerService.DeleteResourceAlias(deleteOptions)
+		Expect(err).To(BeNil())
+
+		bindingsList, _, err = resourceControllerService.ListResourceBindings(resourceControllerService.NewListResourceBindingsOptions())
+		Expect(err).To(BeNil())
+		Expect(bindingsList.Resources).To(BeEmpty())
+	})
+	It(`Lock a resource instance`, func() {
+		instance := createInstance("test-instance")
+		_, _, err := resourceControllerService.LockResourceInstance(resourceControllerService.NewLockResourceInstanceOptions(*instance.ID))
+		Expect(err).To(BeNil())
+
+		response, err := resourceControllerService.DeleteResourceInstance(resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(422))
+
+		result, _, err := resourceControllerService.UnlockResourceInstance(resourceControllerService.NewUnlockResourceInstanceOptions(*instance.ID))
+		Expect(err).To(BeNil())
+		Expect(*result.Locked).To(BeFalse())
+	})
+	It(`Provision resource instances asynchronously`, func() {
+		fake.SetProvisioningPolls(2)
+		instance, response, err := resourceControllerService.CreateResourceInstance(
+			resourceControllerService.NewCreateResourceInstanceOptions("test-instance", "us-south", "group-1", "plan-1"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		Expect(*instance.State).To(Equal("provisioning"))
+		Expect(*instance.LastOperation.State).To(Equal("in progress"))
+
+		getOptions := resourceControllerService.NewGetResourceInstanceOptions(*instance.ID)
+		result, _, err := resourceControllerService.GetResourceInstance(getOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal("provisioning"))
+		result, _, err = resourceControllerService.GetResourceInstance(getOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal("active"))
+		Expect(*result.LastOperation.State).To(Equal("succeeded"))
+	})
+	It(`Cancel the provisioning of a resource instance`, func() {
+		fake.SetProvisioningPolls(5)
+		instance := createInstance("test-instance")
+
+		result, _, err := resourceControllerService.CancelLastopResourceInstance(resourceControllerService.NewCancelLastopResourceInstanceOptions(*instance.ID))
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal("failed"))
+
+		_, response, err := resourceControllerService.CancelLastopResourceInstance(resourceControllerService.NewCancelLastopResourceInstanceOptions(*instance.ID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(422))
+	})
+	It(`Paginate the list of resource instances`, func() {
+		for i := 0; i < 5; i++ {
+			fake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+				Name:            core.StringPtr(fmt.Sprintf("instance-%d", i)),
+				ResourceGroupID: core.StringPtr("group-1"),
+			})
+		}
+		fake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+			Name:            core.StringPtr("other-group"),
+			ResourceGroupID: core.StringPtr("group-2"),
+		})
+
+		listOptions := resourceControllerService.NewListResourceInstancesOptions()
+		listOptions.SetResourceGroupID("group-1")
+		listOptions.SetLimit(2)
+		pager, err := resourceControllerService.NewResourceInstancesPager(listOptions)
+		Expect(err).To(BeNil())
+		instances, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(instances).To(HaveLen(5))
+		Expect(*instances[0].ResourceGroupCRN).To(HaveSuffix("resource-group:group-1"))
+		Expect(*instances[4].Name).To(Equal("instance-4"))
+	})
+})
@@ -0,0 +1,227 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+)
+
+// ResourceManagerV2Server is an in-memory fake of the Resource Manager service.
+//
+// It supports the resource group operations and the (read-only) quota definition operations.
+// Quota definitions are seeded with AddQuotaDefinition(). Default resource groups cannot be
+// deleted.
+type ResourceManagerV2Server struct {
+	*Server
+
+	resourceGroups   []*resourcemanagerv2.ResourceGroup
+	quotaDefinitions []*resourcemanagerv2.QuotaDefinition
+}
+
+// NewResourceManagerV2Server returns a new, started ResourceManagerV2Server.
+// The caller is responsible for invoking Close() when the server is no longer needed.
+func NewResourceManagerV2Server() *ResourceManagerV2Server {
+	fake := &ResourceManagerV2Server{
+		Server: newServer(),
+	}
+	fake.handle("GET /v2/resource_groups", fake.listResourceGroups)
+	fake.handle("POST /v2/resource_groups", fake.createResourceGroup)
+	fake.handle("GET /v2/resource_groups/{id}", fake.getResourceGroup)
+	fake.handle("PATCH /v2/resource_groups/{id}", fake.updateResourceGroup)
+	fake.handle("DELETE /v2/resource_groups/{id}", fake.deleteResourceGroup)
+	fake.handle("GET /v2/quota_definitions", fake.listQuotaDefinitions)
+	fake.handle("GET /v2/quota_definitions/{id}", fake.getQuotaDefinition)
+	fake.start()
+	return fake
+}
+
+// NewClient returns a ResourceManagerV2 client that sends its requests to the fake.
+func (fake *ResourceManagerV2Server) NewClient() (*resourcemanagerv2.ResourceManagerV2, error) {
+	return resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+		URL:           fake.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// AddResourceGroup adds a copy of "resourceGroup" to the fake, filling in the ID, CRN,
+// account ID, state and timestamps if they are not set. It returns the stored resource group.
+func (fake *ResourceManagerV2Server) AddResourceGroup(resourceGroup resourcemanagerv2.ResourceGroup) resourcemanagerv2.ResourceGroup {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	return *fake.addResourceGroup(&resourceGroup)
+}
+
+// AddQuotaDefinition adds a copy of "quotaDefinition" to the fake, filling in the ID and
+// timestamps if they are not set. It returns the stored quota definition.
+func (fake *ResourceManagerV2Server) AddQuotaDefinition(quotaDefinition resourcemanagerv2.QuotaDefinition) resourcemanagerv2.QuotaDefinition {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	if quotaDefinition.ID == nil {
+		quotaDefinition.ID = core.StringPtr(fake.newID())
+	}
+	if quotaDefinition.CreatedAt == nil {
+		quotaDefinition.CreatedAt = now()
+		quotaDefinition.UpdatedAt = quotaDefinition.CreatedAt
+	}
+	fake.quotaDefinitions = append(fake.quotaDefinitions, &quotaDefinition)
+	return quotaDefinition
+}
+
+func (fake *ResourceManagerV2Server) addResourceGroup(resourceGroup *resourcemanagerv2.ResourceGroup) *resourcemanagerv2.ResourceGroup {
+	if resourceGroup.ID == nil {
+		resourceGroup.ID = core.StringPtr(fake.newID())
+	}
+	if resourceGroup.AccountID == nil {
+		resourceGroup.AccountID = core.StringPtr(DefaultAccountID)
+	}
+	if resourceGroup.CRN == nil {
+		resourceGroup.CRN = core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:resource-controller::a/%s::resource-group:%s", *resourceGroup.AccountID, *resourceGroup.ID))
+	}
+	if resourceGroup.State == nil {
+		resourceGroup.State = core.StringPtr("ACTIVE")
+	}
+	if resourceGroup.Default == nil {
+		resourceGroup.Default = core.BoolPtr(false)
+	}
+	if resourceGroup.CreatedAt == nil {
+		resourceGroup.CreatedAt = now()
+		resourceGroup.UpdatedAt = resourceGroup.CreatedAt
+	}
+	fake.resourceGroups = append(fake.resourceGroups, resourceGroup)
+	return resourceGroup
+}
+
+func (fake *ResourceManagerV2Server) findResourceGroup(id string) (int, *resourcemanagerv2.ResourceGroup) {
+	for i, resourceGroup := range fake.resourceGroups {
+		if *resourceGroup.ID == id {
+			return i, resourceGroup
+		}
+	}
+	return -1, nil
+}
+
+func (fake *ResourceManagerV2Server) listResourceGroups(res http.ResponseWriter, req *http.Request) {
+	result := &resourcemanagerv2.ResourceGroupList{
+		Resources: []resourcemanagerv2.ResourceGroup{},
+	}
+	defaultFilter := req.URL.Query().Get("default")
+	for _, resourceGroup := range fake.resourceGroups {
+		if !queryMatches(req, "account_id", resourceGroup.AccountID) || !queryMatches(req, "name", resourceGroup.Name) {
+			continue
+		}
+		if defaultFilter != "" && fmt.Sprint(*resourceGroup.Default) != defaultFilter {
+			continue
+		}
+		result.Resources = append(result.Resources, *resourceGroup)
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (fake *ResourceManagerV2Server) createResourceGroup(res http.ResponseWriter, req *http.Request) {
+	body := &resourcemanagerv2.CreateResourceGroupOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+	if body.Name == nil || *body.Name == "" {
+		writeError(res, http.StatusBadRequest, "bad_request", "the 'name' field is required")
+		return
+	}
+
+	resourceGroup := fake.addResourceGroup(&resourcemanagerv2.ResourceGroup{
+		Name:      body.Name,
+		AccountID: body.AccountID,
+	})
+	writeJSON(res, http.StatusCreated, &resourcemanagerv2.ResCreateResourceGroup{
+		ID:  resourceGroup.ID,
+		CRN: resourceGroup.CRN,
+	})
+}
+
+func (fake *ResourceManagerV2Server) getResourceGroup(res http.ResponseWriter, req *http.Request) {
+	_, resourceGroup := fake.findResourceGroup(req.PathValue("id"))
+	if resourceGroup == nil {
+		writeNotFound(res, "resource group", req.PathValue("id"))
+		return
+	}
+	res.Header().Set("ETag", etagOf(resourceGroup))
+	writeJSON(res, http.StatusOK, resourceGroup)
+}
+
+func (fake *ResourceManagerV2Server) updateResourceGroup(res http.ResponseWriter, req *http.Request) {
+	_, resourceGroup := fake.findResourceGroup(req.PathValue("id"))
+	if resourceGroup == nil {
+		writeNotFound(res, "resource group", req.PathValue("id"))
+		return
+	}
+	if !checkIfMatch(res, req, etagOf(resourceGroup), false) {
+		return
+	}
+	body := &resourcemanagerv2.UpdateResourceGroupOptions{}
+	if !decodeBody(res, req, body) {
+		return
+	}
+
+	if body.Name != nil {
+		resourceGroup.Name = body.Name
+	}
+	if body.State != nil {
+		resourceGroup.State = body.State
+	}
+	resourceGroup.UpdatedAt = now()
+	res.Header().Set("ETag", etagOf(resourceGroup))
+	writeJSON(res, http.StatusOK, resourceGroup)
+}
+
+func (fake *ResourceManagerV2Server) deleteResourceGroup(res http.ResponseWriter, req *http.Request) {
+	i, resourceGroup := fake.findResourceGroup(req.PathValue("id"))
+	if resourceGroup == nil {
+		writeNotFound(res, "resource group", req.PathValue("id"))
+		return
+	}
+	if *resourceGroup.Default {
+		writeError(res, http.StatusBadRequest, "bad_request", "the default resource group cannot be deleted")
+		return
+	}
+	fake.resourceGroups = append(fake.resourceGroups[:i], fake.resourceGroups[i+1:]...)
+	res.WriteHeader(http.StatusNoContent)
+}
+
+func (fake *ResourceManagerV2Server) listQuotaDefinitions(res http.ResponseWriter, req *http.Request) {
+	result := &resourcemanagerv2.QuotaDefinitionList{
+		Resources: []resourcemanagerv2.QuotaDefinition{},
+	}
+	for _, quotaDefinition := range fake.quotaDefinitions {
+		result.Resources = append(result.Resources, *quotaDefinition)
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (fake *ResourceManagerV2Server) getQuotaDefinition(res http.ResponseWriter, req *http.Request) {
+	for _, quotaDefinition := range fake.quotaDefinitions {
+		if *quotaDefinition.ID == req.PathValue("id") {
+			writeJSON(res, http.StatusOK, quotaDefinition)
+			return
+		}
+	}
+	writeNotFound(res, "quota definition", req.PathValue("id"))
+}
@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockservers_test
+
+import (
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceManagerV2Server`, func() {
+	var fake *mockservers.ResourceManagerV2Server
+	var resourceManagerService *resourcemanagerv2.ResourceManagerV2
+
+	BeforeEach(func() {
+		fake = mockservers.NewResourceManagerV2Server()
+		var err error
+		resourceManagerService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It(`Create, get, list, update and delete a resource group`, func() {
+		created, response, err := resourceManagerService.CreateResourceGroup(&resourcemanagerv2.CreateResourceGroupOptions{
+			Name:      core.StringPtr("test-group"),
+			AccountID: core.StringPtr("account-1"),
+		})
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(201))
+		Expect(created.ID).ToNot(BeNil())
+		Expect(*created.CRN).To(ContainSubstring("resource-group:" + *created.ID))
+
+		resourceGroup, response, err := resourceManagerService.GetResourceGroup(resourceManagerService.NewGetResourceGroupOptions(*created.ID))
+		Expect(err).To(BeNil())
+		Expect(response.GetHeaders().Get("ETag")).ToNot(BeEmpty())
+		Expect(*resourceGroup.Name).To(Equal("test-group"))
+		Expect(*resourceGroup.AccountID).To(Equal("account-1"))
+		Expect(*resourceGroup.State).To(Equal("ACTIVE"))
+
+		updateOptions := resourceManagerService.NewUpdateResourceGroupOptions(*created.ID)
+		updateOptions.SetName("renamed-group")
+		resourceGroup, _, err = resourceManagerService.UpdateResourceGroup(updateOptions)
+		Expect(err).To(BeNil())
+		Expect(*resourceGroup.Name).To(Equal("renamed-group"))
+
+		listOptions := resourceManagerService.NewListResourceGroupsOptions()
+		listOptions.SetAccountID("account-1")
+		resourceGroupList, _, err := resourceManagerService.ListResourceGroups(listOptions)
+		Expect(err).To(BeNil())
+		Expect(resourceGroupList.Resources).To(HaveLen(1))
+		Expect(*resourceGroupList.Resources[0].Name).To(Equal("renamed-group"))
+
+		listOptions.SetAccountID("account-2")
+		resourceGroupList, _, err = resourceManagerService.ListResourceGroups(listOptions)
+		Expect(err).To(BeNil())
+		Expect(resourceGroupList.Resources).To(BeEmpty())
+
+		response, err = resourceManagerService.DeleteResourceGroup(resourceManagerService.NewDeleteResourceGroupOptions(*created.ID))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(204))
+
+		_, response, err = resourceManagerService.GetResourceGroup(resourceManagerService.NewGetResourceGroupOptions(*created.ID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Refuse to delete the default resource group`, func() {
+		resourceGroup := fake.AddResourceGroup(resourcemanagerv2.ResourceGroup{
+			Name:    core.StringPtr("Default"),
+			Default: core.BoolPtr(true),
+		})
+
+		response, err := resourceManagerService.DeleteResourceGroup(resourceManagerService.NewDeleteResourceGroupOptions(*resourceGroup.ID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(400))
+	})
+	It(`Return seeded quota definitions`, func() {
+		quotaDefinition := fake.AddQuotaDefinition(resourcemanagerv2.QuotaDefinition{
+			Name:                     core.StringPtr("Trial Quota"),
+			NumberOfServiceInstances: core.Float64Ptr(10),
+		})
+
+		quotaDefinitionList, _, err := resourceManagerService.ListQuotaDefinitions(resourceManagerService.NewListQuotaDefinitionsOptions())
+		Expect(err).To(BeNil())
+		Expect(quotaDefinitionList.Resources).To(HaveLen(1))
+
+		result, _, err := resourceManagerService.GetQuotaDefinition(resourceManagerService.NewGetQuotaDefinitionOptions(*quotaDefinition.ID))
+		Expect(err).To(BeNil())
+		Expect(*result.Name).To(Equal("Trial Quota"))
+		Expect(*result.NumberOfServiceInstances).To(Equal(float64(10)))
+	})
+	It(`Inject faults and record requests`, func() {
+		fake.AddFault(mockservers.Fault{
+			Method:     http.MethodGet,
+			Path:       "/v2/resource_groups",
+			StatusCode: 503,
+			Message:    "try again later",
+			Headers:    map[string]string{"Retry-After": "1"},
+		})
+
+		_, response, err := resourceManagerService.ListResourceGroups(resourceManagerService.NewListResourceGroupsOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("try again later"))
+		Expect(response.StatusCode).To(Equal(503))
+		Expect(response.GetHeaders().Get("Retry-After")).To(Equal("1"))
+
+		_, response, err = resourceManagerService.ListResourceGroups(resourceManagerService.NewListResourceGroupsOptions())
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+
+		requests := fake.Requests()
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1].Method).To(Equal(http.MethodGet))
+		Expect(requests[1].Path).To(Equal("/v2/resource_groups"))
+	})
+})
@@ -0,0 +1,61 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 99-SNAPSHOT-d753183b-20201209-163011
+ */
+
+package openservicebrokerv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// OpenServiceBrokerV1API : The operations supported by the OpenServiceBrokerV1 service. This interface is satisfied by
+// *OpenServiceBrokerV1 and may be used to substitute a fake or a decorator for the service client.
+type OpenServiceBrokerV1API interface {
+	// GetServiceInstanceStateWithContext : Get the current state of the service instance
+	GetServiceInstanceStateWithContext(ctx context.Context, getServiceInstanceStateOptions *GetServiceInstanceStateOptions) (result *Resp1874644Root, response *core.DetailedResponse, err error)
+
+	// ReplaceServiceInstanceStateWithContext : Update the state of a provisioned service instance
+	ReplaceServiceInstanceStateWithContext(ctx context.Context, replaceServiceInstanceStateOptions *ReplaceServiceInstanceStateOptions) (result *Resp2448145Root, response *core.DetailedResponse, err error)
+
+	// ReplaceServiceInstanceWithContext : Create (provision) a service instance
+	ReplaceServiceInstanceWithContext(ctx context.Context, replaceServiceInstanceOptions *ReplaceServiceInstanceOptions) (result *Resp2079872Root, response *core.DetailedResponse, err error)
+
+	// UpdateServiceInstanceWithContext : Update a service instance
+	UpdateServiceInstanceWithContext(ctx context.Context, updateServiceInstanceOptions *UpdateServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+
+	// DeleteServiceInstanceWithContext : Delete (deprovision) a service instance
+	DeleteServiceInstanceWithContext(ctx context.Context, deleteServiceInstanceOptions *DeleteServiceInstanceOptions) (result *Resp2079874Root, response *core.DetailedResponse, err error)
+
+	// ListCatalogWithContext : Get the catalog metadata stored within the broker
+	ListCatalogWithContext(ctx context.Context, listCatalogOptions *ListCatalogOptions) (result *Resp1874650Root, response *core.DetailedResponse, err error)
+
+	// GetLastOperationWithContext : Get the current status of a provision in-progress for a service instance
+	GetLastOperationWithContext(ctx context.Context, getLastOperationOptions *GetLastOperationOptions) (result *Resp2079894Root, response *core.DetailedResponse, err error)
+
+	// ReplaceServiceBindingWithContext : Bind a service instance to another resource
+	ReplaceServiceBindingWithContext(ctx context.Context, replaceServiceBindingOptions *ReplaceServiceBindingOptions) (result *Resp2079876Root, response *core.DetailedResponse, err error)
+
+	// DeleteServiceBindingWithContext : Delete (unbind) the credentials bound to a resource
+	DeleteServiceBindingWithContext(ctx context.Context, deleteServiceBindingOptions *DeleteServiceBindingOptions) (response *core.DetailedResponse, err error)
+}
+
+// Verify that OpenServiceBrokerV1 implements the OpenServiceBrokerV1API interface.
+var _ OpenServiceBrokerV1API = (*OpenServiceBrokerV1)(nil)
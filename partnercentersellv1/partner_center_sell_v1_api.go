@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.95.2-120e65bc-20240924-152329
+ */
+
+package partnercentersellv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PartnerCenterSellV1API : The operations supported by the PartnerCenterSellV1 service. This interface is satisfied by
+// *PartnerCenterSellV1 and may be used to substitute a fake or a decorator for the service client.
+type PartnerCenterSellV1API interface {
+	// CreateRegistrationWithContext : Register your account in Partner Center - Sell
+	CreateRegistrationWithContext(ctx context.Context, createRegistrationOptions *CreateRegistrationOptions) (result *Registration, response *core.DetailedResponse, err error)
+
+	// GetRegistrationWithContext : Retrieve a Partner Center - Sell registration
+	GetRegistrationWithContext(ctx context.Context, getRegistrationOptions *GetRegistrationOptions) (result *Registration, response *core.DetailedResponse, err error)
+
+	// UpdateRegistrationWithContext : Update a Partner Center - Sell registration
+	UpdateRegistrationWithContext(ctx context.Context, updateRegistrationOptions *UpdateRegistrationOptions) (result *Registration, response *core.DetailedResponse, err error)
+
+	// DeleteRegistrationWithContext : Delete your registration in Partner - Center Sell
+	DeleteRegistrationWithContext(ctx context.Context, deleteRegistrationOptions *DeleteRegistrationOptions) (response *core.DetailedResponse, err error)
+
+	// CreateOnboardingProductWithContext : Create a product to onboard
+	CreateOnboardingProductWithContext(ctx context.Context, createOnboardingProductOptions *CreateOnboardingProductOptions) (result *OnboardingProduct, response *core.DetailedResponse, err error)
+
+	// GetOnboardingProductWithContext : Get an onboarding product
+	GetOnboardingProductWithContext(ctx context.Context, getOnboardingProductOptions *GetOnboardingProductOptions) (result *OnboardingProduct, response *core.DetailedResponse, err error)
+
+	// UpdateOnboardingProductWithContext : Update an onboarding product
+	UpdateOnboardingProductWithContext(ctx context.Context, updateOnboardingProductOptions *UpdateOnboardingProductOptions) (result *OnboardingProduct, response *core.DetailedResponse, err error)
+
+	// DeleteOnboardingProductWithContext : Delete an onboarding product
+	DeleteOnboardingProductWithContext(ctx context.Context, deleteOnboardingProductOptions *DeleteOnboardingProductOptions) (response *core.DetailedResponse, err error)
+
+	// CreateCatalogProductWithContext : Create a global catalog product
+	CreateCatalogProductWithContext(ctx context.Context, createCatalogProductOptions *CreateCatalogProductOptions) (result *GlobalCatalogProduct, response *core.DetailedResponse, err error)
+
+	// GetCatalogProductWithContext : Get a global catalog product
+	GetCatalogProductWithContext(ctx context.Context, getCatalogProductOptions *GetCatalogProductOptions) (result *GlobalCatalogProduct, response *core.DetailedResponse, err error)
+
+	// UpdateCatalogProductWithContext : Update a global catalog product
+	UpdateCatalogProductWithContext(ctx context.Context, updateCatalogProductOptions *UpdateCatalogProductOptions) (result *GlobalCatalogProduct, response *core.DetailedResponse, err error)
+
+	// DeleteCatalogProductWithContext : Delete a global catalog product
+	DeleteCatalogProductWithContext(ctx context.Context, deleteCatalogProductOptions *DeleteCatalogProductOptions) (response *core.DetailedResponse, err error)
+
+	// CreateCatalogPlanWithContext : Create a pricing plan in global catalog
+	CreateCatalogPlanWithContext(ctx context.Context, createCatalogPlanOptions *CreateCatalogPlanOptions) (result *GlobalCatalogPlan, response *core.DetailedResponse, err error)
+
+	// GetCatalogPlanWithContext : Get a global catalog pricing plan
+	GetCatalogPlanWithContext(ctx context.Context, getCatalogPlanOptions *GetCatalogPlanOptions) (result *GlobalCatalogPlan, response *core.DetailedResponse, err error)
+
+	// UpdateCatalogPlanWithContext : Update a global catalog plan
+	UpdateCatalogPlanWithContext(ctx context.Context, updateCatalogPlanOptions *UpdateCatalogPlanOptions) (result *GlobalCatalogPlan, response *core.DetailedResponse, err error)
+
+	// DeleteCatalogPlanWithContext : Delete a global catalog pricing plan
+	DeleteCatalogPlanWithContext(ctx context.Context, deleteCatalogPlanOptions *DeleteCatalogPlanOptions) (response *core.DetailedResponse, err error)
+
+	// CreateCatalogDeploymentWithContext : Create a global catalog deployment
+	CreateCatalogDeploymentWithContext(ctx context.Context, createCatalogDeploymentOptions *CreateCatalogDeploymentOptions) (result *GlobalCatalogDeployment, response *core.DetailedResponse, err error)
+
+	// GetCatalogDeploymentWithContext : Get a global catalog deployment
+	GetCatalogDeploymentWithContext(ctx context.Context, getCatalogDeploymentOptions *GetCatalogDeploymentOptions) (result *GlobalCatalogDeployment, response *core.DetailedResponse, err error)
+
+	// UpdateCatalogDeploymentWithContext : Update a global catalog deployment
+	UpdateCatalogDeploymentWithContext(ctx context.Context, updateCatalogDeploymentOptions *UpdateCatalogDeploymentOptions) (result *GlobalCatalogDeployment, response *core.DetailedResponse, err error)
+
+	// DeleteCatalogDeploymentWithContext : Delete a global catalog deployment
+	DeleteCatalogDeploymentWithContext(ctx context.Context, deleteCatalogDeploymentOptions *DeleteCatalogDeploymentOptions) (response *core.DetailedResponse, err error)
+
+	// CreateIamRegistrationWithContext : Create IAM registration for your service
+	CreateIamRegistrationWithContext(ctx context.Context, createIamRegistrationOptions *CreateIamRegistrationOptions) (result *IamServiceRegistration, response *core.DetailedResponse, err error)
+
+	// UpdateIamRegistrationWithContext : Update IAM registration for your service
+	UpdateIamRegistrationWithContext(ctx context.Context, updateIamRegistrationOptions *UpdateIamRegistrationOptions) (result *IamServiceRegistration, response *core.DetailedResponse, err error)
+
+	// DeleteIamRegistrationWithContext : Delete IAM registration for your service
+	DeleteIamRegistrationWithContext(ctx context.Context, deleteIamRegistrationOptions *DeleteIamRegistrationOptions) (response *core.DetailedResponse, err error)
+
+	// GetIamRegistrationWithContext : Get IAM registration for your service
+	GetIamRegistrationWithContext(ctx context.Context, getIamRegistrationOptions *GetIamRegistrationOptions) (result *IamServiceRegistration, response *core.DetailedResponse, err error)
+
+	// CreateResourceBrokerWithContext : Create a broker
+	CreateResourceBrokerWithContext(ctx context.Context, createResourceBrokerOptions *CreateResourceBrokerOptions) (result *Broker, response *core.DetailedResponse, err error)
+
+	// UpdateResourceBrokerWithContext : Update broker details
+	UpdateResourceBrokerWithContext(ctx context.Context, updateResourceBrokerOptions *UpdateResourceBrokerOptions) (result *Broker, response *core.DetailedResponse, err error)
+
+	// GetResourceBrokerWithContext : Get a broker
+	GetResourceBrokerWithContext(ctx context.Context, getResourceBrokerOptions *GetResourceBrokerOptions) (result *Broker, response *core.DetailedResponse, err error)
+
+	// DeleteResourceBrokerWithContext : Remove a broker
+	DeleteResourceBrokerWithContext(ctx context.Context, deleteResourceBrokerOptions *DeleteResourceBrokerOptions) (response *core.DetailedResponse, err error)
+
+	// ListProductBadgesWithContext : List badges
+	ListProductBadgesWithContext(ctx context.Context, listProductBadgesOptions *ListProductBadgesOptions) (result *ProductBadgeCollection, response *core.DetailedResponse, err error)
+
+	// GetProductBadgeWithContext : Get badge
+	GetProductBadgeWithContext(ctx context.Context, getProductBadgeOptions *GetProductBadgeOptions) (result *ProductBadge, response *core.DetailedResponse, err error)
+}
+
+// Verify that PartnerCenterSellV1 implements the PartnerCenterSellV1API interface.
+var _ PartnerCenterSellV1API = (*PartnerCenterSellV1)(nil)
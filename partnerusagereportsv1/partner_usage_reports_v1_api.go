@@ -0,0 +1,37 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.85.0-75c38f8f-20240206-210220
+ */
+
+package partnerusagereportsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PartnerUsageReportsV1API : The operations supported by the PartnerUsageReportsV1 service. This interface is satisfied by
+// *PartnerUsageReportsV1 and may be used to substitute a fake or a decorator for the service client.
+type PartnerUsageReportsV1API interface {
+	// GetResourceUsageReportWithContext : Get partner resource usage report
+	GetResourceUsageReportWithContext(ctx context.Context, getResourceUsageReportOptions *GetResourceUsageReportOptions) (result *PartnerUsageReportSummary, response *core.DetailedResponse, err error)
+}
+
+// Verify that PartnerUsageReportsV1 implements the PartnerUsageReportsV1API interface.
+var _ PartnerUsageReportsV1API = (*PartnerUsageReportsV1)(nil)
@@ -512,6 +512,50 @@ var _ = Describe(`PartnerUsageReportsV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageReportPager.Checkpoint successfully`, func() {
+				partnerUsageReportsService, serviceErr := partnerusagereportsv1.NewPartnerUsageReportsV1(&partnerusagereportsv1.PartnerUsageReportsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(partnerUsageReportsService).ToNot(BeNil())
+
+				getResourceUsageReportOptionsModel := &partnerusagereportsv1.GetResourceUsageReportOptions{
+					PartnerID:  core.StringPtr("testString"),
+					ResellerID: core.StringPtr("testString"),
+					CustomerID: core.StringPtr("testString"),
+					Children:   core.BoolPtr(false),
+					Month:      core.StringPtr("2024-01"),
+					Viewpoint:  core.StringPtr("DISTRIBUTOR"),
+					Recurse:    core.BoolPtr(false),
+					Limit:      core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := partnerUsageReportsService.NewGetResourceUsageReportPager(getResourceUsageReportOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []partnerusagereportsv1.PartnerUsageReport
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = partnerUsageReportsService.NewGetResourceUsageReportPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetResourceUsageReportPager.GetAll successfully`, func() {
 				partnerUsageReportsService, serviceErr := partnerusagereportsv1.NewPartnerUsageReportsV1(&partnerusagereportsv1.PartnerUsageReportsV1Options{
 					URL:           testServer.URL,
@@ -540,6 +584,36 @@ var _ = Describe(`PartnerUsageReportsV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageReportPager.All successfully`, func() {
+				partnerUsageReportsService, serviceErr := partnerusagereportsv1.NewPartnerUsageReportsV1(&partnerusagereportsv1.PartnerUsageReportsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(partnerUsageReportsService).ToNot(BeNil())
+
+				getResourceUsageReportOptionsModel := &partnerusagereportsv1.GetResourceUsageReportOptions{
+					PartnerID:  core.StringPtr("testString"),
+					ResellerID: core.StringPtr("testString"),
+					CustomerID: core.StringPtr("testString"),
+					Children:   core.BoolPtr(false),
+					Month:      core.StringPtr("2024-01"),
+					Viewpoint:  core.StringPtr("DISTRIBUTOR"),
+					Recurse:    core.BoolPtr(false),
+					Limit:      core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := partnerUsageReportsService.NewGetResourceUsageReportPager(getResourceUsageReportOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []partnerusagereportsv1.PartnerUsageReport
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`Model constructor tests`, func() {
@@ -0,0 +1,290 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The kinds of the pieces of a resource instance reported in a CloneIssue.
+const (
+	CloneIssueKindTags   = "tags"
+	CloneIssueKindKey    = "resource_key"
+	CloneIssueKindAlias  = "resource_alias"
+	CloneIssueKindSource = "source"
+)
+
+// CloneResourceInstanceOptions : The options for the CloneResourceInstance method.
+type CloneResourceInstanceOptions struct {
+	// The name of the new resource instance. Defaults to the name of the source instance.
+	Name string
+
+	// The resource group of the new resource instance. Defaults to the resource group of the
+	// source instance.
+	ResourceGroupID string
+
+	// The deployment location (e.g. "us-south") of the new resource instance. Defaults to the
+	// region of the source instance.
+	Target string
+
+	// Parameters that override those of the source instance.
+	Parameters map[string]interface{}
+
+	// Tags that are attached to the new resource instance, in addition to those of the source.
+	Tags []string
+
+	// An optional function that returns the tags attached to the resource identified by "crn"
+	// (e.g. by invoking the ListTags operation of the Global Tagging service). If it is not
+	// provided, the tags of the source instance are not copied.
+	TagLister func(ctx context.Context, crn string) ([]string, error)
+
+	// If true, the resource keys of the source instance are not recreated. They are reported as
+	// not copied, so the source is not deleted by DeleteSource if it has any.
+	SkipKeys bool
+
+	// The options used to wait for the new resource instance to become active before its
+	// resource keys are created.
+	WaitOptions *WaitOptions
+
+	// If true, the source instance (and its keys and aliases) is deleted once every piece of it
+	// has been copied, which migrates the instance to the target.
+	DeleteSource bool
+}
+
+// CloneIssue : A piece of the source resource instance that was not copied.
+type CloneIssue struct {
+	// The kind of the piece (e.g. CloneIssueKindKey).
+	Kind string
+
+	// The name of the piece, if any.
+	Name string
+
+	// Why the piece was not copied.
+	Reason string
+}
+
+// CloneResourceInstanceResult : The result of the CloneResourceInstance method.
+type CloneResourceInstanceResult struct {
+	// The source resource instance.
+	Source *ResourceInstance
+
+	// The new resource instance.
+	Instance *ResourceInstance
+
+	// The tags with which the new resource instance was created.
+	Tags []string
+
+	// The resource keys created for the new resource instance.
+	Keys []ResourceKey
+
+	// The pieces of the source resource instance that were not copied.
+	NotCopied []CloneIssue
+
+	// Whether the source resource instance was deleted.
+	SourceDeleted bool
+}
+
+// Complete returns true if every piece of the source resource instance was copied.
+func (result *CloneResourceInstanceResult) Complete() bool {
+	return len(result.NotCopied) == 0
+}
+
+func (result *CloneResourceInstanceResult) notCopied(kind string, name string, reason string) {
+	result.NotCopied = append(result.NotCopied, CloneIssue{Kind: kind, Name: name, Reason: reason})
+}
+
+// CloneResourceInstance recreates the resource instance identified by "id", with its plan,
+// parameters, tags and resource keys, in the target resource group and/or region. The pieces of
+// the instance that cannot be copied are reported in the NotCopied field of the result; resource
+// aliases, which are bound to the source's Cloud Foundry space, are never copied.
+//
+// An error is returned if the source instance cannot be retrieved or the new instance cannot be
+// created. Once the new instance has been created, the result is returned along with any error.
+func (resourceController *ResourceControllerV2) CloneResourceInstance(ctx context.Context, id string, options *CloneResourceInstanceOptions) (result *CloneResourceInstanceResult, err error) {
+	if options == nil {
+		options = &CloneResourceInstanceOptions{}
+	}
+	source, _, err := resourceController.GetResourceInstanceWithContext(ctx, resourceController.NewGetResourceInstanceOptions(id))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-source-error")
+		return
+	}
+	cloneResult := &CloneResourceInstanceResult{
+		Source: source,
+	}
+
+	name := options.Name
+	if name == "" {
+		name = core.StringNilMapper(source.Name)
+	}
+	resourceGroupID := options.ResourceGroupID
+	if resourceGroupID == "" {
+		resourceGroupID = core.StringNilMapper(source.ResourceGroupID)
+	}
+	target := options.Target
+	if target == "" {
+		target = core.StringNilMapper(source.RegionID)
+	}
+	if name == "" || resourceGroupID == "" || target == "" || source.ResourcePlanID == nil || source.CRN == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the name, resource group, target, plan and CRN of resource instance '%s' could not be determined", id), "clone-incomplete-source", common.GetComponentInfo())
+		return
+	}
+
+	if options.TagLister != nil {
+		var tags []string
+		tags, err = options.TagLister(ctx, *source.CRN)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "list-tags-error", common.GetComponentInfo())
+			return
+		}
+		cloneResult.Tags = append(cloneResult.Tags, tags...)
+	} else {
+		cloneResult.notCopied(CloneIssueKindTags, "", "no TagLister was provided")
+	}
+	for _, tag := range options.Tags {
+		if !slices.Contains(cloneResult.Tags, tag) {
+			cloneResult.Tags = append(cloneResult.Tags, tag)
+		}
+	}
+
+	parameters := maps.Clone(source.Parameters)
+	if len(options.Parameters) > 0 {
+		if parameters == nil {
+			parameters = make(map[string]interface{})
+		}
+		maps.Copy(parameters, options.Parameters)
+	}
+
+	createOptions := resourceController.NewCreateResourceInstanceOptions(name, target, resourceGroupID, *source.ResourcePlanID)
+	createOptions.Parameters = parameters
+	createOptions.AllowCleanup = source.AllowCleanup
+	if len(cloneResult.Tags) > 0 {
+		createOptions.SetTags(cloneResult.Tags)
+	}
+	cloneResult.Instance, _, err = resourceController.CreateResourceInstanceWithContext(ctx, createOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-instance-error")
+		return
+	}
+	result = cloneResult
+
+	keys, err := resourceController.listCloneSourceKeys(ctx, id)
+	if err != nil {
+		return
+	}
+	if options.SkipKeys {
+		for _, key := range keys {
+			result.notCopied(CloneIssueKindKey, core.StringNilMapper(key.Name), "resource keys are skipped (SkipKeys)")
+		}
+		keys = nil
+	}
+	if len(keys) > 0 {
+		// Resource keys can only be created for an active resource instance.
+		var instance *ResourceInstance
+		instance, err = resourceController.WaitForResourceInstanceState(ctx, *result.Instance.ID, []string{ResourceInstanceStateActiveConst}, options.WaitOptions)
+		if instance != nil {
+			result.Instance = instance
+		}
+		if err != nil {
+			for _, key := range keys {
+				result.notCopied(CloneIssueKindKey, core.StringNilMapper(key.Name), "the new resource instance did not become active")
+			}
+			return
+		}
+	}
+	for _, key := range keys {
+		resourceController.cloneResourceKey(ctx, result, key)
+	}
+
+	aliasPager, err := resourceController.NewResourceAliasesForInstancePager(resourceController.NewListResourceAliasesForInstanceOptions(id))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-aliases-error")
+		return
+	}
+	aliases, err := aliasPager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-aliases-error")
+		return
+	}
+	for _, alias := range aliases {
+		result.notCopied(CloneIssueKindAlias, core.StringNilMapper(alias.Name),
+			fmt.Sprintf("resource aliases are bound to their target '%s'", core.StringNilMapper(alias.TargetCRN)))
+	}
+
+	if options.DeleteSource {
+		if !result.Complete() {
+			result.notCopied(CloneIssueKindSource, core.StringNilMapper(source.Name), "the source was not deleted because it was not copied completely")
+			return
+		}
+		_, err = resourceController.DeleteResourceInstanceWithContext(ctx, resourceController.NewDeleteResourceInstanceOptions(id).SetRecursive(true))
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "delete-source-error")
+			return
+		}
+		result.SourceDeleted = true
+	}
+	return
+}
+
+// listCloneSourceKeys returns the resource keys of the source instance.
+func (resourceController *ResourceControllerV2) listCloneSourceKeys(ctx context.Context, id string) (keys []ResourceKey, err error) {
+	pager, err := resourceController.NewResourceKeysForInstancePager(resourceController.NewListResourceKeysForInstanceOptions(id))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-keys-error")
+		return
+	}
+	keys, err = pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-keys-error")
+		return
+	}
+	return
+}
+
+// cloneResourceKey recreates "key" for the new resource instance, with the same name, role and
+// service ID, and records the outcome in "result".
+func (resourceController *ResourceControllerV2) cloneResourceKey(ctx context.Context, result *CloneResourceInstanceResult, key ResourceKey) {
+	name := core.StringNilMapper(key.Name)
+	if key.Credentials == nil || key.Credentials.IamRoleCRN == nil {
+		reason := "the role of the resource key is unknown"
+		if IsRedacted(key.Credentials) {
+			reason = "the credentials of the resource key are redacted, so its role is unknown"
+		}
+		result.notCopied(CloneIssueKindKey, name, reason)
+		return
+	}
+
+	createOptions := resourceController.NewCreateResourceKeyOptions(name, *result.Instance.ID)
+	createOptions.SetRole(*key.Credentials.IamRoleCRN)
+	if key.Credentials.IamServiceidCRN != nil {
+		createOptions.SetParameters(&ResourceKeyPostParameters{
+			ServiceidCRN: key.Credentials.IamServiceidCRN,
+		})
+	}
+	newKey, _, err := resourceController.CreateResourceKeyWithContext(ctx, createOptions)
+	if err != nil {
+		result.notCopied(CloneIssueKindKey, name, err.Error())
+		return
+	}
+	result.Keys = append(result.Keys, *newKey)
+}
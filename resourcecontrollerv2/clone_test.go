@@ -0,0 +1,173 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 CloneResourceInstance`, func() {
+	var fake *mockservers.ResourceControllerV2Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	var source resourcecontrollerv2.ResourceInstance
+	var cloneOptions *resourcecontrollerv2.CloneResourceInstanceOptions
+
+	BeforeEach(func() {
+		fake = mockservers.NewResourceControllerV2Server()
+		var err error
+		resourceControllerService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+
+		source = fake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+			Name:            core.StringPtr("my-instance"),
+			RegionID:        core.StringPtr("us-south"),
+			ResourceGroupID: core.StringPtr("dev-group"),
+			ResourcePlanID:  core.StringPtr("plan-1"),
+			Parameters: map[string]interface{}{
+				"members_memory_allocation_mb": float64(4096),
+				"version":                      "15",
+			},
+		})
+		fake.AddResourceKey(resourcecontrollerv2.ResourceKey{
+			Name:      core.StringPtr("reader-key"),
+			SourceCRN: source.CRN,
+			Credentials: &resourcecontrollerv2.Credentials{
+				IamRoleCRN: core.StringPtr("crn:v1:bluemix:public:iam::::serviceRole:Reader"),
+			},
+		})
+
+		cloneOptions = &resourcecontrollerv2.CloneResourceInstanceOptions{
+			ResourceGroupID: "staging-group",
+			Target:          "eu-de",
+			Parameters: map[string]interface{}{
+				"members_memory_allocation_mb": float64(8192),
+			},
+			Tags: []string{"env:staging"},
+			TagLister: func(ctx context.Context, crn string) ([]string, error) {
+				Expect(crn).To(Equal(*source.CRN))
+				return []string{"team:data", "env:dev"}, nil
+			},
+			WaitOptions: &resourcecontrollerv2.WaitOptions{
+				PollInterval: time.Millisecond,
+				Jitter:       -1,
+			},
+		}
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It(`Clone a resource instance into another resource group and region`, func() {
+		fake.SetProvisioningPolls(2)
+		result, err := resourceControllerService.CloneResourceInstance(context.Background(), *source.ID, cloneOptions)
+		Expect(err).To(BeNil())
+		Expect(result.Complete()).To(BeTrue())
+		Expect(result.SourceDeleted).To(BeFalse())
+		Expect(*result.Source.ID).To(Equal(*source.ID))
+
+		instance := result.Instance
+		Expect(*instance.ID).ToNot(Equal(*source.ID))
+		Expect(*instance.State).To(Equal("active"))
+		Expect(*instance.Name).To(Equal("my-instance"))
+		Expect(*instance.ResourceGroupID).To(Equal("staging-group"))
+		Expect(*instance.RegionID).To(Equal("eu-de"))
+		Expect(*instance.ResourcePlanID).To(Equal("plan-1"))
+		Expect(instance.Parameters).To(Equal(map[string]interface{}{
+			"members_memory_allocation_mb": float64(8192),
+			"version":                      "15",
+		}))
+		Expect(result.Tags).To(Equal([]string{"team:data", "env:dev", "env:staging"}))
+		Expect(fake.ResourceInstanceTags(*instance.ID)).To(Equal(result.Tags))
+
+		Expect(result.Keys).To(HaveLen(1))
+		Expect(*result.Keys[0].Name).To(Equal("reader-key"))
+		Expect(*result.Keys[0].SourceCRN).To(Equal(*instance.CRN))
+		Expect(*result.Keys[0].Credentials.IamRoleCRN).To(Equal("crn:v1:bluemix:public:iam::::serviceRole:Reader"))
+
+		// The source is left in place.
+		original, _ := fake.ResourceInstance(*source.ID)
+		Expect(*original.State).To(Equal("active"))
+		Expect(original.Parameters["members_memory_allocation_mb"]).To(Equal(float64(4096)))
+	})
+	It(`Report the pieces that could not be copied`, func() {
+		fake.AddResourceKey(resourcecontrollerv2.ResourceKey{
+			Name:        core.StringPtr("legacy-key"),
+			SourceCRN:   source.CRN,
+			Credentials: &resourcecontrollerv2.Credentials{},
+		})
+		fake.AddResourceAlias(resourcecontrollerv2.ResourceAlias{
+			Name:               core.StringPtr("my-alias"),
+			ResourceInstanceID: source.ID,
+			TargetCRN:          core.StringPtr("crn:v1:bluemix:public:cf:us-south:o/org-1::cf-space:space-1"),
+		})
+		cloneOptions.TagLister = nil
+		cloneOptions.DeleteSource = true
+
+		result, err := resourceControllerService.CloneResourceInstance(context.Background(), *source.ID, cloneOptions)
+		Expect(err).To(BeNil())
+		Expect(result.Complete()).To(BeFalse())
+		Expect(result.Keys).To(HaveLen(1))
+		Expect(result.Tags).To(Equal([]string{"env:staging"}))
+
+		kinds := []string{}
+		for _, issue := range result.NotCopied {
+			kinds = append(kinds, issue.Kind+":"+issue.Name)
+		}
+		Expect(kinds).To(Equal([]string{"tags:", "resource_key:legacy-key", "resource_alias:my-alias", "source:my-instance"}))
+
+		// An incomplete copy does not migrate the source.
+		Expect(result.SourceDeleted).To(BeFalse())
+		original, _ := fake.ResourceInstance(*source.ID)
+		Expect(*original.State).To(Equal("active"))
+	})
+	It(`Migrate a resource instance`, func() {
+		cloneOptions.DeleteSource = true
+		result, err := resourceControllerService.CloneResourceInstance(context.Background(), *source.ID, cloneOptions)
+		Expect(err).To(BeNil())
+		Expect(result.Complete()).To(BeTrue())
+		Expect(result.SourceDeleted).To(BeTrue())
+
+		original, _ := fake.ResourceInstance(*source.ID)
+		Expect(*original.State).To(Equal("pending_reclamation"))
+	})
+	It(`Keep the source when its resource keys are skipped`, func() {
+		cloneOptions.SkipKeys = true
+		cloneOptions.DeleteSource = true
+		result, err := resourceControllerService.CloneResourceInstance(context.Background(), *source.ID, cloneOptions)
+		Expect(err).To(BeNil())
+		Expect(result.Keys).To(BeEmpty())
+		Expect(result.Complete()).To(BeFalse())
+		Expect(result.NotCopied[0].Kind).To(Equal(resourcecontrollerv2.CloneIssueKindKey))
+		Expect(result.NotCopied[0].Name).To(Equal("reader-key"))
+
+		Expect(result.SourceDeleted).To(BeFalse())
+		original, _ := fake.ResourceInstance(*source.ID)
+		Expect(*original.State).To(Equal("active"))
+	})
+	It(`Fail when the source resource instance does not exist`, func() {
+		result, err := resourceControllerService.CloneResourceInstance(context.Background(), "does-not-exist", nil)
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+	})
+})
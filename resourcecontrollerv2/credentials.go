@@ -0,0 +1,312 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ErrRedactedCredentials is returned (wrapped) when decoding credentials that the caller is not
+// permitted to view.
+var ErrRedactedCredentials = errors.New("the credentials are redacted")
+
+// TypedCredentials : The service-specific credentials produced by a CredentialDecoder. The String()
+// method of each implementation masks every secret value.
+type TypedCredentials interface {
+	String() string
+}
+
+// CredentialDecoder : A function that decodes the credentials of a resource key or resource
+// binding into a service-specific type.
+type CredentialDecoder func(credentials *Credentials) (TypedCredentials, error)
+
+// CredentialDecoderRegistry : A set of credential decoders keyed by service name (e.g.
+// "cloud-object-storage"), which is the service name segment of the CRN of a resource instance.
+type CredentialDecoderRegistry struct {
+	mutex    sync.RWMutex
+	decoders map[string]CredentialDecoder
+}
+
+// NewCredentialDecoderRegistry returns a new registry that contains the decoders for
+// COSCredentials, PostgresConnection and EventStreamsCredentials.
+func NewCredentialDecoderRegistry() *CredentialDecoderRegistry {
+	registry := &CredentialDecoderRegistry{
+		decoders: make(map[string]CredentialDecoder),
+	}
+	registry.Register("cloud-object-storage", NewTypedCredentialDecoder[COSCredentials]())
+	registry.Register("databases-for-postgresql", NewTypedCredentialDecoder[PostgresConnection]("connection", "postgres"))
+	registry.Register("messagehub", NewTypedCredentialDecoder[EventStreamsCredentials]())
+	return registry
+}
+
+// NewTypedCredentialDecoder returns a CredentialDecoder that decodes credentials into a new
+// instance of T by using DecodeCredentials with the specified "path".
+func NewTypedCredentialDecoder[T any, PT interface {
+	*T
+	TypedCredentials
+}](path ...string) CredentialDecoder {
+	return func(credentials *Credentials) (TypedCredentials, error) {
+		result, err := DecodeCredentials[T](credentials, path...)
+		if err != nil {
+			return nil, err
+		}
+		return PT(result), nil
+	}
+}
+
+// DefaultCredentialDecoders is the registry used by DecodeResourceKeyCredentials and
+// DecodeResourceBindingCredentials.
+var DefaultCredentialDecoders = NewCredentialDecoderRegistry()
+
+// Register adds "decoder" to the registry as the decoder for the service named "serviceName",
+// replacing any existing decoder for that service.
+func (registry *CredentialDecoderRegistry) Register(serviceName string, decoder CredentialDecoder) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	registry.decoders[serviceName] = decoder
+}
+
+// Decode decodes "credentials" with the decoder registered for the service named "serviceName".
+func (registry *CredentialDecoderRegistry) Decode(serviceName string, credentials *Credentials) (TypedCredentials, error) {
+	registry.mutex.RLock()
+	decoder, found := registry.decoders[serviceName]
+	registry.mutex.RUnlock()
+	if !found {
+		errMsg := fmt.Sprintf("no credential decoder is registered for service '%s'", serviceName)
+		return nil, core.SDKErrorf(nil, errMsg, "no-credential-decoder", common.GetComponentInfo())
+	}
+	if credentials == nil {
+		return nil, core.SDKErrorf(nil, "the credentials are missing", "no-credentials", common.GetComponentInfo())
+	}
+	if IsRedacted(credentials) {
+		return nil, core.SDKErrorf(ErrRedactedCredentials, "", "redacted-credentials", common.GetComponentInfo())
+	}
+	return decoder(credentials)
+}
+
+// DecodeResourceKeyCredentials decodes the credentials of "resourceKey" with the decoder that is
+// registered in DefaultCredentialDecoders for the service named in its source CRN.
+func DecodeResourceKeyCredentials(resourceKey *ResourceKey) (TypedCredentials, error) {
+	return DefaultCredentialDecoders.Decode(ServiceNameFromCRN(core.StringNilMapper(resourceKey.SourceCRN)), resourceKey.Credentials)
+}
+
+// DecodeResourceBindingCredentials decodes the credentials of "resourceBinding" with the decoder
+// that is registered in DefaultCredentialDecoders for the service named in its source CRN.
+func DecodeResourceBindingCredentials(resourceBinding *ResourceBinding) (TypedCredentials, error) {
+	return DefaultCredentialDecoders.Decode(ServiceNameFromCRN(core.StringNilMapper(resourceBinding.SourceCRN)), resourceBinding.Credentials)
+}
+
+// ServiceNameFromCRN returns the service name segment of "crn"
+// (e.g. "cloud-object-storage" for "crn:v1:bluemix:public:cloud-object-storage:global:a/...").
+func ServiceNameFromCRN(crn string) string {
+	segments := strings.Split(crn, ":")
+	if len(segments) < 5 || segments[0] != "crn" {
+		return ""
+	}
+	return segments[4]
+}
+
+// IsRedacted returns true if "credentials" have been redacted because the caller is not permitted
+// to view them, i.e. if the "REDACTED" property is set or the API key is the "REDACTED" marker.
+func IsRedacted(credentials *Credentials) bool {
+	if credentials == nil {
+		return false
+	}
+	if credentials.Redacted != nil {
+		return true
+	}
+	return credentials.Apikey != nil && *credentials.Apikey == CredentialsRedactedRedactedConst
+}
+
+// DecodeCredentials decodes the properties of "credentials" into a new instance of T. If "path" is
+// specified, the object found by following the named properties is decoded instead
+// (e.g. "connection", "postgres").
+func DecodeCredentials[T any](credentials *Credentials, path ...string) (result *T, err error) {
+	data, err := json.Marshal(credentials)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "credentials-marshal-error", common.GetComponentInfo())
+		return
+	}
+	for _, name := range path {
+		var properties map[string]json.RawMessage
+		if err = json.Unmarshal(data, &properties); err != nil || properties[name] == nil {
+			errMsg := fmt.Sprintf("the credentials do not contain the '%s' property", strings.Join(path, "."))
+			err = core.SDKErrorf(err, errMsg, "credentials-missing-property", common.GetComponentInfo())
+			return
+		}
+		data = properties[name]
+	}
+	result = new(T)
+	err = json.Unmarshal(data, result)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "credentials-unmarshal-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// COSCredentials : The credentials of a Cloud Object Storage resource key.
+type COSCredentials struct {
+	Apikey               string       `json:"apikey,omitempty" secret:"true"`
+	Endpoints            string       `json:"endpoints,omitempty"`
+	IamApikeyDescription string       `json:"iam_apikey_description,omitempty"`
+	IamApikeyName        string       `json:"iam_apikey_name,omitempty"`
+	IamRoleCRN           string       `json:"iam_role_crn,omitempty"`
+	IamServiceidCRN      string       `json:"iam_serviceid_crn,omitempty"`
+	ResourceInstanceID   string       `json:"resource_instance_id,omitempty"`
+	HMACKeys             *COSHMACKeys `json:"cos_hmac_keys,omitempty"`
+}
+
+// COSHMACKeys : The HMAC keys of a Cloud Object Storage resource key.
+type COSHMACKeys struct {
+	AccessKeyID     string `json:"access_key_id,omitempty"`
+	SecretAccessKey string `json:"secret_access_key,omitempty" secret:"true"`
+}
+
+// String returns a description of the credentials in which secrets are masked.
+func (credentials *COSCredentials) String() string {
+	return redactedString(credentials)
+}
+
+// PostgresConnection : The "connection.postgres" credentials of a Databases for PostgreSQL
+// resource key.
+type PostgresConnection struct {
+	Type           string                  `json:"type,omitempty"`
+	Composed       []string                `json:"composed,omitempty" secret:"true"`
+	Authentication *PostgresAuthentication `json:"authentication,omitempty"`
+	Certificate    *PostgresCertificate    `json:"certificate,omitempty"`
+	Hosts          []PostgresHost          `json:"hosts,omitempty"`
+	Database       string                  `json:"database,omitempty"`
+	Path           string                  `json:"path,omitempty"`
+	QueryOptions   map[string]interface{}  `json:"query_options,omitempty"`
+	Scheme         string                  `json:"scheme,omitempty"`
+}
+
+// PostgresAuthentication : The authentication details of a PostgresConnection.
+type PostgresAuthentication struct {
+	Method   string `json:"method,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty" secret:"true"`
+}
+
+// PostgresCertificate : The CA certificate of a PostgresConnection.
+type PostgresCertificate struct {
+	Name              string `json:"name,omitempty"`
+	CertificateBase64 string `json:"certificate_base64,omitempty"`
+}
+
+// PostgresHost : A host of a PostgresConnection.
+type PostgresHost struct {
+	Hostname string `json:"hostname,omitempty"`
+	Port     int64  `json:"port,omitempty"`
+}
+
+// String returns a description of the connection in which secrets are masked.
+func (connection *PostgresConnection) String() string {
+	return redactedString(connection)
+}
+
+// EventStreamsCredentials : The credentials of an Event Streams resource key.
+type EventStreamsCredentials struct {
+	Apikey               string   `json:"apikey,omitempty" secret:"true"`
+	User                 string   `json:"user,omitempty"`
+	Password             string   `json:"password,omitempty" secret:"true"`
+	InstanceID           string   `json:"instance_id,omitempty"`
+	KafkaAdminURL        string   `json:"kafka_admin_url,omitempty"`
+	KafkaHTTPURL         string   `json:"kafka_http_url,omitempty"`
+	KafkaBrokersSasl     []string `json:"kafka_brokers_sasl,omitempty"`
+	IamApikeyDescription string   `json:"iam_apikey_description,omitempty"`
+	IamApikeyName        string   `json:"iam_apikey_name,omitempty"`
+	IamRoleCRN           string   `json:"iam_role_crn,omitempty"`
+	IamServiceidCRN      string   `json:"iam_serviceid_crn,omitempty"`
+}
+
+// String returns a description of the credentials in which secrets are masked.
+func (credentials *EventStreamsCredentials) String() string {
+	return redactedString(credentials)
+}
+
+// redactedMask replaces the value of each secret field in the result of redactedString().
+const redactedMask = "********"
+
+// redactedString formats the struct pointed to by "value" as "TypeName{json_name: value, ...}",
+// omitting empty fields and masking the fields tagged with `secret:"true"`.
+func redactedString(value interface{}) string {
+	var builder strings.Builder
+	writeRedacted(&builder, reflect.ValueOf(value), false)
+	return builder.String()
+}
+
+func writeRedacted(builder *strings.Builder, value reflect.Value, secret bool) {
+	if value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			builder.WriteString("<nil>")
+			return
+		}
+		value = value.Elem()
+	}
+	if secret {
+		builder.WriteString(redactedMask)
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		builder.WriteString(value.Type().Name())
+		builder.WriteString("{")
+		first := true
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() || value.Field(i).IsZero() {
+				continue
+			}
+			if !first {
+				builder.WriteString(", ")
+			}
+			first = false
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "" {
+				name = field.Name
+			}
+			builder.WriteString(name)
+			builder.WriteString(": ")
+			writeRedacted(builder, value.Field(i), field.Tag.Get("secret") == "true")
+		}
+		builder.WriteString("}")
+	case reflect.Slice, reflect.Array:
+		builder.WriteString("[")
+		for i := 0; i < value.Len(); i++ {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			writeRedacted(builder, value.Index(i), false)
+		}
+		builder.WriteString("]")
+	case reflect.String:
+		fmt.Fprintf(builder, "%q", value.String())
+	default:
+		fmt.Fprintf(builder, "%v", value.Interface())
+	}
+}
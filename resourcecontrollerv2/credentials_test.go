@@ -0,0 +1,148 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 typed credentials`, func() {
+	cosCRN := "crn:v1:bluemix:public:cloud-object-storage:global:a/testAccountID:instance-1::"
+	postgresCRN := "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/testAccountID:instance-2::"
+
+	cosCredentials := func() *resourcecontrollerv2.Credentials {
+		credentials := &resourcecontrollerv2.Credentials{
+			Apikey:        core.StringPtr("my-api-key"),
+			IamApikeyName: core.StringPtr("test-key"),
+		}
+		credentials.SetProperties(map[string]interface{}{
+			"endpoints":            "https://control.cloud-object-storage.cloud.ibm.com/v2/endpoints",
+			"resource_instance_id": cosCRN,
+			"cos_hmac_keys": map[string]interface{}{
+				"access_key_id":     "access-key",
+				"secret_access_key": "secret-key",
+			},
+		})
+		return credentials
+	}
+
+	It(`Decode Cloud Object Storage credentials`, func() {
+		result, err := resourcecontrollerv2.DefaultCredentialDecoders.Decode("cloud-object-storage", cosCredentials())
+		Expect(err).To(BeNil())
+
+		credentials, ok := result.(*resourcecontrollerv2.COSCredentials)
+		Expect(ok).To(BeTrue())
+		Expect(credentials.Apikey).To(Equal("my-api-key"))
+		Expect(credentials.ResourceInstanceID).To(Equal(cosCRN))
+		Expect(credentials.HMACKeys.AccessKeyID).To(Equal("access-key"))
+		Expect(credentials.HMACKeys.SecretAccessKey).To(Equal("secret-key"))
+
+		description := credentials.String()
+		Expect(description).To(ContainSubstring(`iam_apikey_name: "test-key"`))
+		Expect(description).To(ContainSubstring(`cos_hmac_keys: COSHMACKeys{access_key_id: "access-key", secret_access_key: ********}`))
+		Expect(description).ToNot(ContainSubstring("my-api-key"))
+		Expect(description).ToNot(ContainSubstring("secret-key"))
+	})
+	It(`Decode a PostgreSQL connection`, func() {
+		credentials := &resourcecontrollerv2.Credentials{}
+		credentials.SetProperty("connection", map[string]interface{}{
+			"postgres": map[string]interface{}{
+				"type":     "uri",
+				"composed": []string{"postgres://admin:pw@host-1:31000/ibmclouddb?sslmode=verify-full"},
+				"authentication": map[string]interface{}{
+					"method":   "direct",
+					"username": "admin",
+					"password": "pw",
+				},
+				"hosts":    []map[string]interface{}{{"hostname": "host-1", "port": 31000}},
+				"database": "ibmclouddb",
+			},
+		})
+		key := &resourcecontrollerv2.ResourceKey{
+			SourceCRN:   core.StringPtr(postgresCRN),
+			Credentials: credentials,
+		}
+
+		result, err := resourcecontrollerv2.DecodeResourceKeyCredentials(key)
+		Expect(err).To(BeNil())
+		connection := result.(*resourcecontrollerv2.PostgresConnection)
+		Expect(connection.Authentication.Username).To(Equal("admin"))
+		Expect(connection.Authentication.Password).To(Equal("pw"))
+		Expect(connection.Hosts).To(Equal([]resourcecontrollerv2.PostgresHost{{Hostname: "host-1", Port: 31000}}))
+		Expect(connection.String()).To(Equal(`PostgresConnection{type: "uri", composed: ********, ` +
+			`authentication: PostgresAuthentication{method: "direct", username: "admin", password: ********}, ` +
+			`hosts: [PostgresHost{hostname: "host-1", port: 31000}], database: "ibmclouddb"}`))
+
+		// The connection is required.
+		key.Credentials = &resourcecontrollerv2.Credentials{Apikey: core.StringPtr("my-api-key")}
+		_, err = resourcecontrollerv2.DecodeResourceKeyCredentials(key)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("connection.postgres"))
+	})
+	It(`Register a custom decoder`, func() {
+		registry := resourcecontrollerv2.NewCredentialDecoderRegistry()
+		_, err := registry.Decode("my-service", cosCredentials())
+		Expect(err).ToNot(BeNil())
+
+		registry.Register("my-service", resourcecontrollerv2.NewTypedCredentialDecoder[resourcecontrollerv2.EventStreamsCredentials]())
+		result, err := registry.Decode("my-service", cosCredentials())
+		Expect(err).To(BeNil())
+		Expect(result.(*resourcecontrollerv2.EventStreamsCredentials).Apikey).To(Equal("my-api-key"))
+
+		registry.Register("my-service", resourcecontrollerv2.NewTypedCredentialDecoder[resourcecontrollerv2.COSCredentials]("missing"))
+		result, err = registry.Decode("my-service", cosCredentials())
+		Expect(err).ToNot(BeNil())
+		Expect(result).To(BeNil())
+	})
+	It(`Detect redacted credentials`, func() {
+		Expect(resourcecontrollerv2.IsRedacted(cosCredentials())).To(BeFalse())
+		Expect(resourcecontrollerv2.IsRedacted(&resourcecontrollerv2.Credentials{Apikey: core.StringPtr("REDACTED")})).To(BeTrue())
+
+		fake := mockservers.NewResourceControllerV2Server()
+		defer fake.Close()
+		resourceControllerService, err := fake.NewClient()
+		Expect(err).To(BeNil())
+		key := fake.AddResourceKey(resourcecontrollerv2.ResourceKey{
+			Name:        core.StringPtr("test-key"),
+			SourceCRN:   core.StringPtr(cosCRN),
+			Credentials: cosCredentials(),
+		})
+
+		result, _, err := resourceControllerService.GetResourceKey(resourceControllerService.NewGetResourceKeyOptions(*key.GUID))
+		Expect(err).To(BeNil())
+		typedCredentials, err := resourcecontrollerv2.DecodeResourceKeyCredentials(result)
+		Expect(err).To(BeNil())
+		Expect(typedCredentials.(*resourcecontrollerv2.COSCredentials).HMACKeys.AccessKeyID).To(Equal("access-key"))
+
+		fake.SetRedactCredentials(true)
+		result, _, err = resourceControllerService.GetResourceKey(resourceControllerService.NewGetResourceKeyOptions(*key.GUID))
+		Expect(err).To(BeNil())
+		Expect(resourcecontrollerv2.IsRedacted(result.Credentials)).To(BeTrue())
+		_, err = resourcecontrollerv2.DecodeResourceKeyCredentials(result)
+		Expect(errors.Is(err, resourcecontrollerv2.ErrRedactedCredentials)).To(BeTrue())
+	})
+	It(`Extract the service name from a CRN`, func() {
+		Expect(resourcecontrollerv2.ServiceNameFromCRN(cosCRN)).To(Equal("cloud-object-storage"))
+		Expect(resourcecontrollerv2.ServiceNameFromCRN("not-a-crn")).To(BeEmpty())
+	})
+})
@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The actions that can be run on a reclamation with RunReclamationAction.
+const (
+	ReclamationActionRestore = "restore"
+	ReclamationActionReclaim = "reclaim"
+)
+
+// ReclamationStateScheduled is the state of a reclamation that has not yet been acted upon.
+const ReclamationStateScheduled = "SCHEDULED"
+
+// PendingReclamation : A scheduled reclamation, joined with the resource instance that it reclaims.
+type PendingReclamation struct {
+	Reclamation *Reclamation
+
+	// The resource instance, or nil if it could not be retrieved.
+	Instance *ResourceInstance
+
+	// The tags attached to the resource instance, if a TagLister was provided.
+	Tags []string
+
+	// The time elapsed since the resource instance was deleted.
+	Age time.Duration
+}
+
+// ReclamationPolicy : A rule that selects the action to be taken for pending reclamations.
+type ReclamationPolicy struct {
+	// The name of the policy, used in reports.
+	Name string
+
+	// The action (ReclamationActionRestore or ReclamationActionReclaim) to run on the
+	// reclamations that match the policy.
+	Action string
+
+	// Returns true if the policy applies to "reclamation".
+	Matches func(reclamation *PendingReclamation) bool
+}
+
+// RestoreTaggedPolicy returns a policy that restores the resource instances to which "tag"
+// (e.g. "env:prod") is attached.
+func RestoreTaggedPolicy(tag string) ReclamationPolicy {
+	return ReclamationPolicy{
+		Name:   "restore-tagged-" + tag,
+		Action: ReclamationActionRestore,
+		Matches: func(reclamation *PendingReclamation) bool {
+			return slices.Contains(reclamation.Tags, tag)
+		},
+	}
+}
+
+// ReclaimOlderThanPolicy returns a policy that reclaims (i.e. permanently deletes) the resource
+// instances that were deleted more than "age" ago.
+func ReclaimOlderThanPolicy(age time.Duration) ReclamationPolicy {
+	return ReclamationPolicy{
+		Name:   fmt.Sprintf("reclaim-older-than-%s", age),
+		Action: ReclamationActionReclaim,
+		Matches: func(reclamation *PendingReclamation) bool {
+			return reclamation.Age > age
+		},
+	}
+}
+
+// ReclamationManagerOptions : The options used to create a ReclamationManager.
+type ReclamationManagerOptions struct {
+	// Limit the reclamations to those of an account and/or resource group.
+	AccountID       string
+	ResourceGroupID string
+
+	// The policies, which are evaluated in order. The first policy that matches a reclamation
+	// determines its action; reclamations that match no policy are left alone.
+	Policies []ReclamationPolicy
+
+	// An optional function that returns the tags attached to the resource identified by "crn"
+	// (e.g. by invoking the ListTags operation of the Global Tagging service).
+	TagLister func(ctx context.Context, crn string) ([]string, error)
+
+	// The "request_by" and "comment" recorded with each action.
+	RequestBy string
+	Comment   string
+
+	// Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// ReclamationManager : Applies policies to the pending reclamations of resource instances.
+type ReclamationManager struct {
+	client  *ResourceControllerV2
+	options ReclamationManagerOptions
+}
+
+// ReclamationDecision : The action selected for a pending reclamation, and its outcome.
+type ReclamationDecision struct {
+	Reclamation *PendingReclamation
+
+	// The policy that matched the reclamation and the action that it selected. Both are empty
+	// if no policy matched.
+	Policy string
+	Action string
+
+	// Whether the action was run, the resulting state of the reclamation and any error.
+	Executed bool
+	State    string
+	Err      error
+}
+
+// ReclamationReport : The decisions made by ReclamationManager.Plan or ReclamationManager.Apply.
+type ReclamationReport struct {
+	DryRun    bool
+	Decisions []ReclamationDecision
+}
+
+// NewReclamationManager returns a new ReclamationManager instance.
+func (resourceController *ResourceControllerV2) NewReclamationManager(options *ReclamationManagerOptions) *ReclamationManager {
+	manager := &ReclamationManager{
+		client: resourceController,
+	}
+	if options != nil {
+		manager.options = *options
+	}
+	if manager.options.Now == nil {
+		manager.options.Now = time.Now
+	}
+	return manager
+}
+
+// ListPending returns the scheduled reclamations, each joined with its resource instance and tags.
+func (manager *ReclamationManager) ListPending(ctx context.Context) (pending []*PendingReclamation, err error) {
+	listOptions := manager.client.NewListReclamationsOptions()
+	if manager.options.AccountID != "" {
+		listOptions.SetAccountID(manager.options.AccountID)
+	}
+	if manager.options.ResourceGroupID != "" {
+		listOptions.SetResourceGroupID(manager.options.ResourceGroupID)
+	}
+	reclamations, _, err := manager.client.ListReclamationsWithContext(ctx, listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-reclamations-error")
+		return
+	}
+
+	now := manager.options.Now()
+	for i := range reclamations.Resources {
+		reclamation := &reclamations.Resources[i]
+		if core.StringNilMapper(reclamation.State) != ReclamationStateScheduled {
+			continue
+		}
+		item := &PendingReclamation{
+			Reclamation: reclamation,
+		}
+		deletedAt := reclamation.CreatedAt
+
+		if reclamation.ResourceInstanceID != nil {
+			instance, response, getErr := manager.client.GetResourceInstanceWithContext(ctx, manager.client.NewGetResourceInstanceOptions(*reclamation.ResourceInstanceID))
+			if getErr != nil && (response == nil || response.StatusCode != 404) {
+				err = core.RepurposeSDKProblem(getErr, "get-instance-error")
+				return
+			}
+			item.Instance = instance
+			if instance != nil && instance.DeletedAt != nil {
+				deletedAt = instance.DeletedAt
+			}
+		}
+		if deletedAt != nil {
+			item.Age = now.Sub(time.Time(*deletedAt))
+		}
+
+		if manager.options.TagLister != nil {
+			crn := reclamation.EntityCRN
+			if crn == nil && item.Instance != nil {
+				crn = item.Instance.CRN
+			}
+			if crn != nil {
+				item.Tags, err = manager.options.TagLister(ctx, *crn)
+				if err != nil {
+					err = core.SDKErrorf(err, "", "list-tags-error", common.GetComponentInfo())
+					return
+				}
+			}
+		}
+		pending = append(pending, item)
+	}
+	return
+}
+
+// Plan returns the action that each policy would take, without running any actions.
+func (manager *ReclamationManager) Plan(ctx context.Context) (*ReclamationReport, error) {
+	return manager.run(ctx, true)
+}
+
+// Apply runs the action selected for each pending reclamation. An action that fails is
+// recorded in the report and does not prevent the remaining actions from being run.
+func (manager *ReclamationManager) Apply(ctx context.Context) (*ReclamationReport, error) {
+	return manager.run(ctx, false)
+}
+
+func (manager *ReclamationManager) run(ctx context.Context, dryRun bool) (report *ReclamationReport, err error) {
+	pending, err := manager.ListPending(ctx)
+	if err != nil {
+		return
+	}
+
+	report = &ReclamationReport{
+		DryRun: dryRun,
+	}
+	for _, reclamation := range pending {
+		decision := ReclamationDecision{
+			Reclamation: reclamation,
+			State:       core.StringNilMapper(reclamation.Reclamation.State),
+		}
+		for _, policy := range manager.options.Policies {
+			if policy.Matches(reclamation) {
+				decision.Policy = policy.Name
+				decision.Action = policy.Action
+				break
+			}
+		}
+
+		if !dryRun && decision.Action != "" {
+			actionOptions := manager.client.NewRunReclamationActionOptions(*reclamation.Reclamation.ID, decision.Action)
+			if manager.options.RequestBy != "" {
+				actionOptions.SetRequestBy(manager.options.RequestBy)
+			}
+			if manager.options.Comment != "" {
+				actionOptions.SetComment(manager.options.Comment)
+			}
+			result, _, actionErr := manager.client.RunReclamationActionWithContext(ctx, actionOptions)
+			if actionErr != nil {
+				decision.Err = core.RepurposeSDKProblem(actionErr, "reclamation-action-error")
+			} else {
+				decision.Executed = true
+				decision.State = core.StringNilMapper(result.State)
+			}
+		}
+		report.Decisions = append(report.Decisions, decision)
+	}
+	return
+}
+
+// Failed returns the decisions whose action failed.
+func (report *ReclamationReport) Failed() (failed []ReclamationDecision) {
+	for _, decision := range report.Decisions {
+		if decision.Err != nil {
+			failed = append(failed, decision)
+		}
+	}
+	return
+}
+
+// String returns a human-readable summary of the report, with one line per reclamation.
+func (report *ReclamationReport) String() string {
+	var builder strings.Builder
+	if report.DryRun {
+		builder.WriteString("Reclamation plan (dry run):\n")
+	} else {
+		builder.WriteString("Reclamation report:\n")
+	}
+	for _, decision := range report.Decisions {
+		name := core.StringNilMapper(decision.Reclamation.Reclamation.ResourceInstanceID)
+		if decision.Reclamation.Instance != nil && decision.Reclamation.Instance.Name != nil {
+			name = fmt.Sprintf("%s (%s)", *decision.Reclamation.Instance.Name, name)
+		}
+		action := "none"
+		if decision.Action != "" {
+			action = fmt.Sprintf("%s [%s]", decision.Action, decision.Policy)
+		}
+		fmt.Fprintf(&builder, "  %s: %s, age %s", name, action, decision.Reclamation.Age.Round(time.Hour))
+		switch {
+		case decision.Err != nil:
+			fmt.Fprintf(&builder, ", failed: %s", decision.Err.Error())
+		case decision.Executed:
+			fmt.Fprintf(&builder, ", state %s", decision.State)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
@@ -0,0 +1,175 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 ReclamationManager`, func() {
+	var fake *mockservers.ResourceControllerV2Server
+	var taggingFake *mockservers.GlobalTaggingV1Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	var managerOptions *resourcecontrollerv2.ReclamationManagerOptions
+	var prodInstance, devInstance *resourcecontrollerv2.ResourceInstance
+
+	BeforeEach(func() {
+		fake = mockservers.NewResourceControllerV2Server()
+		taggingFake = mockservers.NewGlobalTaggingV1Server()
+		var err error
+		resourceControllerService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+		globalTaggingService, err := taggingFake.NewClient()
+		Expect(err).To(BeNil())
+
+		createAndDelete := func(name string) *resourcecontrollerv2.ResourceInstance {
+			instance, _, err := resourceControllerService.CreateResourceInstance(
+				resourceControllerService.NewCreateResourceInstanceOptions(name, "us-south", "group-1", "plan-1"))
+			Expect(err).To(BeNil())
+			response, err := resourceControllerService.DeleteResourceInstance(
+				resourceControllerService.NewDeleteResourceInstanceOptions(*instance.ID))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(202))
+			return instance
+		}
+		prodInstance = createAndDelete("prod-instance")
+		devInstance = createAndDelete("dev-instance")
+		taggingFake.AttachTag("user", *prodInstance.CRN, "env:prod")
+		taggingFake.AttachTag("user", *devInstance.CRN, "env:dev")
+
+		managerOptions = &resourcecontrollerv2.ReclamationManagerOptions{
+			Policies: []resourcecontrollerv2.ReclamationPolicy{
+				resourcecontrollerv2.RestoreTaggedPolicy("env:prod"),
+				resourcecontrollerv2.ReclaimOlderThanPolicy(3 * 24 * time.Hour),
+			},
+			TagLister: func(ctx context.Context, crn string) (tags []string, err error) {
+				result, _, err := globalTaggingService.ListTagsWithContext(ctx, &globaltaggingv1.ListTagsOptions{
+					AttachedTo: &crn,
+				})
+				if err != nil {
+					return
+				}
+				for _, tag := range result.Items {
+					tags = append(tags, *tag.Name)
+				}
+				return
+			},
+			RequestBy: "test-user",
+			Comment:   "reclamation policy",
+		}
+	})
+	AfterEach(func() {
+		fake.Close()
+		taggingFake.Close()
+	})
+
+	decisionFor := func(report *resourcecontrollerv2.ReclamationReport, instance *resourcecontrollerv2.ResourceInstance) resourcecontrollerv2.ReclamationDecision {
+		for _, decision := range report.Decisions {
+			if *decision.Reclamation.Reclamation.ResourceInstanceID == *instance.GUID {
+				return decision
+			}
+		}
+		Fail("no decision for " + *instance.GUID)
+		return resourcecontrollerv2.ReclamationDecision{}
+	}
+
+	It(`List the pending reclamations with their resource instances and tags`, func() {
+		manager := resourceControllerService.NewReclamationManager(managerOptions)
+		pending, err := manager.ListPending(context.Background())
+		Expect(err).To(BeNil())
+		Expect(pending).To(HaveLen(2))
+		for _, reclamation := range pending {
+			Expect(reclamation.Instance).ToNot(BeNil())
+			Expect(*reclamation.Instance.State).To(Equal("pending_reclamation"))
+			Expect(reclamation.Age).To(BeNumerically("<", time.Minute))
+			if *reclamation.Instance.GUID == *prodInstance.GUID {
+				Expect(reclamation.Tags).To(Equal([]string{"env:prod"}))
+			} else {
+				Expect(reclamation.Tags).To(Equal([]string{"env:dev"}))
+			}
+		}
+	})
+	It(`Plan the actions without running them`, func() {
+		managerOptions.Now = func() time.Time {
+			return time.Now().Add(5 * 24 * time.Hour)
+		}
+		manager := resourceControllerService.NewReclamationManager(managerOptions)
+		report, err := manager.Plan(context.Background())
+		Expect(err).To(BeNil())
+		Expect(report.DryRun).To(BeTrue())
+		Expect(report.Decisions).To(HaveLen(2))
+
+		// The first matching policy wins, so the old production instance is restored.
+		prodDecision := decisionFor(report, prodInstance)
+		Expect(prodDecision.Action).To(Equal(resourcecontrollerv2.ReclamationActionRestore))
+		Expect(prodDecision.Policy).To(Equal("restore-tagged-env:prod"))
+		Expect(prodDecision.Executed).To(BeFalse())
+		devDecision := decisionFor(report, devInstance)
+		Expect(devDecision.Action).To(Equal(resourcecontrollerv2.ReclamationActionReclaim))
+		Expect(devDecision.State).To(Equal("SCHEDULED"))
+		Expect(report.String()).To(ContainSubstring("dev-instance (" + *devInstance.GUID + "): reclaim [reclaim-older-than-72h0m0s], age 120h0m0s"))
+
+		instance, _ := fake.ResourceInstance(*devInstance.ID)
+		Expect(*instance.State).To(Equal("pending_reclamation"))
+	})
+	It(`Apply the actions`, func() {
+		manager := resourceControllerService.NewReclamationManager(managerOptions)
+		report, err := manager.Apply(context.Background())
+		Expect(err).To(BeNil())
+		Expect(report.DryRun).To(BeFalse())
+		Expect(report.Failed()).To(BeEmpty())
+
+		prodDecision := decisionFor(report, prodInstance)
+		Expect(prodDecision.Executed).To(BeTrue())
+		Expect(prodDecision.State).To(Equal("RESTORING"))
+		instance, _ := fake.ResourceInstance(*prodInstance.ID)
+		Expect(*instance.State).To(Equal("active"))
+
+		// The development instance is too recent to be reclaimed.
+		devDecision := decisionFor(report, devInstance)
+		Expect(devDecision.Action).To(BeEmpty())
+		Expect(devDecision.Executed).To(BeFalse())
+		instance, _ = fake.ResourceInstance(*devInstance.ID)
+		Expect(*instance.State).To(Equal("pending_reclamation"))
+
+		managerOptions.Now = func() time.Time {
+			return time.Now().Add(5 * 24 * time.Hour)
+		}
+		report, err = resourceControllerService.NewReclamationManager(managerOptions).Apply(context.Background())
+		Expect(err).To(BeNil())
+		Expect(report.Decisions).To(HaveLen(1))
+		Expect(report.Decisions[0].State).To(Equal("RECLAIMING"))
+		instance, _ = fake.ResourceInstance(*devInstance.ID)
+		Expect(*instance.State).To(Equal("removed"))
+	})
+	It(`Fail when the tags cannot be listed`, func() {
+		managerOptions.TagLister = func(ctx context.Context, crn string) ([]string, error) {
+			return nil, errors.New("tagging unavailable")
+		}
+		_, err := resourceControllerService.NewReclamationManager(managerOptions).Plan(context.Background())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("tagging unavailable"))
+	})
+})
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -5667,6 +5668,19 @@ func (resourceController *ResourceControllerV2) NewResourceInstancesPager(option
 	return
 }
 
+// NewResourceInstancesPagerFromCheckpoint returns a new ResourceInstancesPager instance that resumes
+// from the position recorded by ResourceInstancesPager.Checkpoint().
+func (resourceController *ResourceControllerV2) NewResourceInstancesPagerFromCheckpoint(checkpoint []byte) (pager *ResourceInstancesPager, err error) {
+	pager = &ResourceInstancesPager{
+		client: resourceController,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListResourceInstancesOptions, string]("ResourceInstancesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *ResourceInstancesPager) HasNext() bool {
 	return pager.hasNext
@@ -5733,6 +5747,19 @@ func (pager *ResourceInstancesPager) GetAll() (allItems []ResourceInstance, err
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ResourceInstancesPager) All(ctx context.Context) iter.Seq2[ResourceInstance, error] {
+	return common.All[ResourceInstance](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewResourceInstancesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ResourceInstancesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ResourceInstancesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // ResourceAliasesForInstancePager can be used to simplify the use of the "ListResourceAliasesForInstance" method.
 type ResourceAliasesForInstancePager struct {
 	hasNext     bool
@@ -5759,6 +5786,19 @@ func (resourceController *ResourceControllerV2) NewResourceAliasesForInstancePag
 	return
 }
 
+// NewResourceAliasesForInstancePagerFromCheckpoint returns a new ResourceAliasesForInstancePager instance that resumes
+// from the position recorded by ResourceAliasesForInstancePager.Checkpoint().
+func (resourceController *ResourceControllerV2) NewResourceAliasesForInstancePagerFromCheckpoint(checkpoint []byte) (pager *ResourceAliasesForInstancePager, err error) {
+	pager = &ResourceAliasesForInstancePager{
+		client: resourceController,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListResourceAliasesForInstanceOptions, string]("ResourceAliasesForInstancePager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *ResourceAliasesForInstancePager) HasNext() bool {
 	return pager.hasNext
@@ -5825,6 +5865,19 @@ func (pager *ResourceAliasesForInstancePager) GetAll() (allItems []ResourceAlias
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ResourceAliasesForInstancePager) All(ctx context.Context) iter.Seq2[ResourceAlias, error] {
+	return common.All[ResourceAlias](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewResourceAliasesForInstancePagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ResourceAliasesForInstancePager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ResourceAliasesForInstancePager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // ResourceKeysForInstancePager can be used to simplify the use of the "ListResourceKeysForInstance" method.
 type ResourceKeysForInstancePager struct {
 	hasNext     bool
@@ -5851,6 +5904,19 @@ func (resourceController *ResourceControllerV2) NewResourceKeysForInstancePager(
 	return
 }
 
+// NewResourceKeysForInstancePagerFromCheckpoint returns a new ResourceKeysForInstancePager instance that resumes
+// from the position recorded by ResourceKeysForInstancePager.Checkpoint().
+func (resourceController *ResourceControllerV2) NewResourceKeysForInstancePagerFromCheckpoint(checkpoint []byte) (pager *ResourceKeysForInstancePager, err error) {
+	pager = &ResourceKeysForInstancePager{
+		client: resourceController,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListResourceKeysForInstanceOptions, string]("ResourceKeysForInstancePager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *ResourceKeysForInstancePager) HasNext() bool {
 	return pager.hasNext
@@ -5917,6 +5983,19 @@ func (pager *ResourceKeysForInstancePager) GetAll() (allItems []ResourceKey, err
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ResourceKeysForInstancePager) All(ctx context.Context) iter.Seq2[ResourceKey, error] {
+	return common.All[ResourceKey](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewResourceKeysForInstancePagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ResourceKeysForInstancePager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ResourceKeysForInstancePager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // ResourceKeysPager can be used to simplify the use of the "ListResourceKeys" method.
 type ResourceKeysPager struct {
 	hasNext     bool
@@ -5943,6 +6022,19 @@ func (resourceController *ResourceControllerV2) NewResourceKeysPager(options *Li
 	return
 }
 
+// NewResourceKeysPagerFromCheckpoint returns a new ResourceKeysPager instance that resumes
+// from the position recorded by ResourceKeysPager.Checkpoint().
+func (resourceController *ResourceControllerV2) NewResourceKeysPagerFromCheckpoint(checkpoint []byte) (pager *ResourceKeysPager, err error) {
+	pager = &ResourceKeysPager{
+		client: resourceController,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListResourceKeysOptions, string]("ResourceKeysPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *ResourceKeysPager) HasNext() bool {
 	return pager.hasNext
@@ -6009,6 +6101,19 @@ func (pager *ResourceKeysPager) GetAll() (allItems []ResourceKey, err error) {
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ResourceKeysPager) All(ctx context.Context) iter.Seq2[ResourceKey, error] {
+	return common.All[ResourceKey](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewResourceKeysPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ResourceKeysPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ResourceKeysPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // ResourceBindingsPager can be used to simplify the use of the "ListResourceBindings" method.
 type ResourceBindingsPager struct {
 	hasNext     bool
@@ -6035,6 +6140,19 @@ func (resourceController *ResourceControllerV2) NewResourceBindingsPager(options
 	return
 }
 
+// NewResourceBindingsPagerFromCheckpoint returns a new ResourceBindingsPager instance that resumes
+// from the position recorded by ResourceBindingsPager.Checkpoint().
+func (resourceController *ResourceControllerV2) NewResourceBindingsPagerFromCheckpoint(checkpoint []byte) (pager *ResourceBindingsPager, err error) {
+	pager = &ResourceBindingsPager{
+		client: resourceController,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListResourceBindingsOptions, string]("ResourceBindingsPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *ResourceBindingsPager) HasNext() bool {
 	return pager.hasNext
@@ -6101,6 +6219,19 @@ func (pager *ResourceBindingsPager) GetAll() (allItems []ResourceBinding, err er
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ResourceBindingsPager) All(ctx context.Context) iter.Seq2[ResourceBinding, error] {
+	return common.All[ResourceBinding](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewResourceBindingsPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ResourceBindingsPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ResourceBindingsPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // ResourceAliasesPager can be used to simplify the use of the "ListResourceAliases" method.
 type ResourceAliasesPager struct {
 	hasNext     bool
@@ -6127,6 +6258,19 @@ func (resourceController *ResourceControllerV2) NewResourceAliasesPager(options
 	return
 }
 
+// NewResourceAliasesPagerFromCheckpoint returns a new ResourceAliasesPager instance that resumes
+// from the position recorded by ResourceAliasesPager.Checkpoint().
+func (resourceController *ResourceControllerV2) NewResourceAliasesPagerFromCheckpoint(checkpoint []byte) (pager *ResourceAliasesPager, err error) {
+	pager = &ResourceAliasesPager{
+		client: resourceController,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListResourceAliasesOptions, string]("ResourceAliasesPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *ResourceAliasesPager) HasNext() bool {
 	return pager.hasNext
@@ -6193,6 +6337,19 @@ func (pager *ResourceAliasesPager) GetAll() (allItems []ResourceAlias, err error
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ResourceAliasesPager) All(ctx context.Context) iter.Seq2[ResourceAlias, error] {
+	return common.All[ResourceAlias](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewResourceAliasesPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ResourceAliasesPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ResourceAliasesPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 // ResourceBindingsForAliasPager can be used to simplify the use of the "ListResourceBindingsForAlias" method.
 type ResourceBindingsForAliasPager struct {
 	hasNext     bool
@@ -6219,6 +6376,19 @@ func (resourceController *ResourceControllerV2) NewResourceBindingsForAliasPager
 	return
 }
 
+// NewResourceBindingsForAliasPagerFromCheckpoint returns a new ResourceBindingsForAliasPager instance that resumes
+// from the position recorded by ResourceBindingsForAliasPager.Checkpoint().
+func (resourceController *ResourceControllerV2) NewResourceBindingsForAliasPagerFromCheckpoint(checkpoint []byte) (pager *ResourceBindingsForAliasPager, err error) {
+	pager = &ResourceBindingsForAliasPager{
+		client: resourceController,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListResourceBindingsForAliasOptions, string]("ResourceBindingsForAliasPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *ResourceBindingsForAliasPager) HasNext() bool {
 	return pager.hasNext
@@ -6284,3 +6454,16 @@ func (pager *ResourceBindingsForAliasPager) GetAll() (allItems []ResourceBinding
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *ResourceBindingsForAliasPager) All(ctx context.Context) iter.Seq2[ResourceBinding, error] {
+	return common.All[ResourceBinding](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewResourceBindingsForAliasPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *ResourceBindingsForAliasPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("ResourceBindingsForAliasPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
@@ -0,0 +1,118 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.94.1-71478489-20240820-161623
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ResourceControllerV2API : The operations supported by the ResourceControllerV2 service. This interface is satisfied by
+// *ResourceControllerV2 and may be used to substitute a fake or a decorator for the service client.
+type ResourceControllerV2API interface {
+	// ListResourceInstancesWithContext : Get a list of all resource instances
+	ListResourceInstancesWithContext(ctx context.Context, listResourceInstancesOptions *ListResourceInstancesOptions) (result *ResourceInstancesList, response *core.DetailedResponse, err error)
+
+	// CreateResourceInstanceWithContext : Create (provision) a new resource instance
+	CreateResourceInstanceWithContext(ctx context.Context, createResourceInstanceOptions *CreateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// GetResourceInstanceWithContext : Get a resource instance
+	GetResourceInstanceWithContext(ctx context.Context, getResourceInstanceOptions *GetResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// DeleteResourceInstanceWithContext : Delete a resource instance
+	DeleteResourceInstanceWithContext(ctx context.Context, deleteResourceInstanceOptions *DeleteResourceInstanceOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateResourceInstanceWithContext : Update a resource instance
+	UpdateResourceInstanceWithContext(ctx context.Context, updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// ListResourceAliasesForInstanceWithContext : Get a list of all resource aliases for the instance
+	ListResourceAliasesForInstanceWithContext(ctx context.Context, listResourceAliasesForInstanceOptions *ListResourceAliasesForInstanceOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+
+	// ListResourceKeysForInstanceWithContext : Get a list of all the resource keys for the instance
+	ListResourceKeysForInstanceWithContext(ctx context.Context, listResourceKeysForInstanceOptions *ListResourceKeysForInstanceOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+
+	// LockResourceInstanceWithContext : Lock a resource instance
+	LockResourceInstanceWithContext(ctx context.Context, lockResourceInstanceOptions *LockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// UnlockResourceInstanceWithContext : Unlock a resource instance
+	UnlockResourceInstanceWithContext(ctx context.Context, unlockResourceInstanceOptions *UnlockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// CancelLastopResourceInstanceWithContext : Cancel the in progress last operation of the resource instance
+	CancelLastopResourceInstanceWithContext(ctx context.Context, cancelLastopResourceInstanceOptions *CancelLastopResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error)
+
+	// ListResourceKeysWithContext : Get a list of all of the resource keys
+	ListResourceKeysWithContext(ctx context.Context, listResourceKeysOptions *ListResourceKeysOptions) (result *ResourceKeysList, response *core.DetailedResponse, err error)
+
+	// CreateResourceKeyWithContext : Create a new resource key
+	CreateResourceKeyWithContext(ctx context.Context, createResourceKeyOptions *CreateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// GetResourceKeyWithContext : Get resource key
+	GetResourceKeyWithContext(ctx context.Context, getResourceKeyOptions *GetResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// DeleteResourceKeyWithContext : Delete a resource key
+	DeleteResourceKeyWithContext(ctx context.Context, deleteResourceKeyOptions *DeleteResourceKeyOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateResourceKeyWithContext : Update a resource key
+	UpdateResourceKeyWithContext(ctx context.Context, updateResourceKeyOptions *UpdateResourceKeyOptions) (result *ResourceKey, response *core.DetailedResponse, err error)
+
+	// ListResourceBindingsWithContext : Get a list of all resource bindings
+	ListResourceBindingsWithContext(ctx context.Context, listResourceBindingsOptions *ListResourceBindingsOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+
+	// CreateResourceBindingWithContext : Create a new resource binding
+	CreateResourceBindingWithContext(ctx context.Context, createResourceBindingOptions *CreateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// GetResourceBindingWithContext : Get a resource binding
+	GetResourceBindingWithContext(ctx context.Context, getResourceBindingOptions *GetResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// DeleteResourceBindingWithContext : Delete a resource binding
+	DeleteResourceBindingWithContext(ctx context.Context, deleteResourceBindingOptions *DeleteResourceBindingOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateResourceBindingWithContext : Update a resource binding
+	UpdateResourceBindingWithContext(ctx context.Context, updateResourceBindingOptions *UpdateResourceBindingOptions) (result *ResourceBinding, response *core.DetailedResponse, err error)
+
+	// ListResourceAliasesWithContext : Get a list of all resource aliases
+	ListResourceAliasesWithContext(ctx context.Context, listResourceAliasesOptions *ListResourceAliasesOptions) (result *ResourceAliasesList, response *core.DetailedResponse, err error)
+
+	// CreateResourceAliasWithContext : Create a new resource alias
+	CreateResourceAliasWithContext(ctx context.Context, createResourceAliasOptions *CreateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// GetResourceAliasWithContext : Get a resource alias
+	GetResourceAliasWithContext(ctx context.Context, getResourceAliasOptions *GetResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// DeleteResourceAliasWithContext : Delete a resource alias
+	DeleteResourceAliasWithContext(ctx context.Context, deleteResourceAliasOptions *DeleteResourceAliasOptions) (response *core.DetailedResponse, err error)
+
+	// UpdateResourceAliasWithContext : Update a resource alias
+	UpdateResourceAliasWithContext(ctx context.Context, updateResourceAliasOptions *UpdateResourceAliasOptions) (result *ResourceAlias, response *core.DetailedResponse, err error)
+
+	// ListResourceBindingsForAliasWithContext : Get a list of all resource bindings for the alias
+	ListResourceBindingsForAliasWithContext(ctx context.Context, listResourceBindingsForAliasOptions *ListResourceBindingsForAliasOptions) (result *ResourceBindingsList, response *core.DetailedResponse, err error)
+
+	// ListReclamationsWithContext : Get a list of all reclamations
+	ListReclamationsWithContext(ctx context.Context, listReclamationsOptions *ListReclamationsOptions) (result *ReclamationsList, response *core.DetailedResponse, err error)
+
+	// RunReclamationActionWithContext : Perform a reclamation action
+	RunReclamationActionWithContext(ctx context.Context, runReclamationActionOptions *RunReclamationActionOptions) (result *Reclamation, response *core.DetailedResponse, err error)
+}
+
+// Verify that ResourceControllerV2 implements the ResourceControllerV2API interface.
+var _ ResourceControllerV2API = (*ResourceControllerV2)(nil)
@@ -540,6 +540,53 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceInstancesPager.Checkpoint successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceInstancesOptionsModel := &resourcecontrollerv2.ListResourceInstancesOptions{
+					GUID:            core.StringPtr("testString"),
+					Name:            core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					ResourceID:      core.StringPtr("testString"),
+					ResourcePlanID:  core.StringPtr("testString"),
+					Type:            core.StringPtr("testString"),
+					SubType:         core.StringPtr("testString"),
+					Limit:           core.Int64Ptr(int64(10)),
+					State:           core.StringPtr("active"),
+					UpdatedFrom:     core.StringPtr("2021-01-01"),
+					UpdatedTo:       core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceInstancesPager(listResourceInstancesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceInstance
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = resourceControllerService.NewResourceInstancesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use ResourceInstancesPager.GetAll successfully`, func() {
 				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
 					URL:           testServer.URL,
@@ -571,6 +618,39 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceInstancesPager.All successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceInstancesOptionsModel := &resourcecontrollerv2.ListResourceInstancesOptions{
+					GUID:            core.StringPtr("testString"),
+					Name:            core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					ResourceID:      core.StringPtr("testString"),
+					ResourcePlanID:  core.StringPtr("testString"),
+					Type:            core.StringPtr("testString"),
+					SubType:         core.StringPtr("testString"),
+					Limit:           core.Int64Ptr(int64(10)),
+					State:           core.StringPtr("active"),
+					UpdatedFrom:     core.StringPtr("2021-01-01"),
+					UpdatedTo:       core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceInstancesPager(listResourceInstancesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceInstance
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateResourceInstance(createResourceInstanceOptions *CreateResourceInstanceOptions) - Operation response error`, func() {
@@ -1706,6 +1786,44 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceAliasesForInstancePager.Checkpoint successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceAliasesForInstanceOptionsModel := &resourcecontrollerv2.ListResourceAliasesForInstanceOptions{
+					ID:    core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := resourceControllerService.NewResourceAliasesForInstancePager(listResourceAliasesForInstanceOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceAlias
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = resourceControllerService.NewResourceAliasesForInstancePagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use ResourceAliasesForInstancePager.GetAll successfully`, func() {
 				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
 					URL:           testServer.URL,
@@ -1728,6 +1846,30 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceAliasesForInstancePager.All successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceAliasesForInstanceOptionsModel := &resourcecontrollerv2.ListResourceAliasesForInstanceOptions{
+					ID:    core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := resourceControllerService.NewResourceAliasesForInstancePager(listResourceAliasesForInstanceOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceAlias
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListResourceKeysForInstance(listResourceKeysForInstanceOptions *ListResourceKeysForInstanceOptions) - Operation response error`, func() {
@@ -2031,6 +2173,44 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceKeysForInstancePager.Checkpoint successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceKeysForInstanceOptionsModel := &resourcecontrollerv2.ListResourceKeysForInstanceOptions{
+					ID:    core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := resourceControllerService.NewResourceKeysForInstancePager(listResourceKeysForInstanceOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceKey
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = resourceControllerService.NewResourceKeysForInstancePagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use ResourceKeysForInstancePager.GetAll successfully`, func() {
 				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
 					URL:           testServer.URL,
@@ -2053,6 +2233,30 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceKeysForInstancePager.All successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceKeysForInstanceOptionsModel := &resourcecontrollerv2.ListResourceKeysForInstanceOptions{
+					ID:    core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := resourceControllerService.NewResourceKeysForInstancePager(listResourceKeysForInstanceOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceKey
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`LockResourceInstance(lockResourceInstanceOptions *LockResourceInstanceOptions) - Operation response error`, func() {
@@ -3033,6 +3237,49 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceKeysPager.Checkpoint successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceKeysOptionsModel := &resourcecontrollerv2.ListResourceKeysOptions{
+					GUID:            core.StringPtr("testString"),
+					Name:            core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					ResourceID:      core.StringPtr("testString"),
+					Limit:           core.Int64Ptr(int64(10)),
+					UpdatedFrom:     core.StringPtr("2021-01-01"),
+					UpdatedTo:       core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceKeysPager(listResourceKeysOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceKey
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = resourceControllerService.NewResourceKeysPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use ResourceKeysPager.GetAll successfully`, func() {
 				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
 					URL:           testServer.URL,
@@ -3060,6 +3307,35 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceKeysPager.All successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceKeysOptionsModel := &resourcecontrollerv2.ListResourceKeysOptions{
+					GUID:            core.StringPtr("testString"),
+					Name:            core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					ResourceID:      core.StringPtr("testString"),
+					Limit:           core.Int64Ptr(int64(10)),
+					UpdatedFrom:     core.StringPtr("2021-01-01"),
+					UpdatedTo:       core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceKeysPager(listResourceKeysOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceKey
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateResourceKey(createResourceKeyOptions *CreateResourceKeyOptions) - Operation response error`, func() {
@@ -4226,6 +4502,50 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceBindingsPager.Checkpoint successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceBindingsOptionsModel := &resourcecontrollerv2.ListResourceBindingsOptions{
+					GUID:            core.StringPtr("testString"),
+					Name:            core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					ResourceID:      core.StringPtr("testString"),
+					RegionBindingID: core.StringPtr("testString"),
+					Limit:           core.Int64Ptr(int64(10)),
+					UpdatedFrom:     core.StringPtr("2021-01-01"),
+					UpdatedTo:       core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceBindingsPager(listResourceBindingsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceBinding
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = resourceControllerService.NewResourceBindingsPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use ResourceBindingsPager.GetAll successfully`, func() {
 				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
 					URL:           testServer.URL,
@@ -4254,6 +4574,36 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceBindingsPager.All successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceBindingsOptionsModel := &resourcecontrollerv2.ListResourceBindingsOptions{
+					GUID:            core.StringPtr("testString"),
+					Name:            core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					ResourceID:      core.StringPtr("testString"),
+					RegionBindingID: core.StringPtr("testString"),
+					Limit:           core.Int64Ptr(int64(10)),
+					UpdatedFrom:     core.StringPtr("2021-01-01"),
+					UpdatedTo:       core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceBindingsPager(listResourceBindingsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceBinding
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateResourceBinding(createResourceBindingOptions *CreateResourceBindingOptions) - Operation response error`, func() {
@@ -5434,6 +5784,51 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceAliasesPager.Checkpoint successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceAliasesOptionsModel := &resourcecontrollerv2.ListResourceAliasesOptions{
+					GUID:               core.StringPtr("testString"),
+					Name:               core.StringPtr("testString"),
+					ResourceInstanceID: core.StringPtr("testString"),
+					RegionInstanceID:   core.StringPtr("testString"),
+					ResourceID:         core.StringPtr("testString"),
+					ResourceGroupID:    core.StringPtr("testString"),
+					Limit:              core.Int64Ptr(int64(10)),
+					UpdatedFrom:        core.StringPtr("2021-01-01"),
+					UpdatedTo:          core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceAliasesPager(listResourceAliasesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceAlias
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = resourceControllerService.NewResourceAliasesPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use ResourceAliasesPager.GetAll successfully`, func() {
 				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
 					URL:           testServer.URL,
@@ -5463,6 +5858,37 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceAliasesPager.All successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceAliasesOptionsModel := &resourcecontrollerv2.ListResourceAliasesOptions{
+					GUID:               core.StringPtr("testString"),
+					Name:               core.StringPtr("testString"),
+					ResourceInstanceID: core.StringPtr("testString"),
+					RegionInstanceID:   core.StringPtr("testString"),
+					ResourceID:         core.StringPtr("testString"),
+					ResourceGroupID:    core.StringPtr("testString"),
+					Limit:              core.Int64Ptr(int64(10)),
+					UpdatedFrom:        core.StringPtr("2021-01-01"),
+					UpdatedTo:          core.StringPtr("2021-01-01"),
+				}
+
+				pager, err := resourceControllerService.NewResourceAliasesPager(listResourceAliasesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceAlias
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`CreateResourceAlias(createResourceAliasOptions *CreateResourceAliasOptions) - Operation response error`, func() {
@@ -6552,6 +6978,44 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceBindingsForAliasPager.Checkpoint successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceBindingsForAliasOptionsModel := &resourcecontrollerv2.ListResourceBindingsForAliasOptions{
+					ID:    core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := resourceControllerService.NewResourceBindingsForAliasPager(listResourceBindingsForAliasOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceBinding
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = resourceControllerService.NewResourceBindingsForAliasPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use ResourceBindingsForAliasPager.GetAll successfully`, func() {
 				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
 					URL:           testServer.URL,
@@ -6574,6 +7038,30 @@ var _ = Describe(`ResourceControllerV2`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use ResourceBindingsForAliasPager.All successfully`, func() {
+				resourceControllerService, serviceErr := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(resourceControllerService).ToNot(BeNil())
+
+				listResourceBindingsForAliasOptionsModel := &resourcecontrollerv2.ListResourceBindingsForAliasOptions{
+					ID:    core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := resourceControllerService.NewResourceBindingsForAliasPager(listResourceBindingsForAliasOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []resourcecontrollerv2.ResourceBinding
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`ListReclamations(listReclamationsOptions *ListReclamationsOptions) - Operation response error`, func() {
@@ -0,0 +1,185 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The default values of the WaitOptions fields.
+const (
+	DefaultWaitPollInterval    = 5 * time.Second
+	DefaultWaitMaxPollInterval = time.Minute
+	DefaultWaitBackoffFactor   = 1.5
+	DefaultWaitJitter          = 0.2
+)
+
+// WaitOptions : The options for the WaitForResourceInstanceState method.
+type WaitOptions struct {
+	// The interval before the second poll, used when the last operation of the resource instance
+	// does not specify "poll_after". Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+
+	// The maximum interval between polls. Defaults to DefaultWaitMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// The factor by which the interval grows after each poll. Defaults to DefaultWaitBackoffFactor.
+	BackoffFactor float64
+
+	// The fraction (0 to 1) by which each interval is randomly shortened or lengthened.
+	// Defaults to DefaultWaitJitter; a negative value disables jitter.
+	Jitter float64
+
+	// If true, the in-progress last operation of the resource instance is cancelled (see
+	// CancelLastopResourceInstance) when the context is done before the target state is reached.
+	CancelOnTimeout bool
+
+	// An optional function that is invoked with the resource instance retrieved by each poll.
+	OnPoll func(instance *ResourceInstance)
+}
+
+// ResourceInstanceFailedError : The error returned by WaitForResourceInstanceState when the
+// resource instance, or its last operation, has failed.
+type ResourceInstanceFailedError struct {
+	// The resource instance, as retrieved by the last poll.
+	Instance *ResourceInstance
+}
+
+// Error implements the error interface.
+func (e *ResourceInstanceFailedError) Error() string {
+	msg := fmt.Sprintf("resource instance '%s' is in state '%s'", core.StringNilMapper(e.Instance.ID), core.StringNilMapper(e.Instance.State))
+	if lastOperation := e.Instance.LastOperation; lastOperation != nil {
+		msg += fmt.Sprintf(": %s operation %s", core.StringNilMapper(lastOperation.Type), core.StringNilMapper(lastOperation.State))
+		if lastOperation.Description != nil {
+			msg += ": " + *lastOperation.Description
+		}
+		if lastOperation.ReasonCode != nil {
+			msg += fmt.Sprintf(" (%s)", *lastOperation.ReasonCode)
+		}
+	}
+	return msg
+}
+
+// WaitForResourceInstanceState polls the resource instance identified by "id" until its state is
+// one of "targetStates" and its last operation is no longer in progress, and returns the instance.
+//
+// Between polls, the method waits for the interval specified by the "poll_after" field of the
+// last operation, or else for an interval that grows exponentially, with random jitter applied
+// to either. If the instance or its last operation fails (and "failed" is not a target state),
+// an error wrapping a *ResourceInstanceFailedError is returned. If "ctx" is done first, the
+// context's error is returned, after the last operation has been cancelled if
+// options.CancelOnTimeout is set.
+func (resourceController *ResourceControllerV2) WaitForResourceInstanceState(ctx context.Context, id string, targetStates []string, options *WaitOptions) (result *ResourceInstance, err error) {
+	if len(targetStates) == 0 {
+		err = core.SDKErrorf(nil, "at least one target state must be specified", "no-target-states", common.GetComponentInfo())
+		return
+	}
+	if options == nil {
+		options = &WaitOptions{}
+	}
+	interval := options.PollInterval
+	if interval <= 0 {
+		interval = DefaultWaitPollInterval
+	}
+	maxInterval := options.MaxPollInterval
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxPollInterval
+	}
+	backoffFactor := options.BackoffFactor
+	if backoffFactor < 1 {
+		backoffFactor = DefaultWaitBackoffFactor
+	}
+	jitter := options.Jitter
+	if jitter == 0 {
+		jitter = DefaultWaitJitter
+	}
+
+	getOptions := resourceController.NewGetResourceInstanceOptions(id)
+	for {
+		var instance *ResourceInstance
+		instance, _, err = resourceController.GetResourceInstanceWithContext(ctx, getOptions)
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, resourceController.waitTimedOut(ctx, result, options)
+			}
+			err = core.RepurposeSDKProblem(err, "get-instance-error")
+			return
+		}
+		result = instance
+		if options.OnPoll != nil {
+			options.OnPoll(instance)
+		}
+
+		state := core.StringNilMapper(instance.State)
+		lastOperationState := ""
+		if instance.LastOperation != nil {
+			lastOperationState = core.StringNilMapper(instance.LastOperation.State)
+		}
+		if slices.Contains(targetStates, state) && lastOperationState != ResourceInstanceLastOperationStateInProgressConst {
+			return
+		}
+		if state == ResourceInstanceStateFailedConst || lastOperationState == ResourceInstanceLastOperationStateFailedConst {
+			err = core.SDKErrorf(&ResourceInstanceFailedError{Instance: instance}, "", "resource-instance-failed", common.GetComponentInfo())
+			return
+		}
+
+		// The service's "poll_after" hint takes precedence over the backoff interval.
+		delay := interval
+		if instance.LastOperation != nil && instance.LastOperation.PollAfter != nil && *instance.LastOperation.PollAfter > 0 {
+			delay = time.Duration(*instance.LastOperation.PollAfter * float64(time.Second))
+		}
+		if jitter > 0 {
+			delay = time.Duration(float64(delay) * (1 + jitter*(2*rand.Float64()-1)))
+		}
+		interval = min(time.Duration(float64(interval)*backoffFactor), maxInterval)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, resourceController.waitTimedOut(ctx, result, options)
+		case <-timer.C:
+		}
+	}
+}
+
+// waitTimedOut returns the error for a wait whose context is done, after cancelling the last
+// operation of "instance" if requested.
+func (resourceController *ResourceControllerV2) waitTimedOut(ctx context.Context, instance *ResourceInstance, options *WaitOptions) error {
+	err := core.SDKErrorf(ctx.Err(), "", "wait-timed-out", common.GetComponentInfo())
+	if !options.CancelOnTimeout || instance == nil || instance.LastOperation == nil ||
+		instance.LastOperation.Cancelable == nil || !*instance.LastOperation.Cancelable {
+		return err
+	}
+
+	// The cancellation must not be bound by the context that has just expired.
+	cancelCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
+	defer cancel()
+	_, _, cancelErr := resourceController.CancelLastopResourceInstanceWithContext(cancelCtx, resourceController.NewCancelLastopResourceInstanceOptions(*instance.ID))
+	if cancelErr != nil {
+		errMsg := fmt.Sprintf("%s; the last operation could not be cancelled: %s", ctx.Err().Error(), cancelErr.Error())
+		return core.SDKErrorf(ctx.Err(), errMsg, "wait-cancel-error", common.GetComponentInfo())
+	}
+	return err
+}
@@ -0,0 +1,148 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceControllerV2 WaitForResourceInstanceState`, func() {
+	var fake *mockservers.ResourceControllerV2Server
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	var waitOptions *resourcecontrollerv2.WaitOptions
+	var polls int
+
+	BeforeEach(func() {
+		fake = mockservers.NewResourceControllerV2Server()
+		var err error
+		resourceControllerService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+
+		polls = 0
+		waitOptions = &resourcecontrollerv2.WaitOptions{
+			PollInterval: time.Millisecond,
+			Jitter:       -1,
+			OnPoll: func(instance *resourcecontrollerv2.ResourceInstance) {
+				polls++
+			},
+		}
+	})
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	createInstance := func() *resourcecontrollerv2.ResourceInstance {
+		instance, response, err := resourceControllerService.CreateResourceInstance(
+			resourceControllerService.NewCreateResourceInstanceOptions("test-instance", "us-south", "group-1", "plan-1"))
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(202))
+		return instance
+	}
+
+	It(`Wait until a resource instance is provisioned`, func() {
+		fake.SetProvisioningPolls(3)
+		instance := createInstance()
+
+		result, err := resourceControllerService.WaitForResourceInstanceState(context.Background(), *instance.ID, []string{"active"}, waitOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal("active"))
+		Expect(*result.LastOperation.State).To(Equal("succeeded"))
+		Expect(polls).To(Equal(3))
+	})
+	It(`Return a typed error when the resource instance fails`, func() {
+		fake.SetProvisioningPolls(3)
+		instance := createInstance()
+		lastOperation := &resourcecontrollerv2.ResourceInstanceLastOperation{
+			Type:        core.StringPtr("create"),
+			State:       core.StringPtr("failed"),
+			Description: core.StringPtr("quota exceeded"),
+			ReasonCode:  core.StringPtr("E1234"),
+		}
+		Expect(fake.SetResourceInstanceState(*instance.ID, "failed", lastOperation)).To(BeTrue())
+
+		result, err := resourceControllerService.WaitForResourceInstanceState(context.Background(), *instance.ID, []string{"active"}, waitOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("quota exceeded (E1234)"))
+		Expect(*result.State).To(Equal("failed"))
+
+		var failedErr *resourcecontrollerv2.ResourceInstanceFailedError
+		Expect(errors.As(err, &failedErr)).To(BeTrue())
+		Expect(*failedErr.Instance.ID).To(Equal(*instance.ID))
+
+		// A failed resource instance can be the target state.
+		result, err = resourceControllerService.WaitForResourceInstanceState(context.Background(), *instance.ID, []string{"active", "failed"}, waitOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal("failed"))
+	})
+	It(`Honor the poll_after hint of the last operation`, func() {
+		fake.SetProvisioningPolls(1000)
+		instance := createInstance()
+		Expect(fake.SetResourceInstanceState(*instance.ID, "provisioning", &resourcecontrollerv2.ResourceInstanceLastOperation{
+			Type:      core.StringPtr("create"),
+			State:     core.StringPtr("in progress"),
+			PollAfter: core.Float64Ptr(0.01),
+		})).To(BeTrue())
+
+		// Without the hint, the second poll would not be made for an hour.
+		waitOptions.PollInterval = time.Hour
+		waitOptions.OnPoll = func(result *resourcecontrollerv2.ResourceInstance) {
+			polls++
+			if polls == 2 {
+				fake.SetResourceInstanceState(*instance.ID, "active", &resourcecontrollerv2.ResourceInstanceLastOperation{
+					Type:  core.StringPtr("create"),
+					State: core.StringPtr("succeeded"),
+				})
+			}
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		result, err := resourceControllerService.WaitForResourceInstanceState(ctx, *instance.ID, []string{"active"}, waitOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.State).To(Equal("active"))
+		Expect(polls).To(Equal(3))
+	})
+	It(`Cancel the last operation when the context times out`, func() {
+		fake.SetProvisioningPolls(1000)
+		instance := createInstance()
+
+		waitOptions.PollInterval = 5 * time.Millisecond
+		waitOptions.CancelOnTimeout = true
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		result, err := resourceControllerService.WaitForResourceInstanceState(ctx, *instance.ID, []string{"active"}, waitOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(*result.State).To(Equal("provisioning"))
+
+		cancelled, found := fake.ResourceInstance(*instance.ID)
+		Expect(found).To(BeTrue())
+		Expect(*cancelled.State).To(Equal("failed"))
+		Expect(*cancelled.LastOperation.Description).To(Equal("the operation was cancelled"))
+	})
+	It(`Require at least one target state`, func() {
+		_, err := resourceControllerService.WaitForResourceInstanceState(context.Background(), "instance-1", nil, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
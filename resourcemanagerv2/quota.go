@@ -0,0 +1,299 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2
+
+import (
+	"context"
+	"math"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// QuotaResourceIDServiceInstances is the ResourceID of the QuotaUsage that compares the number of
+// resource instances in the account with the NumberOfServiceInstances of a quota definition.
+const QuotaResourceIDServiceInstances = "number_of_service_instances"
+
+// QuotaEvaluatorOptions : The options used to create a QuotaEvaluator.
+type QuotaEvaluatorOptions struct {
+	// The client used to count the resource instances in each resource group.
+	ResourceController *resourcecontrollerv2.ResourceControllerV2
+
+	// The account whose resource groups are evaluated by EvaluateAccount and whose resource
+	// instances count against the NumberOfServiceInstances of the quota definitions. Defaults to
+	// the account of the clients' credentials.
+	AccountID string
+}
+
+// QuotaEvaluator : Compares the resource instances in resource groups with the resource quotas of
+// the groups' quota definitions.
+type QuotaEvaluator struct {
+	resourceManager  *ResourceManagerV2
+	options          QuotaEvaluatorOptions
+	quotaDefinitions map[string]*QuotaDefinition
+
+	// The number of resource instances in the account, or -1 if they have not been counted yet.
+	// The instances are counted again by each invocation of EvaluateAccount and
+	// EvaluateResourceGroup.
+	accountInstances int
+}
+
+// QuotaUsage : The usage of a single resource quota by a resource group.
+type QuotaUsage struct {
+	// The resource (catalog resource ID or resource plan ID) to which the quota applies, or
+	// QuotaResourceIDServiceInstances for the limit on the number of resource instances.
+	ResourceID string
+
+	// The limit set by the quota and the number of resource instances that count against it.
+	Limit float64
+	Used  float64
+
+	// The number of resource instances that can still be created (Limit - Used). It is negative
+	// if the quota is exceeded.
+	Headroom float64
+
+	// The percentage of the limit that is used. It is +Inf if the limit is 0 and instances exist.
+	PercentUsed float64
+}
+
+// Exceeded returns true if more resource instances exist than the quota allows.
+func (usage QuotaUsage) Exceeded() bool {
+	return usage.Used > usage.Limit
+}
+
+// QuotaEvaluation : The usage of the resource quotas of a resource group.
+type QuotaEvaluation struct {
+	ResourceGroupID   string
+	ResourceGroupName string
+
+	// The quota definition of the resource group, or nil if it has none.
+	QuotaDefinition *QuotaDefinition
+
+	// The usage of the limit on the number of resource instances in the account, if the quota
+	// definition sets one, followed by the usage of each resource quota, in the order of the
+	// quota definition.
+	Usage []QuotaUsage
+
+	// The quotas that are exceeded.
+	Violations []QuotaUsage
+
+	// The number of resource instances in the resource group, in total, by catalog resource ID
+	// and by resource plan ID.
+	InstanceCount  int
+	ResourceCounts map[string]int
+	PlanCounts     map[string]int
+}
+
+// UsageOf returns the usage of the quota for "resourceID" (a catalog resource ID or resource plan
+// ID), and false if the resource group has no such quota.
+func (evaluation *QuotaEvaluation) UsageOf(resourceID string) (QuotaUsage, bool) {
+	for _, usage := range evaluation.Usage {
+		if usage.ResourceID == resourceID {
+			return usage, true
+		}
+	}
+	return QuotaUsage{}, false
+}
+
+// Fits returns true if "count" more instances of the resource plan "resourcePlanID" of the
+// catalog resource "resourceID" can be created without exceeding a quota of the resource group.
+func (evaluation *QuotaEvaluation) Fits(resourceID string, resourcePlanID string, count int) bool {
+	for _, id := range []string{QuotaResourceIDServiceInstances, resourceID, resourcePlanID} {
+		if usage, found := evaluation.UsageOf(id); found && usage.Headroom < float64(count) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewQuotaEvaluator returns a new QuotaEvaluator instance.
+func (resourceManager *ResourceManagerV2) NewQuotaEvaluator(options *QuotaEvaluatorOptions) (evaluator *QuotaEvaluator, err error) {
+	if options == nil || options.ResourceController == nil {
+		err = core.SDKErrorf(nil, "a ResourceController client must be specified", "no-resource-controller", common.GetComponentInfo())
+		return
+	}
+	evaluator = &QuotaEvaluator{
+		resourceManager:  resourceManager,
+		options:          *options,
+		quotaDefinitions: make(map[string]*QuotaDefinition),
+		accountInstances: -1,
+	}
+	return
+}
+
+// EvaluateAccount evaluates the quota usage of each resource group of the account.
+func (evaluator *QuotaEvaluator) EvaluateAccount(ctx context.Context) (evaluations []*QuotaEvaluation, err error) {
+	evaluator.accountInstances = -1
+	quotaDefinitions, _, err := evaluator.resourceManager.ListQuotaDefinitionsWithContext(ctx, evaluator.resourceManager.NewListQuotaDefinitionsOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-quota-definitions-error")
+		return
+	}
+	for i := range quotaDefinitions.Resources {
+		quotaDefinition := &quotaDefinitions.Resources[i]
+		evaluator.quotaDefinitions[core.StringNilMapper(quotaDefinition.ID)] = quotaDefinition
+	}
+
+	listOptions := evaluator.resourceManager.NewListResourceGroupsOptions()
+	if evaluator.options.AccountID != "" {
+		listOptions.SetAccountID(evaluator.options.AccountID)
+	}
+	resourceGroups, _, err := evaluator.resourceManager.ListResourceGroupsWithContext(ctx, listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-groups-error")
+		return
+	}
+	for i := range resourceGroups.Resources {
+		var evaluation *QuotaEvaluation
+		evaluation, err = evaluator.evaluate(ctx, &resourceGroups.Resources[i])
+		if err != nil {
+			return
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+	return
+}
+
+// EvaluateResourceGroup evaluates the quota usage of the resource group identified by "id".
+func (evaluator *QuotaEvaluator) EvaluateResourceGroup(ctx context.Context, id string) (evaluation *QuotaEvaluation, err error) {
+	evaluator.accountInstances = -1
+	resourceGroup, _, err := evaluator.resourceManager.GetResourceGroupWithContext(ctx, evaluator.resourceManager.NewGetResourceGroupOptions(id))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-group-error")
+		return
+	}
+	return evaluator.evaluate(ctx, resourceGroup)
+}
+
+func (evaluator *QuotaEvaluator) evaluate(ctx context.Context, resourceGroup *ResourceGroup) (evaluation *QuotaEvaluation, err error) {
+	evaluation = &QuotaEvaluation{
+		ResourceGroupID:   core.StringNilMapper(resourceGroup.ID),
+		ResourceGroupName: core.StringNilMapper(resourceGroup.Name),
+	}
+	if resourceGroup.QuotaID != nil {
+		evaluation.QuotaDefinition, err = evaluator.quotaDefinition(ctx, *resourceGroup.QuotaID)
+		if err != nil {
+			return
+		}
+	}
+
+	instances, err := evaluator.listInstances(ctx, evaluation.ResourceGroupID)
+	if err != nil {
+		return
+	}
+	evaluation.InstanceCount = len(instances)
+	evaluation.ResourceCounts = make(map[string]int)
+	evaluation.PlanCounts = make(map[string]int)
+	for _, instance := range instances {
+		if instance.ResourceID != nil {
+			evaluation.ResourceCounts[*instance.ResourceID]++
+		}
+		if instance.ResourcePlanID != nil {
+			evaluation.PlanCounts[*instance.ResourcePlanID]++
+		}
+	}
+	if evaluation.QuotaDefinition == nil {
+		return
+	}
+
+	// The limit on the number of service instances applies to the whole account.
+	if evaluation.QuotaDefinition.NumberOfServiceInstances != nil {
+		if evaluator.accountInstances < 0 {
+			var accountInstances []resourcecontrollerv2.ResourceInstance
+			accountInstances, err = evaluator.listInstances(ctx, "")
+			if err != nil {
+				return
+			}
+			evaluator.accountInstances = 0
+			for _, instance := range accountInstances {
+				if evaluator.options.AccountID == "" || core.StringNilMapper(instance.AccountID) == evaluator.options.AccountID {
+					evaluator.accountInstances++
+				}
+			}
+		}
+		evaluation.addUsage(QuotaResourceIDServiceInstances, *evaluation.QuotaDefinition.NumberOfServiceInstances, evaluator.accountInstances)
+	}
+
+	// The ID of a resource quota is either a catalog resource ID or a resource plan ID.
+	for _, quota := range evaluation.QuotaDefinition.ResourceQuotas {
+		if quota.ResourceID == nil || quota.Limit == nil {
+			continue
+		}
+		used, found := evaluation.ResourceCounts[*quota.ResourceID]
+		if !found {
+			used = evaluation.PlanCounts[*quota.ResourceID]
+		}
+		evaluation.addUsage(*quota.ResourceID, *quota.Limit, used)
+	}
+	return
+}
+
+// addUsage adds the usage of the quota for "resourceID" to the evaluation.
+func (evaluation *QuotaEvaluation) addUsage(resourceID string, limit float64, used int) {
+	usage := QuotaUsage{
+		ResourceID: resourceID,
+		Limit:      limit,
+		Used:       float64(used),
+	}
+	usage.Headroom = usage.Limit - usage.Used
+	switch {
+	case usage.Limit > 0:
+		usage.PercentUsed = usage.Used / usage.Limit * 100
+	case usage.Used > 0:
+		usage.PercentUsed = math.Inf(1)
+	}
+	evaluation.Usage = append(evaluation.Usage, usage)
+	if usage.Exceeded() {
+		evaluation.Violations = append(evaluation.Violations, usage)
+	}
+}
+
+// quotaDefinition returns the quota definition identified by "id", retrieving it if necessary.
+func (evaluator *QuotaEvaluator) quotaDefinition(ctx context.Context, id string) (*QuotaDefinition, error) {
+	if quotaDefinition, found := evaluator.quotaDefinitions[id]; found {
+		return quotaDefinition, nil
+	}
+	quotaDefinition, _, err := evaluator.resourceManager.GetQuotaDefinitionWithContext(ctx, evaluator.resourceManager.NewGetQuotaDefinitionOptions(id))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-quota-definition-error")
+	}
+	evaluator.quotaDefinitions[id] = quotaDefinition
+	return quotaDefinition, nil
+}
+
+// listInstances returns the resource instances in the resource group identified by
+// "resourceGroupID", or all the instances visible to the client if "resourceGroupID" is empty.
+// The listing cannot be restricted to an account, so the caller filters the instances by
+// AccountID.
+func (evaluator *QuotaEvaluator) listInstances(ctx context.Context, resourceGroupID string) (instances []resourcecontrollerv2.ResourceInstance, err error) {
+	listOptions := &resourcecontrollerv2.ListResourceInstancesOptions{}
+	if resourceGroupID != "" {
+		listOptions.ResourceGroupID = core.StringPtr(resourceGroupID)
+	}
+	pager, err := evaluator.options.ResourceController.NewResourceInstancesPager(listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-instances-error")
+		return
+	}
+	instances, err = pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-instances-error")
+		return
+	}
+	return
+}
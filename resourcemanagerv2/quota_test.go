@@ -0,0 +1,190 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2_test
+
+import (
+	"context"
+	"math"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceManagerV2 QuotaEvaluator`, func() {
+	var fake *mockservers.ResourceManagerV2Server
+	var controllerFake *mockservers.ResourceControllerV2Server
+	var evaluator *resourcemanagerv2.QuotaEvaluator
+	var sharedGroup, emptyGroup resourcemanagerv2.ResourceGroup
+
+	BeforeEach(func() {
+		fake = mockservers.NewResourceManagerV2Server()
+		controllerFake = mockservers.NewResourceControllerV2Server()
+		resourceManagerService, err := fake.NewClient()
+		Expect(err).To(BeNil())
+		resourceControllerService, err := controllerFake.NewClient()
+		Expect(err).To(BeNil())
+
+		quotaDefinition := fake.AddQuotaDefinition(resourcemanagerv2.QuotaDefinition{
+			Name:                     core.StringPtr("Trial Quota"),
+			NumberOfServiceInstances: core.Float64Ptr(10),
+			ResourceQuotas: []resourcemanagerv2.ResourceQuota{
+				{ResourceID: core.StringPtr("databases"), Limit: core.Float64Ptr(4)},
+				{ResourceID: core.StringPtr("lite-plan"), Limit: core.Float64Ptr(1)},
+				{ResourceID: core.StringPtr("kms"), Limit: core.Float64Ptr(0)},
+			},
+		})
+		sharedGroup = fake.AddResourceGroup(resourcemanagerv2.ResourceGroup{
+			Name:    core.StringPtr("shared"),
+			QuotaID: quotaDefinition.ID,
+		})
+		emptyGroup = fake.AddResourceGroup(resourcemanagerv2.ResourceGroup{
+			Name:    core.StringPtr("empty"),
+			QuotaID: quotaDefinition.ID,
+		})
+		unlimitedGroup := fake.AddResourceGroup(resourcemanagerv2.ResourceGroup{
+			Name: core.StringPtr("unlimited"),
+		})
+
+		for _, plan := range []string{"lite-plan", "lite-plan", "standard-plan"} {
+			controllerFake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+				ResourceGroupID: sharedGroup.ID,
+				ResourceID:      core.StringPtr("databases"),
+				ResourcePlanID:  core.StringPtr(plan),
+			})
+		}
+		controllerFake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+			ResourceGroupID: sharedGroup.ID,
+			ResourceID:      core.StringPtr("kms"),
+			ResourcePlanID:  core.StringPtr("kms-plan"),
+		})
+		controllerFake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+			ResourceGroupID: unlimitedGroup.ID,
+			ResourceID:      core.StringPtr("kms"),
+			ResourcePlanID:  core.StringPtr("databases"),
+		})
+
+		evaluator, err = resourceManagerService.NewQuotaEvaluator(&resourcemanagerv2.QuotaEvaluatorOptions{
+			ResourceController: resourceControllerService,
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		fake.Close()
+		controllerFake.Close()
+	})
+
+	It(`Evaluate the quota usage of a resource group`, func() {
+		evaluation, err := evaluator.EvaluateResourceGroup(context.Background(), *sharedGroup.ID)
+		Expect(err).To(BeNil())
+		Expect(evaluation.ResourceGroupName).To(Equal("shared"))
+		Expect(*evaluation.QuotaDefinition.Name).To(Equal("Trial Quota"))
+		Expect(evaluation.InstanceCount).To(Equal(4))
+		Expect(evaluation.ResourceCounts).To(Equal(map[string]int{"databases": 3, "kms": 1}))
+		Expect(evaluation.PlanCounts).To(Equal(map[string]int{"lite-plan": 2, "standard-plan": 1, "kms-plan": 1}))
+
+		// The limit on the number of service instances counts the instances of the whole account.
+		Expect(evaluation.Usage).To(HaveLen(4))
+		Expect(evaluation.Usage[0]).To(Equal(resourcemanagerv2.QuotaUsage{
+			ResourceID: resourcemanagerv2.QuotaResourceIDServiceInstances, Limit: 10, Used: 5, Headroom: 5, PercentUsed: 50,
+		}))
+		databases, found := evaluation.UsageOf("databases")
+		Expect(found).To(BeTrue())
+		Expect(databases).To(Equal(resourcemanagerv2.QuotaUsage{
+			ResourceID: "databases", Limit: 4, Used: 3, Headroom: 1, PercentUsed: 75,
+		}))
+		litePlan, _ := evaluation.UsageOf("lite-plan")
+		Expect(litePlan.Headroom).To(Equal(float64(-1)))
+		Expect(litePlan.PercentUsed).To(Equal(float64(200)))
+		kms, _ := evaluation.UsageOf("kms")
+		Expect(math.IsInf(kms.PercentUsed, 1)).To(BeTrue())
+
+		Expect(evaluation.Violations).To(HaveLen(2))
+		Expect(evaluation.Violations[0].ResourceID).To(Equal("lite-plan"))
+		Expect(evaluation.Violations[1].ResourceID).To(Equal("kms"))
+
+		Expect(evaluation.Fits("databases", "standard-plan", 1)).To(BeTrue())
+		Expect(evaluation.Fits("databases", "standard-plan", 2)).To(BeFalse())
+		Expect(evaluation.Fits("databases", "lite-plan", 1)).To(BeFalse())
+		Expect(evaluation.Fits("other", "other-plan", 5)).To(BeTrue())
+		Expect(evaluation.Fits("other", "other-plan", 6)).To(BeFalse())
+	})
+	It(`Evaluate the quota usage of every resource group of the account`, func() {
+		evaluations, err := evaluator.EvaluateAccount(context.Background())
+		Expect(err).To(BeNil())
+		Expect(evaluations).To(HaveLen(3))
+
+		Expect(evaluations[0].ResourceGroupID).To(Equal(*sharedGroup.ID))
+		Expect(evaluations[0].Violations).To(HaveLen(2))
+
+		Expect(evaluations[1].ResourceGroupID).To(Equal(*emptyGroup.ID))
+		Expect(evaluations[1].Violations).To(BeEmpty())
+		databases, _ := evaluations[1].UsageOf("databases")
+		Expect(databases.Headroom).To(Equal(float64(4)))
+		serviceInstances, _ := evaluations[1].UsageOf(resourcemanagerv2.QuotaResourceIDServiceInstances)
+		Expect(serviceInstances.Used).To(Equal(float64(5)))
+
+		Expect(evaluations[2].QuotaDefinition).To(BeNil())
+		Expect(evaluations[2].Usage).To(BeEmpty())
+		// The catalog resource IDs and resource plan IDs are counted separately.
+		Expect(evaluations[2].ResourceCounts).To(Equal(map[string]int{"kms": 1}))
+		Expect(evaluations[2].PlanCounts).To(Equal(map[string]int{"databases": 1}))
+	})
+	It(`Count the instances of the account again for each evaluation`, func() {
+		evaluation, err := evaluator.EvaluateResourceGroup(context.Background(), *emptyGroup.ID)
+		Expect(err).To(BeNil())
+		Expect(evaluation.Usage[0].Used).To(Equal(float64(5)))
+
+		controllerFake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+			ResourceGroupID: emptyGroup.ID,
+			ResourceID:      core.StringPtr("kms"),
+		})
+		controllerFake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+			AccountID:       core.StringPtr("other-account"),
+			ResourceGroupID: core.StringPtr("other-group"),
+			ResourceID:      core.StringPtr("kms"),
+		})
+		evaluation, err = evaluator.EvaluateResourceGroup(context.Background(), *emptyGroup.ID)
+		Expect(err).To(BeNil())
+		Expect(evaluation.Usage[0].Used).To(Equal(float64(7)))
+
+		// Only the instances of the configured account are counted.
+		resourceManagerService, err := fake.NewClient()
+		Expect(err).To(BeNil())
+		resourceControllerService, err := controllerFake.NewClient()
+		Expect(err).To(BeNil())
+		accountEvaluator, err := resourceManagerService.NewQuotaEvaluator(&resourcemanagerv2.QuotaEvaluatorOptions{
+			ResourceController: resourceControllerService,
+			AccountID:          mockservers.DefaultAccountID,
+		})
+		Expect(err).To(BeNil())
+		evaluation, err = accountEvaluator.EvaluateResourceGroup(context.Background(), *emptyGroup.ID)
+		Expect(err).To(BeNil())
+		Expect(evaluation.Usage[0].Used).To(Equal(float64(6)))
+	})
+	It(`Fail when the quota definition does not exist`, func() {
+		group := fake.AddResourceGroup(resourcemanagerv2.ResourceGroup{
+			Name:    core.StringPtr("broken"),
+			QuotaID: core.StringPtr("does-not-exist"),
+		})
+		_, err := evaluator.EvaluateResourceGroup(context.Background(), *group.ID)
+		Expect(err).ToNot(BeNil())
+	})
+})
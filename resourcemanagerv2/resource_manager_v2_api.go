@@ -0,0 +1,55 @@
+/**
+ * (C) Copyright IBM Corp. 2021.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.41.0-f1ef0102-20211018-193503
+ */
+
+package resourcemanagerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ResourceManagerV2API : The operations supported by the ResourceManagerV2 service. This interface is satisfied by
+// *ResourceManagerV2 and may be used to substitute a fake or a decorator for the service client.
+type ResourceManagerV2API interface {
+	// ListResourceGroupsWithContext : Get a list of all resource groups
+	ListResourceGroupsWithContext(ctx context.Context, listResourceGroupsOptions *ListResourceGroupsOptions) (result *ResourceGroupList, response *core.DetailedResponse, err error)
+
+	// CreateResourceGroupWithContext : Create a resource group
+	CreateResourceGroupWithContext(ctx context.Context, createResourceGroupOptions *CreateResourceGroupOptions) (result *ResCreateResourceGroup, response *core.DetailedResponse, err error)
+
+	// GetResourceGroupWithContext : Get a resource group
+	GetResourceGroupWithContext(ctx context.Context, getResourceGroupOptions *GetResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+
+	// UpdateResourceGroupWithContext : Update a resource group
+	UpdateResourceGroupWithContext(ctx context.Context, updateResourceGroupOptions *UpdateResourceGroupOptions) (result *ResourceGroup, response *core.DetailedResponse, err error)
+
+	// DeleteResourceGroupWithContext : Delete a resource group
+	DeleteResourceGroupWithContext(ctx context.Context, deleteResourceGroupOptions *DeleteResourceGroupOptions) (response *core.DetailedResponse, err error)
+
+	// ListQuotaDefinitionsWithContext : List quota definitions
+	ListQuotaDefinitionsWithContext(ctx context.Context, listQuotaDefinitionsOptions *ListQuotaDefinitionsOptions) (result *QuotaDefinitionList, response *core.DetailedResponse, err error)
+
+	// GetQuotaDefinitionWithContext : Get a quota definition
+	GetQuotaDefinitionWithContext(ctx context.Context, getQuotaDefinitionOptions *GetQuotaDefinitionOptions) (result *QuotaDefinition, response *core.DetailedResponse, err error)
+}
+
+// Verify that ResourceManagerV2 implements the ResourceManagerV2API interface.
+var _ ResourceManagerV2API = (*ResourceManagerV2)(nil)
@@ -0,0 +1,318 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// The kinds of the resources that prevent a resource group from being deleted, in the order in
+// which SafeDeleteResourceGroup deletes them.
+const (
+	ResourceGroupBlockerKindBinding     = "resource_binding"
+	ResourceGroupBlockerKindKey         = "resource_key"
+	ResourceGroupBlockerKindAlias       = "resource_alias"
+	ResourceGroupBlockerKindInstance    = "resource_instance"
+	ResourceGroupBlockerKindReclamation = "reclamation"
+)
+
+// SafeDeleteResourceGroupOptions : The options for the SafeDeleteResourceGroup method.
+type SafeDeleteResourceGroupOptions struct {
+	// The client used to enumerate, and optionally delete, the resources in the resource group.
+	ResourceController *resourcecontrollerv2.ResourceControllerV2
+
+	// If true, the resources in the resource group are deleted before the group itself.
+	// Otherwise, the group is deleted only if it holds no resources.
+	DeleteResources bool
+
+	// Deleted resource instances remain in the resource group, pending reclamation, and prevent
+	// it from being deleted. If true, their reclamations (including those of the instances
+	// deleted by DeleteResources) are run immediately, which permanently deletes the instances.
+	Reclaim bool
+}
+
+// ResourceGroupBlocker : A resource that prevents a resource group from being deleted.
+type ResourceGroupBlocker struct {
+	// The kind of the resource (e.g. ResourceGroupBlockerKindInstance).
+	Kind string
+
+	// The ID and name of the resource.
+	ID   string
+	Name string
+
+	// The error that occurred when the resource was deleted, if any.
+	Err error
+}
+
+// SafeDeleteResourceGroupResult : The result of the SafeDeleteResourceGroup method.
+type SafeDeleteResourceGroupResult struct {
+	// The resources found in the resource group.
+	Blockers []ResourceGroupBlocker
+
+	// The resources that were deleted.
+	Deleted []ResourceGroupBlocker
+
+	// The resources that could not be deleted.
+	Failed []ResourceGroupBlocker
+
+	// Whether the resource group was deleted.
+	GroupDeleted bool
+}
+
+// ResourceGroupNotEmptyError : The error returned by SafeDeleteResourceGroup when resources
+// prevent the resource group from being deleted.
+type ResourceGroupNotEmptyError struct {
+	ResourceGroupID string
+
+	// The resources that remain in the resource group.
+	Blockers []ResourceGroupBlocker
+}
+
+// Error implements the error interface.
+func (e *ResourceGroupNotEmptyError) Error() string {
+	counts := make(map[string]int)
+	var kinds []string
+	for _, blocker := range e.Blockers {
+		if counts[blocker.Kind] == 0 {
+			kinds = append(kinds, blocker.Kind)
+		}
+		counts[blocker.Kind]++
+	}
+	var parts []string
+	for _, kind := range kinds {
+		parts = append(parts, fmt.Sprintf("%d %s(s)", counts[kind], kind))
+	}
+	return fmt.Sprintf("resource group '%s' still contains %s", e.ResourceGroupID, strings.Join(parts, ", "))
+}
+
+// SafeDeleteResourceGroup deletes the resource group identified by "id" after checking that it
+// holds no resources. The resource instances in the group are enumerated, along with their
+// resource keys, resource aliases and the bindings of those aliases, and the group's pending
+// reclamations; all of these are reported in the Blockers field of the result.
+//
+// If any blockers are found and options.DeleteResources is not set, an error wrapping a
+// *ResourceGroupNotEmptyError is returned and nothing is deleted. Otherwise the blockers are
+// deleted in dependency order (bindings, keys, aliases, then instances) and the group is
+// deleted, unless a blocker remains, in which case the same error is returned.
+func (resourceManager *ResourceManagerV2) SafeDeleteResourceGroup(ctx context.Context, id string, options *SafeDeleteResourceGroupOptions) (result *SafeDeleteResourceGroupResult, err error) {
+	if options == nil || options.ResourceController == nil {
+		err = core.SDKErrorf(nil, "a ResourceController client must be specified", "no-resource-controller", common.GetComponentInfo())
+		return
+	}
+	resourceController := options.ResourceController
+
+	result = &SafeDeleteResourceGroupResult{}
+	result.Blockers, err = listResourceGroupBlockers(ctx, resourceController, id)
+	if err != nil {
+		return
+	}
+
+	if len(result.Blockers) > 0 {
+		if !options.DeleteResources {
+			err = resourceGroupNotEmpty(id, result.Blockers)
+			return
+		}
+		for _, blocker := range result.Blockers {
+			if blocker.Kind == ResourceGroupBlockerKindReclamation && !options.Reclaim {
+				result.Failed = append(result.Failed, blocker)
+				continue
+			}
+			blocker.Err = deleteResourceGroupBlocker(ctx, resourceController, blocker)
+			if blocker.Err != nil {
+				result.Failed = append(result.Failed, blocker)
+			} else {
+				result.Deleted = append(result.Deleted, blocker)
+			}
+		}
+
+		// The instances that were just deleted are now pending reclamation. The reclamations that
+		// were already reclaimed above may still be listed, and are skipped.
+		if options.Reclaim {
+			processed := make(map[string]bool)
+			for _, blocker := range result.Blockers {
+				if blocker.Kind == ResourceGroupBlockerKindReclamation {
+					processed[blocker.ID] = true
+				}
+			}
+			var reclamations []ResourceGroupBlocker
+			reclamations, err = listReclamationBlockers(ctx, resourceController, id)
+			if err != nil {
+				return
+			}
+			for _, blocker := range reclamations {
+				if processed[blocker.ID] {
+					continue
+				}
+				blocker.Err = deleteResourceGroupBlocker(ctx, resourceController, blocker)
+				if blocker.Err != nil {
+					result.Failed = append(result.Failed, blocker)
+				} else {
+					result.Deleted = append(result.Deleted, blocker)
+				}
+			}
+		}
+		if len(result.Failed) > 0 {
+			err = resourceGroupNotEmpty(id, result.Failed)
+			return
+		}
+	}
+
+	_, err = resourceManager.DeleteResourceGroupWithContext(ctx, resourceManager.NewDeleteResourceGroupOptions(id))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "delete-group-error")
+		return
+	}
+	result.GroupDeleted = true
+	return
+}
+
+func resourceGroupNotEmpty(id string, blockers []ResourceGroupBlocker) error {
+	return core.SDKErrorf(&ResourceGroupNotEmptyError{ResourceGroupID: id, Blockers: blockers}, "", "resource-group-not-empty", common.GetComponentInfo())
+}
+
+// listResourceGroupBlockers returns the resources in the resource group identified by "id", in
+// the order in which they must be deleted.
+func listResourceGroupBlockers(ctx context.Context, resourceController *resourcecontrollerv2.ResourceControllerV2, id string) (blockers []ResourceGroupBlocker, err error) {
+	instancePager, err := resourceController.NewResourceInstancesPager(&resourcecontrollerv2.ListResourceInstancesOptions{
+		ResourceGroupID: core.StringPtr(id),
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-instances-error")
+		return
+	}
+	instances, err := instancePager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-instances-error")
+		return
+	}
+
+	var bindings, keys, aliases []ResourceGroupBlocker
+	for _, instance := range instances {
+		var aliasPager *resourcecontrollerv2.ResourceAliasesForInstancePager
+		aliasPager, err = resourceController.NewResourceAliasesForInstancePager(resourceController.NewListResourceAliasesForInstanceOptions(*instance.ID))
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-aliases-error")
+			return
+		}
+		var instanceAliases []resourcecontrollerv2.ResourceAlias
+		instanceAliases, err = aliasPager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-aliases-error")
+			return
+		}
+		for _, alias := range instanceAliases {
+			var bindingPager *resourcecontrollerv2.ResourceBindingsForAliasPager
+			bindingPager, err = resourceController.NewResourceBindingsForAliasPager(resourceController.NewListResourceBindingsForAliasOptions(*alias.ID))
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "list-bindings-error")
+				return
+			}
+			var aliasBindings []resourcecontrollerv2.ResourceBinding
+			aliasBindings, err = bindingPager.GetAllWithContext(ctx)
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "list-bindings-error")
+				return
+			}
+			for _, binding := range aliasBindings {
+				bindings = append(bindings, newResourceGroupBlocker(ResourceGroupBlockerKindBinding, binding.ID, binding.Name))
+			}
+			aliases = append(aliases, newResourceGroupBlocker(ResourceGroupBlockerKindAlias, alias.ID, alias.Name))
+		}
+
+		var keyPager *resourcecontrollerv2.ResourceKeysForInstancePager
+		keyPager, err = resourceController.NewResourceKeysForInstancePager(resourceController.NewListResourceKeysForInstanceOptions(*instance.ID))
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-keys-error")
+			return
+		}
+		var instanceKeys []resourcecontrollerv2.ResourceKey
+		instanceKeys, err = keyPager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-keys-error")
+			return
+		}
+		for _, key := range instanceKeys {
+			keys = append(keys, newResourceGroupBlocker(ResourceGroupBlockerKindKey, key.ID, key.Name))
+		}
+	}
+
+	blockers = append(blockers, bindings...)
+	blockers = append(blockers, keys...)
+	blockers = append(blockers, aliases...)
+	for _, instance := range instances {
+		blockers = append(blockers, newResourceGroupBlocker(ResourceGroupBlockerKindInstance, instance.ID, instance.Name))
+	}
+
+	reclamations, err := listReclamationBlockers(ctx, resourceController, id)
+	if err != nil {
+		return
+	}
+	blockers = append(blockers, reclamations...)
+	return
+}
+
+// listReclamationBlockers returns the pending reclamations of the resource group identified by
+// "id", i.e. those in the SCHEDULED state; the others are already being restored or reclaimed.
+func listReclamationBlockers(ctx context.Context, resourceController *resourcecontrollerv2.ResourceControllerV2, id string) (blockers []ResourceGroupBlocker, err error) {
+	listOptions := resourceController.NewListReclamationsOptions().SetResourceGroupID(id)
+	reclamations, _, err := resourceController.ListReclamationsWithContext(ctx, listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-reclamations-error")
+		return
+	}
+	for _, reclamation := range reclamations.Resources {
+		if core.StringNilMapper(reclamation.State) != resourcecontrollerv2.ReclamationStateScheduled {
+			continue
+		}
+		blockers = append(blockers, newResourceGroupBlocker(ResourceGroupBlockerKindReclamation, reclamation.ID, reclamation.ResourceInstanceID))
+	}
+	return
+}
+
+func newResourceGroupBlocker(kind string, id *string, name *string) ResourceGroupBlocker {
+	return ResourceGroupBlocker{
+		Kind: kind,
+		ID:   core.StringNilMapper(id),
+		Name: core.StringNilMapper(name),
+	}
+}
+
+// deleteResourceGroupBlocker deletes the resource described by "blocker".
+func deleteResourceGroupBlocker(ctx context.Context, resourceController *resourcecontrollerv2.ResourceControllerV2, blocker ResourceGroupBlocker) (err error) {
+	switch blocker.Kind {
+	case ResourceGroupBlockerKindBinding:
+		_, err = resourceController.DeleteResourceBindingWithContext(ctx, resourceController.NewDeleteResourceBindingOptions(blocker.ID))
+	case ResourceGroupBlockerKindKey:
+		_, err = resourceController.DeleteResourceKeyWithContext(ctx, resourceController.NewDeleteResourceKeyOptions(blocker.ID))
+	case ResourceGroupBlockerKindAlias:
+		_, err = resourceController.DeleteResourceAliasWithContext(ctx, resourceController.NewDeleteResourceAliasOptions(blocker.ID))
+	case ResourceGroupBlockerKindInstance:
+		_, err = resourceController.DeleteResourceInstanceWithContext(ctx, resourceController.NewDeleteResourceInstanceOptions(blocker.ID))
+	case ResourceGroupBlockerKindReclamation:
+		_, _, err = resourceController.RunReclamationActionWithContext(ctx, resourceController.NewRunReclamationActionOptions(blocker.ID, "reclaim"))
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "delete-"+strings.ReplaceAll(blocker.Kind, "_", "-")+"-error")
+	}
+	return
+}
@@ -0,0 +1,187 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/mockservers"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ResourceManagerV2 SafeDeleteResourceGroup`, func() {
+	var fake *mockservers.ResourceManagerV2Server
+	var controllerFake *mockservers.ResourceControllerV2Server
+	var resourceManagerService *resourcemanagerv2.ResourceManagerV2
+	var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+	var groupID string
+	var instance resourcecontrollerv2.ResourceInstance
+
+	BeforeEach(func() {
+		fake = mockservers.NewResourceManagerV2Server()
+		controllerFake = mockservers.NewResourceControllerV2Server()
+		var err error
+		resourceManagerService, err = fake.NewClient()
+		Expect(err).To(BeNil())
+		resourceControllerService, err = controllerFake.NewClient()
+		Expect(err).To(BeNil())
+
+		group := fake.AddResourceGroup(resourcemanagerv2.ResourceGroup{Name: core.StringPtr("ephemeral")})
+		groupID = *group.ID
+		instance = controllerFake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+			Name:            core.StringPtr("test-instance"),
+			ResourceGroupID: group.ID,
+		})
+		controllerFake.AddResourceKey(resourcecontrollerv2.ResourceKey{
+			Name:      core.StringPtr("test-key"),
+			SourceCRN: instance.CRN,
+		})
+		alias := controllerFake.AddResourceAlias(resourcecontrollerv2.ResourceAlias{
+			Name:               core.StringPtr("test-alias"),
+			ResourceInstanceID: instance.ID,
+		})
+		controllerFake.AddResourceBinding(resourcecontrollerv2.ResourceBinding{
+			Name:      core.StringPtr("test-binding"),
+			SourceCRN: alias.CRN,
+		})
+		controllerFake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+			Name:            core.StringPtr("other-instance"),
+			ResourceGroupID: core.StringPtr("other-group"),
+		})
+	})
+	AfterEach(func() {
+		fake.Close()
+		controllerFake.Close()
+	})
+
+	blockerNames := func(blockers []resourcemanagerv2.ResourceGroupBlocker) (names []string) {
+		for _, blocker := range blockers {
+			names = append(names, blocker.Kind+":"+blocker.Name)
+		}
+		return
+	}
+
+	It(`Report the resources that block the deletion`, func() {
+		result, err := resourceManagerService.SafeDeleteResourceGroup(context.Background(), groupID, &resourcemanagerv2.SafeDeleteResourceGroupOptions{
+			ResourceController: resourceControllerService,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("still contains 1 resource_binding(s), 1 resource_key(s), 1 resource_alias(s), 1 resource_instance(s)"))
+		var notEmptyErr *resourcemanagerv2.ResourceGroupNotEmptyError
+		Expect(errors.As(err, &notEmptyErr)).To(BeTrue())
+		Expect(notEmptyErr.ResourceGroupID).To(Equal(groupID))
+
+		Expect(result.GroupDeleted).To(BeFalse())
+		Expect(result.Deleted).To(BeEmpty())
+		Expect(blockerNames(result.Blockers)).To(Equal([]string{
+			"resource_binding:test-binding",
+			"resource_key:test-key",
+			"resource_alias:test-alias",
+			"resource_instance:test-instance",
+		}))
+
+		_, _, err = resourceManagerService.GetResourceGroup(resourceManagerService.NewGetResourceGroupOptions(groupID))
+		Expect(err).To(BeNil())
+	})
+	It(`Delete the resources and then the resource group`, func() {
+		result, err := resourceManagerService.SafeDeleteResourceGroup(context.Background(), groupID, &resourcemanagerv2.SafeDeleteResourceGroupOptions{
+			ResourceController: resourceControllerService,
+			DeleteResources:    true,
+			Reclaim:            true,
+		})
+		Expect(err).To(BeNil())
+		Expect(result.GroupDeleted).To(BeTrue())
+		Expect(result.Failed).To(BeEmpty())
+		Expect(blockerNames(result.Deleted)).To(Equal([]string{
+			"resource_binding:test-binding",
+			"resource_key:test-key",
+			"resource_alias:test-alias",
+			"resource_instance:test-instance",
+			"reclamation:" + *instance.GUID,
+		}))
+
+		deleted, _ := controllerFake.ResourceInstance(*instance.ID)
+		Expect(*deleted.State).To(Equal("removed"))
+		_, response, err := resourceManagerService.GetResourceGroup(resourceManagerService.NewGetResourceGroupOptions(groupID))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Reclaim each reclamation once when the reclaimed ones remain listed`, func() {
+		controllerFake.SetKeepReclamations(true)
+		deletedInstance := controllerFake.AddResourceInstance(resourcecontrollerv2.ResourceInstance{
+			Name:            core.StringPtr("deleted-instance"),
+			ResourceGroupID: core.StringPtr(groupID),
+		})
+		_, err := resourceControllerService.DeleteResourceInstance(resourceControllerService.NewDeleteResourceInstanceOptions(*deletedInstance.ID))
+		Expect(err).To(BeNil())
+
+		result, err := resourceManagerService.SafeDeleteResourceGroup(context.Background(), groupID, &resourcemanagerv2.SafeDeleteResourceGroupOptions{
+			ResourceController: resourceControllerService,
+			DeleteResources:    true,
+			Reclaim:            true,
+		})
+		Expect(err).To(BeNil())
+		Expect(result.GroupDeleted).To(BeTrue())
+		Expect(result.Failed).To(BeEmpty())
+		Expect(blockerNames(result.Deleted)).To(Equal([]string{
+			"resource_binding:test-binding",
+			"resource_key:test-key",
+			"resource_alias:test-alias",
+			"resource_instance:test-instance",
+			"reclamation:" + *deletedInstance.GUID,
+			"reclamation:" + *instance.GUID,
+		}))
+
+		reclamationsList, _, err := resourceControllerService.ListReclamations(resourceControllerService.NewListReclamationsOptions())
+		Expect(err).To(BeNil())
+		Expect(reclamationsList.Resources).To(HaveLen(2))
+		for _, reclamation := range reclamationsList.Resources {
+			Expect(*reclamation.State).To(Equal("RECLAIMING"))
+		}
+	})
+	It(`Keep the resource group when a resource cannot be deleted`, func() {
+		_, _, err := resourceControllerService.LockResourceInstance(resourceControllerService.NewLockResourceInstanceOptions(*instance.ID))
+		Expect(err).To(BeNil())
+
+		result, err := resourceManagerService.SafeDeleteResourceGroup(context.Background(), groupID, &resourcemanagerv2.SafeDeleteResourceGroupOptions{
+			ResourceController: resourceControllerService,
+			DeleteResources:    true,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(result.GroupDeleted).To(BeFalse())
+		Expect(blockerNames(result.Failed)).To(Equal([]string{"resource_instance:test-instance"}))
+		Expect(result.Failed[0].Err).ToNot(BeNil())
+	})
+	It(`Delete an empty resource group`, func() {
+		emptyGroup := fake.AddResourceGroup(resourcemanagerv2.ResourceGroup{Name: core.StringPtr("empty")})
+		result, err := resourceManagerService.SafeDeleteResourceGroup(context.Background(), *emptyGroup.ID, &resourcemanagerv2.SafeDeleteResourceGroupOptions{
+			ResourceController: resourceControllerService,
+		})
+		Expect(err).To(BeNil())
+		Expect(result.Blockers).To(BeEmpty())
+		Expect(result.GroupDeleted).To(BeTrue())
+	})
+	It(`Require a ResourceController client`, func() {
+		_, err := resourceManagerService.SafeDeleteResourceGroup(context.Background(), groupID, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,37 @@
+/**
+ * (C) Copyright IBM Corp. 2020.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 99-SNAPSHOT-d753183b-20201209-163011
+ */
+
+package usagemeteringv4
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// UsageMeteringV4API : The operations supported by the UsageMeteringV4 service. This interface is satisfied by
+// *UsageMeteringV4 and may be used to substitute a fake or a decorator for the service client.
+type UsageMeteringV4API interface {
+	// ReportResourceUsageWithContext : Report Resource Controller resource usage
+	ReportResourceUsageWithContext(ctx context.Context, reportResourceUsageOptions *ReportResourceUsageOptions) (result *ResponseAccepted, response *core.DetailedResponse, err error)
+}
+
+// Verify that UsageMeteringV4 implements the UsageMeteringV4API interface.
+var _ UsageMeteringV4API = (*UsageMeteringV4)(nil)
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -4382,6 +4383,19 @@ func (usageReports *UsageReportsV4) NewGetResourceUsageAccountPager(options *Get
 	return
 }
 
+// NewGetResourceUsageAccountPagerFromCheckpoint returns a new GetResourceUsageAccountPager instance that resumes
+// from the position recorded by GetResourceUsageAccountPager.Checkpoint().
+func (usageReports *UsageReportsV4) NewGetResourceUsageAccountPagerFromCheckpoint(checkpoint []byte) (pager *GetResourceUsageAccountPager, err error) {
+	pager = &GetResourceUsageAccountPager{
+		client: usageReports,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetResourceUsageAccountOptions, string]("GetResourceUsageAccountPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetResourceUsageAccountPager) HasNext() bool {
 	return pager.hasNext
@@ -4448,6 +4462,19 @@ func (pager *GetResourceUsageAccountPager) GetAll() (allItems []InstanceUsage, e
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetResourceUsageAccountPager) All(ctx context.Context) iter.Seq2[InstanceUsage, error] {
+	return common.All[InstanceUsage](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetResourceUsageAccountPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetResourceUsageAccountPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetResourceUsageAccountPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 //
 // GetResourceUsageResourceGroupPager can be used to simplify the use of the "GetResourceUsageResourceGroup" method.
 //
@@ -4476,6 +4503,19 @@ func (usageReports *UsageReportsV4) NewGetResourceUsageResourceGroupPager(option
 	return
 }
 
+// NewGetResourceUsageResourceGroupPagerFromCheckpoint returns a new GetResourceUsageResourceGroupPager instance that resumes
+// from the position recorded by GetResourceUsageResourceGroupPager.Checkpoint().
+func (usageReports *UsageReportsV4) NewGetResourceUsageResourceGroupPagerFromCheckpoint(checkpoint []byte) (pager *GetResourceUsageResourceGroupPager, err error) {
+	pager = &GetResourceUsageResourceGroupPager{
+		client: usageReports,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetResourceUsageResourceGroupOptions, string]("GetResourceUsageResourceGroupPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetResourceUsageResourceGroupPager) HasNext() bool {
 	return pager.hasNext
@@ -4542,6 +4582,19 @@ func (pager *GetResourceUsageResourceGroupPager) GetAll() (allItems []InstanceUs
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetResourceUsageResourceGroupPager) All(ctx context.Context) iter.Seq2[InstanceUsage, error] {
+	return common.All[InstanceUsage](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetResourceUsageResourceGroupPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetResourceUsageResourceGroupPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetResourceUsageResourceGroupPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 //
 // GetResourceUsageOrgPager can be used to simplify the use of the "GetResourceUsageOrg" method.
 //
@@ -4570,6 +4623,19 @@ func (usageReports *UsageReportsV4) NewGetResourceUsageOrgPager(options *GetReso
 	return
 }
 
+// NewGetResourceUsageOrgPagerFromCheckpoint returns a new GetResourceUsageOrgPager instance that resumes
+// from the position recorded by GetResourceUsageOrgPager.Checkpoint().
+func (usageReports *UsageReportsV4) NewGetResourceUsageOrgPagerFromCheckpoint(checkpoint []byte) (pager *GetResourceUsageOrgPager, err error) {
+	pager = &GetResourceUsageOrgPager{
+		client: usageReports,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetResourceUsageOrgOptions, string]("GetResourceUsageOrgPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetResourceUsageOrgPager) HasNext() bool {
 	return pager.hasNext
@@ -4636,6 +4702,19 @@ func (pager *GetResourceUsageOrgPager) GetAll() (allItems []InstanceUsage, err e
 	return
 }
 
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetResourceUsageOrgPager) All(ctx context.Context) iter.Seq2[InstanceUsage, error] {
+	return common.All[InstanceUsage](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetResourceUsageOrgPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetResourceUsageOrgPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetResourceUsageOrgPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
+
 //
 // GetReportsSnapshotPager can be used to simplify the use of the "GetReportsSnapshot" method.
 //
@@ -4664,6 +4743,19 @@ func (usageReports *UsageReportsV4) NewGetReportsSnapshotPager(options *GetRepor
 	return
 }
 
+// NewGetReportsSnapshotPagerFromCheckpoint returns a new GetReportsSnapshotPager instance that resumes
+// from the position recorded by GetReportsSnapshotPager.Checkpoint().
+func (usageReports *UsageReportsV4) NewGetReportsSnapshotPagerFromCheckpoint(checkpoint []byte) (pager *GetReportsSnapshotPager, err error) {
+	pager = &GetReportsSnapshotPager{
+		client: usageReports,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[GetReportsSnapshotOptions, string]("GetReportsSnapshotPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *GetReportsSnapshotPager) HasNext() bool {
 	return pager.hasNext
@@ -4729,3 +4821,16 @@ func (pager *GetReportsSnapshotPager) GetAll() (allItems []SnapshotListSnapshots
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *GetReportsSnapshotPager) All(ctx context.Context) iter.Seq2[SnapshotListSnapshotsItem, error] {
+	return common.All[SnapshotListSnapshotsItem](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewGetReportsSnapshotPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *GetReportsSnapshotPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("GetReportsSnapshotPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
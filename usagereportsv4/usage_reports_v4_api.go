@@ -0,0 +1,73 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.89.0-f33c767b-20240410-144451
+ */
+
+package usagereportsv4
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// UsageReportsV4API : The operations supported by the UsageReportsV4 service. This interface is satisfied by
+// *UsageReportsV4 and may be used to substitute a fake or a decorator for the service client.
+type UsageReportsV4API interface {
+	// GetAccountSummaryWithContext : Get account summary
+	GetAccountSummaryWithContext(ctx context.Context, getAccountSummaryOptions *GetAccountSummaryOptions) (result *AccountSummary, response *core.DetailedResponse, err error)
+
+	// GetAccountUsageWithContext : Get account usage
+	GetAccountUsageWithContext(ctx context.Context, getAccountUsageOptions *GetAccountUsageOptions) (result *AccountUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceGroupUsageWithContext : Get resource group usage
+	GetResourceGroupUsageWithContext(ctx context.Context, getResourceGroupUsageOptions *GetResourceGroupUsageOptions) (result *ResourceGroupUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageAccountWithContext : Get resource instance usage in an account
+	GetResourceUsageAccountWithContext(ctx context.Context, getResourceUsageAccountOptions *GetResourceUsageAccountOptions) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageResourceGroupWithContext : Get resource instance usage in a resource group
+	GetResourceUsageResourceGroupWithContext(ctx context.Context, getResourceUsageResourceGroupOptions *GetResourceUsageResourceGroupOptions) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// GetResourceUsageOrgWithContext : Get resource instance usage in an organization
+	GetResourceUsageOrgWithContext(ctx context.Context, getResourceUsageOrgOptions *GetResourceUsageOrgOptions) (result *InstancesUsage, response *core.DetailedResponse, err error)
+
+	// GetOrgUsageWithContext : Get organization usage
+	GetOrgUsageWithContext(ctx context.Context, getOrgUsageOptions *GetOrgUsageOptions) (result *OrgUsage, response *core.DetailedResponse, err error)
+
+	// CreateReportsSnapshotConfigWithContext : Setup the snapshot configuration
+	CreateReportsSnapshotConfigWithContext(ctx context.Context, createReportsSnapshotConfigOptions *CreateReportsSnapshotConfigOptions) (result *SnapshotConfig, response *core.DetailedResponse, err error)
+
+	// GetReportsSnapshotConfigWithContext : Fetch the snapshot configuration
+	GetReportsSnapshotConfigWithContext(ctx context.Context, getReportsSnapshotConfigOptions *GetReportsSnapshotConfigOptions) (result *SnapshotConfig, response *core.DetailedResponse, err error)
+
+	// UpdateReportsSnapshotConfigWithContext : Update the snapshot configuration
+	UpdateReportsSnapshotConfigWithContext(ctx context.Context, updateReportsSnapshotConfigOptions *UpdateReportsSnapshotConfigOptions) (result *SnapshotConfig, response *core.DetailedResponse, err error)
+
+	// DeleteReportsSnapshotConfigWithContext : Delete the snapshot configuration
+	DeleteReportsSnapshotConfigWithContext(ctx context.Context, deleteReportsSnapshotConfigOptions *DeleteReportsSnapshotConfigOptions) (response *core.DetailedResponse, err error)
+
+	// ValidateReportsSnapshotConfigWithContext : Verify billing to COS authorization
+	ValidateReportsSnapshotConfigWithContext(ctx context.Context, validateReportsSnapshotConfigOptions *ValidateReportsSnapshotConfigOptions) (result *SnapshotConfigValidateResponse, response *core.DetailedResponse, err error)
+
+	// GetReportsSnapshotWithContext : Fetch the current or past snapshots
+	GetReportsSnapshotWithContext(ctx context.Context, getReportsSnapshotOptions *GetReportsSnapshotOptions) (result *SnapshotList, response *core.DetailedResponse, err error)
+}
+
+// Verify that UsageReportsV4 implements the UsageReportsV4API interface.
+var _ UsageReportsV4API = (*UsageReportsV4)(nil)
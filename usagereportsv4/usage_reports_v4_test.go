@@ -1249,6 +1249,54 @@ var _ = Describe(`UsageReportsV4`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageAccountPager.Checkpoint successfully`, func() {
+				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(usageReportsService).ToNot(BeNil())
+
+				getResourceUsageAccountOptionsModel := &usagereportsv4.GetResourceUsageAccountOptions{
+					AccountID: core.StringPtr("testString"),
+					Billingmonth: core.StringPtr("testString"),
+					Names: core.BoolPtr(true),
+					Tags: core.BoolPtr(true),
+					AcceptLanguage: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(30)),
+					ResourceGroupID: core.StringPtr("testString"),
+					OrganizationID: core.StringPtr("testString"),
+					ResourceInstanceID: core.StringPtr("testString"),
+					ResourceID: core.StringPtr("testString"),
+					PlanID: core.StringPtr("testString"),
+					Region: core.StringPtr("testString"),
+				}
+
+				pager, err := usageReportsService.NewGetResourceUsageAccountPager(getResourceUsageAccountOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usagereportsv4.InstanceUsage
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = usageReportsService.NewGetResourceUsageAccountPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetResourceUsageAccountPager.GetAll successfully`, func() {
 				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
 					URL:           testServer.URL,
@@ -1281,6 +1329,40 @@ var _ = Describe(`UsageReportsV4`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageAccountPager.All successfully`, func() {
+				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(usageReportsService).ToNot(BeNil())
+
+				getResourceUsageAccountOptionsModel := &usagereportsv4.GetResourceUsageAccountOptions{
+					AccountID: core.StringPtr("testString"),
+					Billingmonth: core.StringPtr("testString"),
+					Names: core.BoolPtr(true),
+					Tags: core.BoolPtr(true),
+					AcceptLanguage: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(30)),
+					ResourceGroupID: core.StringPtr("testString"),
+					OrganizationID: core.StringPtr("testString"),
+					ResourceInstanceID: core.StringPtr("testString"),
+					ResourceID: core.StringPtr("testString"),
+					PlanID: core.StringPtr("testString"),
+					Region: core.StringPtr("testString"),
+				}
+
+				pager, err := usageReportsService.NewGetResourceUsageAccountPager(getResourceUsageAccountOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usagereportsv4.InstanceUsage
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetResourceUsageResourceGroup(getResourceUsageResourceGroupOptions *GetResourceUsageResourceGroupOptions) - Operation response error`, func() {
@@ -1664,6 +1746,53 @@ var _ = Describe(`UsageReportsV4`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageResourceGroupPager.Checkpoint successfully`, func() {
+				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(usageReportsService).ToNot(BeNil())
+
+				getResourceUsageResourceGroupOptionsModel := &usagereportsv4.GetResourceUsageResourceGroupOptions{
+					AccountID: core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					Billingmonth: core.StringPtr("testString"),
+					Names: core.BoolPtr(true),
+					Tags: core.BoolPtr(true),
+					AcceptLanguage: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(30)),
+					ResourceInstanceID: core.StringPtr("testString"),
+					ResourceID: core.StringPtr("testString"),
+					PlanID: core.StringPtr("testString"),
+					Region: core.StringPtr("testString"),
+				}
+
+				pager, err := usageReportsService.NewGetResourceUsageResourceGroupPager(getResourceUsageResourceGroupOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usagereportsv4.InstanceUsage
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = usageReportsService.NewGetResourceUsageResourceGroupPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetResourceUsageResourceGroupPager.GetAll successfully`, func() {
 				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
 					URL:           testServer.URL,
@@ -1695,6 +1824,39 @@ var _ = Describe(`UsageReportsV4`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageResourceGroupPager.All successfully`, func() {
+				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(usageReportsService).ToNot(BeNil())
+
+				getResourceUsageResourceGroupOptionsModel := &usagereportsv4.GetResourceUsageResourceGroupOptions{
+					AccountID: core.StringPtr("testString"),
+					ResourceGroupID: core.StringPtr("testString"),
+					Billingmonth: core.StringPtr("testString"),
+					Names: core.BoolPtr(true),
+					Tags: core.BoolPtr(true),
+					AcceptLanguage: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(30)),
+					ResourceInstanceID: core.StringPtr("testString"),
+					ResourceID: core.StringPtr("testString"),
+					PlanID: core.StringPtr("testString"),
+					Region: core.StringPtr("testString"),
+				}
+
+				pager, err := usageReportsService.NewGetResourceUsageResourceGroupPager(getResourceUsageResourceGroupOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usagereportsv4.InstanceUsage
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetResourceUsageOrg(getResourceUsageOrgOptions *GetResourceUsageOrgOptions) - Operation response error`, func() {
@@ -2077,6 +2239,53 @@ var _ = Describe(`UsageReportsV4`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageOrgPager.Checkpoint successfully`, func() {
+				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(usageReportsService).ToNot(BeNil())
+
+				getResourceUsageOrgOptionsModel := &usagereportsv4.GetResourceUsageOrgOptions{
+					AccountID: core.StringPtr("testString"),
+					OrganizationID: core.StringPtr("testString"),
+					Billingmonth: core.StringPtr("testString"),
+					Names: core.BoolPtr(true),
+					Tags: core.BoolPtr(true),
+					AcceptLanguage: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(30)),
+					ResourceInstanceID: core.StringPtr("testString"),
+					ResourceID: core.StringPtr("testString"),
+					PlanID: core.StringPtr("testString"),
+					Region: core.StringPtr("testString"),
+				}
+
+				pager, err := usageReportsService.NewGetResourceUsageOrgPager(getResourceUsageOrgOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usagereportsv4.InstanceUsage
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = usageReportsService.NewGetResourceUsageOrgPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetResourceUsageOrgPager.GetAll successfully`, func() {
 				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
 					URL:           testServer.URL,
@@ -2108,6 +2317,39 @@ var _ = Describe(`UsageReportsV4`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetResourceUsageOrgPager.All successfully`, func() {
+				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(usageReportsService).ToNot(BeNil())
+
+				getResourceUsageOrgOptionsModel := &usagereportsv4.GetResourceUsageOrgOptions{
+					AccountID: core.StringPtr("testString"),
+					OrganizationID: core.StringPtr("testString"),
+					Billingmonth: core.StringPtr("testString"),
+					Names: core.BoolPtr(true),
+					Tags: core.BoolPtr(true),
+					AcceptLanguage: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(30)),
+					ResourceInstanceID: core.StringPtr("testString"),
+					ResourceID: core.StringPtr("testString"),
+					PlanID: core.StringPtr("testString"),
+					Region: core.StringPtr("testString"),
+				}
+
+				pager, err := usageReportsService.NewGetResourceUsageOrgPager(getResourceUsageOrgOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usagereportsv4.InstanceUsage
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`GetOrgUsage(getOrgUsageOptions *GetOrgUsageOptions) - Operation response error`, func() {
@@ -3792,6 +4034,47 @@ var _ = Describe(`UsageReportsV4`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetReportsSnapshotPager.Checkpoint successfully`, func() {
+				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(usageReportsService).ToNot(BeNil())
+
+				getReportsSnapshotOptionsModel := &usagereportsv4.GetReportsSnapshotOptions{
+					AccountID: core.StringPtr("abc"),
+					Month: core.StringPtr("2023-02"),
+					DateFrom: core.Int64Ptr(int64(1675209600000)),
+					DateTo: core.Int64Ptr(int64(1675987200000)),
+					Limit: core.Int64Ptr(int64(30)),
+				}
+
+				pager, err := usageReportsService.NewGetReportsSnapshotPager(getReportsSnapshotOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usagereportsv4.SnapshotListSnapshotsItem
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = usageReportsService.NewGetReportsSnapshotPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use GetReportsSnapshotPager.GetAll successfully`, func() {
 				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
 					URL:           testServer.URL,
@@ -3817,6 +4100,33 @@ var _ = Describe(`UsageReportsV4`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use GetReportsSnapshotPager.All successfully`, func() {
+				usageReportsService, serviceErr := usagereportsv4.NewUsageReportsV4(&usagereportsv4.UsageReportsV4Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(usageReportsService).ToNot(BeNil())
+
+				getReportsSnapshotOptionsModel := &usagereportsv4.GetReportsSnapshotOptions{
+					AccountID: core.StringPtr("abc"),
+					Month: core.StringPtr("2023-02"),
+					DateFrom: core.Int64Ptr(int64(1675209600000)),
+					DateTo: core.Int64Ptr(int64(1675987200000)),
+					Limit: core.Int64Ptr(int64(30)),
+				}
+
+				pager, err := usageReportsService.NewGetReportsSnapshotPager(getReportsSnapshotOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usagereportsv4.SnapshotListSnapshotsItem
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`Model constructor tests`, func() {
@@ -25,6 +25,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"reflect"
 	"time"
@@ -1687,6 +1688,19 @@ func (userManagement *UserManagementV1) NewUsersPager(options *ListUsersOptions)
 	return
 }
 
+// NewUsersPagerFromCheckpoint returns a new UsersPager instance that resumes
+// from the position recorded by UsersPager.Checkpoint().
+func (userManagement *UserManagementV1) NewUsersPagerFromCheckpoint(checkpoint []byte) (pager *UsersPager, err error) {
+	pager = &UsersPager{
+		client: userManagement,
+	}
+	pager.options, pager.hasNext, pager.pageContext.next, err = common.UnmarshalPagerCheckpoint[ListUsersOptions, string]("UsersPager", checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
 // HasNext returns true if there are potentially more results to be retrieved.
 func (pager *UsersPager) HasNext() bool {
 	return pager.hasNext
@@ -1745,3 +1759,16 @@ func (pager *UsersPager) GetNext() (page []UserProfile, err error) {
 func (pager *UsersPager) GetAll() (allItems []UserProfile, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// All returns an iterator over all results, retrieving each page lazily as the previous
+// page is consumed. An error is yielded once and ends the iteration.
+func (pager *UsersPager) All(ctx context.Context) iter.Seq2[UserProfile, error] {
+	return common.All[UserProfile](ctx, pager)
+}
+
+// Checkpoint returns the serialized state of the pager, including the options and the position
+// of the next page of results. It can be passed to NewUsersPagerFromCheckpoint()
+// to resume retrieving results, e.g. after a restart.
+func (pager *UsersPager) Checkpoint() ([]byte, error) {
+	return common.MarshalPagerCheckpoint("UsersPager", pager.options, pager.hasNext, pager.pageContext.next)
+}
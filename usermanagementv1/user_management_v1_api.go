@@ -0,0 +1,61 @@
+/**
+ * (C) Copyright IBM Corp. 2023.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+ * IBM OpenAPI SDK Code Generator Version: 3.70.0-7df966bf-20230419-195904
+ */
+
+package usermanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// UserManagementV1API : The operations supported by the UserManagementV1 service. This interface is satisfied by
+// *UserManagementV1 and may be used to substitute a fake or a decorator for the service client.
+type UserManagementV1API interface {
+	// ListUsersWithContext : List users
+	ListUsersWithContext(ctx context.Context, listUsersOptions *ListUsersOptions) (result *UserList, response *core.DetailedResponse, err error)
+
+	// InviteUsersWithContext : Invite users to an account
+	InviteUsersWithContext(ctx context.Context, inviteUsersOptions *InviteUsersOptions) (result *InvitedUserList, response *core.DetailedResponse, err error)
+
+	// GetUserProfileWithContext : Get user profile
+	GetUserProfileWithContext(ctx context.Context, getUserProfileOptions *GetUserProfileOptions) (result *UserProfile, response *core.DetailedResponse, err error)
+
+	// UpdateUserProfileWithContext : Partially update user profile
+	UpdateUserProfileWithContext(ctx context.Context, updateUserProfileOptions *UpdateUserProfileOptions) (response *core.DetailedResponse, err error)
+
+	// RemoveUserWithContext : Remove user from account
+	RemoveUserWithContext(ctx context.Context, removeUserOptions *RemoveUserOptions) (response *core.DetailedResponse, err error)
+
+	// AcceptWithContext : Accept an invitation
+	AcceptWithContext(ctx context.Context, acceptOptions *AcceptOptions) (response *core.DetailedResponse, err error)
+
+	// V3RemoveUserWithContext : Remove user from account (Asynchronous)
+	V3RemoveUserWithContext(ctx context.Context, v3RemoveUserOptions *V3RemoveUserOptions) (response *core.DetailedResponse, err error)
+
+	// GetUserSettingsWithContext : Get user settings
+	GetUserSettingsWithContext(ctx context.Context, getUserSettingsOptions *GetUserSettingsOptions) (result *UserSettings, response *core.DetailedResponse, err error)
+
+	// UpdateUserSettingsWithContext : Partially update user settings
+	UpdateUserSettingsWithContext(ctx context.Context, updateUserSettingsOptions *UpdateUserSettingsOptions) (response *core.DetailedResponse, err error)
+}
+
+// Verify that UserManagementV1 implements the UserManagementV1API interface.
+var _ UserManagementV1API = (*UserManagementV1)(nil)
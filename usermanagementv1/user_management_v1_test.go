@@ -491,6 +491,47 @@ var _ = Describe(`UserManagementV1`, func() {
 				}
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use UsersPager.Checkpoint successfully`, func() {
+				userManagementService, serviceErr := usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(userManagementService).ToNot(BeNil())
+
+				listUsersOptionsModel := &usermanagementv1.ListUsersOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+					IncludeSettings: core.BoolPtr(true),
+					Search: core.StringPtr("testString"),
+					UserID: core.StringPtr("testString"),
+				}
+
+				pager, err := userManagementService.NewUsersPager(listUsersOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usermanagementv1.UserProfile
+				nextPage, err := pager.GetNext()
+				Expect(err).To(BeNil())
+				allResults = append(allResults, nextPage...)
+
+				checkpoint, err := pager.Checkpoint()
+				Expect(err).To(BeNil())
+				Expect(checkpoint).ToNot(BeNil())
+
+				pager, err = userManagementService.NewUsersPagerFromCheckpoint(checkpoint)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 			It(`Use UsersPager.GetAll successfully`, func() {
 				userManagementService, serviceErr := usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
 					URL:           testServer.URL,
@@ -516,6 +557,33 @@ var _ = Describe(`UserManagementV1`, func() {
 				Expect(allResults).ToNot(BeNil())
 				Expect(len(allResults)).To(Equal(2))
 			})
+			It(`Use UsersPager.All successfully`, func() {
+				userManagementService, serviceErr := usermanagementv1.NewUserManagementV1(&usermanagementv1.UserManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(userManagementService).ToNot(BeNil())
+
+				listUsersOptionsModel := &usermanagementv1.ListUsersOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+					IncludeSettings: core.BoolPtr(true),
+					Search: core.StringPtr("testString"),
+					UserID: core.StringPtr("testString"),
+				}
+
+				pager, err := userManagementService.NewUsersPager(listUsersOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []usermanagementv1.UserProfile
+				for item, err := range pager.All(context.Background()) {
+					Expect(err).To(BeNil())
+					allResults = append(allResults, item)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
 		})
 	})
 	Describe(`InviteUsers(inviteUsersOptions *InviteUsersOptions) - Operation response error`, func() {